@@ -20,6 +20,9 @@ const defaultOtelColVersion = "0.83.0"
 // ErrInvalidGoMod indicates an invalid gomod
 var ErrInvalidGoMod = errors.New("invalid gomod specification for module")
 
+// ErrInvalidSBOMFormat indicates an unsupported SBOM format
+var ErrInvalidSBOMFormat = errors.New("invalid SBOM format, must be either \"cyclonedx\" or \"spdx\"")
+
 // Config holds the builder's configuration
 type Config struct {
 	Logger          *zap.Logger
@@ -34,6 +37,8 @@ type Config struct {
 	Receivers    []Module     `mapstructure:"receivers"`
 	Processors   []Module     `mapstructure:"processors"`
 	Connectors   []Module     `mapstructure:"connectors"`
+	Providers    []Module     `mapstructure:"providers"`
+	Converters   []Module     `mapstructure:"converters"`
 	Replaces     []string     `mapstructure:"replaces"`
 	Excludes     []string     `mapstructure:"excludes"`
 }
@@ -49,9 +54,12 @@ type Distribution struct {
 	Version          string `mapstructure:"version"`
 	BuildTags        string `mapstructure:"build_tags"`
 	DebugCompilation bool   `mapstructure:"debug_compilation"`
+	Dockerfile       bool   `mapstructure:"dockerfile"`
+	SBOM             string `mapstructure:"sbom"`
 }
 
-// Module represents a receiver, exporter, processor or extension for the distribution
+// Module represents a receiver, exporter, processor, extension, connector,
+// confmap provider or confmap converter for the distribution
 type Module struct {
 	Name   string `mapstructure:"name"`   // if not specified, this is package part of the go mod (last part of the path)
 	Import string `mapstructure:"import"` // if not specified, this is the path part of the go mods
@@ -89,6 +97,9 @@ func (c *Config) Validate() error {
 		validateModules(c.Exporters),
 		validateModules(c.Processors),
 		validateModules(c.Connectors),
+		validateModules(c.Providers),
+		validateModules(c.Converters),
+		validateSBOMFormat(c.Distribution.SBOM),
 	)
 }
 
@@ -137,6 +148,16 @@ func (c *Config) ParseModules() error {
 		return err
 	}
 
+	c.Providers, err = parseModules(c.Providers)
+	if err != nil {
+		return err
+	}
+
+	c.Converters, err = parseModules(c.Converters)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -149,6 +170,14 @@ func validateModules(mods []Module) error {
 	return nil
 }
 
+func validateSBOMFormat(format string) error {
+	switch format {
+	case "", sbomFormatCycloneDX, sbomFormatSPDX:
+		return nil
+	}
+	return fmt.Errorf("sbom %q: %w", format, ErrInvalidSBOMFormat)
+}
+
 func parseModules(mods []Module) ([]Module, error) {
 	var parsedModules []Module
 	for _, mod := range mods {
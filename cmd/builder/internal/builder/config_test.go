@@ -37,6 +37,28 @@ func TestParseModules(t *testing.T) {
 	assert.Equal(t, "repo", cfg.Extensions[0].Name)
 }
 
+func TestParseProvidersAndConverters(t *testing.T) {
+	// prepare
+	cfg := Config{
+		Providers: []Module{{
+			GoMod:  "github.com/org/repo v0.1.2",
+			Import: "github.com/org/repo/secretprovider",
+		}},
+		Converters: []Module{{
+			GoMod: "github.com/org/converter v0.1.2",
+		}},
+	}
+
+	// test
+	err := cfg.ParseModules()
+	assert.NoError(t, err)
+
+	// verify
+	assert.Equal(t, "secretprovider", cfg.Providers[0].Name)
+	assert.Equal(t, "github.com/org/converter", cfg.Converters[0].Import)
+	assert.Equal(t, "converter", cfg.Converters[0].Name)
+}
+
 func TestRelativePath(t *testing.T) {
 	// prepare
 	cfg := Config{
@@ -122,6 +144,24 @@ func TestInvalidModule(t *testing.T) {
 			},
 			err: ErrInvalidGoMod,
 		},
+		{
+			cfg: Config{
+				Logger: zap.NewNop(),
+				Providers: []Module{{
+					Import: "invalid",
+				}},
+			},
+			err: ErrInvalidGoMod,
+		},
+		{
+			cfg: Config{
+				Logger: zap.NewNop(),
+				Converters: []Module{{
+					Import: "invalid",
+				}},
+			},
+			err: ErrInvalidGoMod,
+		},
 	}
 
 	for _, test := range configurations {
@@ -129,6 +169,19 @@ func TestInvalidModule(t *testing.T) {
 	}
 }
 
+func TestInvalidSBOMFormat(t *testing.T) {
+	cfg := Config{
+		Logger:       zap.NewNop(),
+		Distribution: Distribution{SBOM: "invalid"},
+	}
+	assert.ErrorIs(t, cfg.Validate(), ErrInvalidSBOMFormat)
+
+	for _, format := range []string{"", "cyclonedx", "spdx"} {
+		cfg.Distribution.SBOM = format
+		assert.NoError(t, cfg.Validate())
+	}
+}
+
 func TestNewDefaultConfig(t *testing.T) {
 	cfg := NewDefaultConfig()
 	require.NoError(t, cfg.ParseModules())
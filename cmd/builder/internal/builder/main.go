@@ -31,6 +31,10 @@ func GenerateAndCompile(cfg Config) error {
 		return err
 	}
 
+	if err := GenerateSBOM(cfg); err != nil {
+		return err
+	}
+
 	return Compile(cfg)
 }
 
@@ -66,6 +70,12 @@ func Generate(cfg Config) error {
 		}
 	}
 
+	if cfg.Distribution.Dockerfile {
+		if err := processAndWrite(cfg, dockerfileTemplate, dockerfileTemplate.Name(), cfg); err != nil {
+			return fmt.Errorf("failed to generate %q: %w", dockerfileTemplate.Name(), err)
+		}
+	}
+
 	cfg.Logger.Info("Sources created", zap.String("path", cfg.Distribution.OutputPath))
 	return nil
 }
@@ -35,6 +35,21 @@ func TestGenerateInvalidOutputPath(t *testing.T) {
 	require.Contains(t, err.Error(), "failed to create output path")
 }
 
+func TestGenerateDockerfile(t *testing.T) {
+	cfg := NewDefaultConfig()
+	cfg.Distribution.OutputPath = t.TempDir()
+	cfg.Distribution.Name = "otelcol-custom"
+	require.NoError(t, Generate(cfg))
+	_, err := os.Stat(filepath.Join(cfg.Distribution.OutputPath, "Dockerfile"))
+	assert.True(t, os.IsNotExist(err))
+
+	cfg.Distribution.Dockerfile = true
+	require.NoError(t, Generate(cfg))
+	dockerfile, err := os.ReadFile(filepath.Join(cfg.Distribution.OutputPath, "Dockerfile"))
+	require.NoError(t, err)
+	assert.Contains(t, string(dockerfile), `ENTRYPOINT ["/otelcol-custom"]`)
+}
+
 func TestSkipGenerate(t *testing.T) {
 	if runtime.GOOS == "windows" {
 		t.Skip("skipping the test on Windows, see https://github.com/open-telemetry/opentelemetry-collector/issues/5403")
@@ -116,6 +131,42 @@ func TestGenerateAndCompile(t *testing.T) {
 				return cfg
 			},
 		},
+		{
+			testCase: "SBOM Compilation",
+			cfgBuilder: func(t *testing.T) Config {
+				cfg := NewDefaultConfig()
+				cfg.Distribution.OutputPath = t.TempDir()
+				cfg.Replaces = append(cfg.Replaces, replaces...)
+				cfg.Distribution.SBOM = "cyclonedx"
+				return cfg
+			},
+		},
+		{
+			testCase: "Custom Providers and Converters Compilation",
+			cfgBuilder: func(t *testing.T) Config {
+				cfg := NewDefaultConfig()
+				cfg.Distribution.OutputPath = t.TempDir()
+				cfg.Replaces = append(cfg.Replaces, replaces...)
+				cfg.Providers = []Module{
+					{
+						Import: "go.opentelemetry.io/collector/confmap/provider/envprovider",
+						GoMod:  "go.opentelemetry.io/collector/confmap v" + defaultOtelColVersion,
+					},
+					{
+						Import: "go.opentelemetry.io/collector/confmap/provider/fileprovider",
+						GoMod:  "go.opentelemetry.io/collector/confmap v" + defaultOtelColVersion,
+					},
+				}
+				cfg.Converters = []Module{
+					{
+						Import: "go.opentelemetry.io/collector/confmap/converter/expandconverter",
+						GoMod:  "go.opentelemetry.io/collector/confmap v" + defaultOtelColVersion,
+					},
+				}
+				require.NoError(t, cfg.ParseModules())
+				return cfg
+			},
+		},
 	}
 
 	for _, tt := range testCases {
@@ -0,0 +1,271 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package builder // import "go.opentelemetry.io/collector/cmd/builder/internal/builder"
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	sbomFormatCycloneDX = "cyclonedx"
+	sbomFormatSPDX      = "spdx"
+
+	sbomCycloneDXFile = "sbom.cdx.json"
+	sbomSPDXFile      = "sbom.spdx.json"
+)
+
+// goModule is a module of the build list, as reported by "go list -m -json".
+type goModule struct {
+	Path    string
+	Version string
+	Main    bool
+	Replace *goModule
+}
+
+// GenerateSBOM writes a software bill of materials for the distribution,
+// listing the modules resolved for its build.
+func GenerateSBOM(cfg Config) error {
+	if cfg.Distribution.SBOM == "" {
+		return nil
+	}
+
+	// #nosec G204 -- cfg.Distribution.Go is trusted to be a safe path
+	cmd := exec.Command(cfg.Distribution.Go, "list", "-m", "-json", "all")
+	cmd.Dir = cfg.Distribution.OutputPath
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("failed to list go modules: %w. Output:\n%s", err, stderr.String())
+	}
+	mods, err := parseGoModules(bytes.NewReader(out))
+	if err != nil {
+		return fmt.Errorf("failed to parse go modules: %w", err)
+	}
+
+	var doc any
+	var file string
+	switch cfg.Distribution.SBOM {
+	case sbomFormatCycloneDX:
+		doc, file = newCycloneDXBOM(cfg.Distribution, mods, time.Now()), sbomCycloneDXFile
+	case sbomFormatSPDX:
+		doc, file = newSPDXDocument(cfg.Distribution, mods, time.Now()), sbomSPDXFile
+	default:
+		return fmt.Errorf("sbom %q: %w", cfg.Distribution.SBOM, ErrInvalidSBOMFormat)
+	}
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal the SBOM: %w", err)
+	}
+	path := filepath.Join(cfg.Distribution.OutputPath, file)
+	if err = os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write the SBOM: %w", err)
+	}
+	cfg.Logger.Info("SBOM created", zap.String("path", path))
+	return nil
+}
+
+// parseGoModules decodes the stream of JSON objects written by "go list -m -json".
+func parseGoModules(r io.Reader) ([]goModule, error) {
+	var mods []goModule
+	dec := json.NewDecoder(r)
+	for {
+		var mod goModule
+		err := dec.Decode(&mod)
+		if errors.Is(err, io.EOF) {
+			return mods, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		mods = append(mods, mod)
+	}
+}
+
+// resolved returns the path and version of the module actually used for the build.
+func (m goModule) resolved() (string, string) {
+	if m.Replace != nil && m.Replace.Version != "" {
+		return m.Replace.Path, m.Replace.Version
+	}
+	return m.Path, m.Version
+}
+
+func purl(path, version string) string {
+	if version == "" {
+		return "pkg:golang/" + path
+	}
+	return "pkg:golang/" + path + "@" + version
+}
+
+type cycloneDXBOM struct {
+	BOMFormat    string                `json:"bomFormat"`
+	SpecVersion  string                `json:"specVersion"`
+	Version      int                   `json:"version"`
+	Metadata     cycloneDXMetadata     `json:"metadata"`
+	Components   []cycloneDXComponent  `json:"components"`
+	Dependencies []cycloneDXDependency `json:"dependencies"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string             `json:"timestamp"`
+	Component cycloneDXComponent `json:"component"`
+}
+
+type cycloneDXComponent struct {
+	Type        string `json:"type"`
+	BOMRef      string `json:"bom-ref"`
+	Name        string `json:"name"`
+	Version     string `json:"version,omitempty"`
+	Description string `json:"description,omitempty"`
+	PURL        string `json:"purl,omitempty"`
+}
+
+type cycloneDXDependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn"`
+}
+
+func newCycloneDXBOM(dist Distribution, mods []goModule, now time.Time) cycloneDXBOM {
+	app := cycloneDXComponent{
+		Type:        "application",
+		BOMRef:      purl(dist.Module, dist.Version),
+		Name:        dist.Name,
+		Version:     dist.Version,
+		Description: dist.Description,
+		PURL:        purl(dist.Module, dist.Version),
+	}
+	bom := cycloneDXBOM{
+		BOMFormat:   "CycloneDX",
+		SpecVersion: "1.5",
+		Version:     1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: now.UTC().Format(time.RFC3339),
+			Component: app,
+		},
+		Components: []cycloneDXComponent{},
+	}
+	deps := cycloneDXDependency{Ref: app.BOMRef, DependsOn: []string{}}
+	for _, mod := range mods {
+		if mod.Main {
+			continue
+		}
+		path, version := mod.resolved()
+		ref := purl(path, version)
+		bom.Components = append(bom.Components, cycloneDXComponent{
+			Type:    "library",
+			BOMRef:  ref,
+			Name:    path,
+			Version: version,
+			PURL:    ref,
+		})
+		deps.DependsOn = append(deps.DependsOn, ref)
+	}
+	bom.Dependencies = []cycloneDXDependency{deps}
+	return bom
+}
+
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	Name             string            `json:"name"`
+	SPDXID           string            `json:"SPDXID"`
+	VersionInfo      string            `json:"versionInfo,omitempty"`
+	DownloadLocation string            `json:"downloadLocation"`
+	FilesAnalyzed    bool              `json:"filesAnalyzed"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs,omitempty"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+// spdxIDInvalidChars matches the characters not allowed in SPDX identifiers.
+var spdxIDInvalidChars = regexp.MustCompile(`[^a-zA-Z0-9.-]+`)
+
+func spdxPackageID(path, version string) string {
+	return "SPDXRef-Package-" + spdxIDInvalidChars.ReplaceAllString(path+"-"+version, "-")
+}
+
+func newSPDXPackage(path, version string) spdxPackage {
+	return spdxPackage{
+		Name:             path,
+		SPDXID:           spdxPackageID(path, version),
+		VersionInfo:      version,
+		DownloadLocation: "NOASSERTION",
+		ExternalRefs: []spdxExternalRef{{
+			ReferenceCategory: "PACKAGE-MANAGER",
+			ReferenceType:     "purl",
+			ReferenceLocator:  purl(path, version),
+		}},
+	}
+}
+
+func newSPDXDocument(dist Distribution, mods []goModule, now time.Time) spdxDocument {
+	app := newSPDXPackage(dist.Module, dist.Version)
+	app.Name = dist.Name
+	doc := spdxDocument{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              dist.Name,
+		DocumentNamespace: fmt.Sprintf("https://%s/spdx/%s-%s-%d", dist.Module, dist.Name, dist.Version, now.UnixNano()),
+		CreationInfo: spdxCreationInfo{
+			Created:  now.UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: ocb"},
+		},
+		Packages: []spdxPackage{app},
+		Relationships: []spdxRelationship{{
+			SPDXElementID:      "SPDXRef-DOCUMENT",
+			RelationshipType:   "DESCRIBES",
+			RelatedSPDXElement: app.SPDXID,
+		}},
+	}
+	for _, mod := range mods {
+		if mod.Main {
+			continue
+		}
+		pkg := newSPDXPackage(mod.resolved())
+		doc.Packages = append(doc.Packages, pkg)
+		doc.Relationships = append(doc.Relationships, spdxRelationship{
+			SPDXElementID:      app.SPDXID,
+			RelationshipType:   "DEPENDS_ON",
+			RelatedSPDXElement: pkg.SPDXID,
+		})
+	}
+	return doc
+}
@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package builder
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const goListOutput = `{
+	"Path": "example.com/otelcol-custom",
+	"Main": true,
+	"Dir": "/tmp/otelcol-custom"
+}
+{
+	"Path": "go.opentelemetry.io/collector/receiver/otlpreceiver",
+	"Version": "v0.83.0"
+}
+{
+	"Path": "go.opentelemetry.io/collector/exporter/loggingexporter",
+	"Version": "v0.83.0",
+	"Replace": {
+		"Path": "../loggingexporter",
+		"Dir": "/tmp/loggingexporter"
+	}
+}
+{
+	"Path": "github.com/org/repo",
+	"Version": "v0.1.0",
+	"Replace": {
+		"Path": "github.com/fork/repo",
+		"Version": "v0.1.1"
+	}
+}
+`
+
+var testDistribution = Distribution{
+	Module:      "example.com/otelcol-custom",
+	Name:        "otelcol-custom",
+	Description: "Custom distribution",
+	Version:     "1.0.0",
+}
+
+func TestParseGoModules(t *testing.T) {
+	mods, err := parseGoModules(strings.NewReader(goListOutput))
+	require.NoError(t, err)
+	require.Len(t, mods, 4)
+	assert.True(t, mods[0].Main)
+
+	path, version := mods[2].resolved()
+	assert.Equal(t, "go.opentelemetry.io/collector/exporter/loggingexporter", path)
+	assert.Equal(t, "v0.83.0", version)
+
+	path, version = mods[3].resolved()
+	assert.Equal(t, "github.com/fork/repo", path)
+	assert.Equal(t, "v0.1.1", version)
+
+	_, err = parseGoModules(strings.NewReader("{"))
+	assert.Error(t, err)
+}
+
+func TestNewCycloneDXBOM(t *testing.T) {
+	mods, err := parseGoModules(strings.NewReader(goListOutput))
+	require.NoError(t, err)
+
+	bom := newCycloneDXBOM(testDistribution, mods, time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, "CycloneDX", bom.BOMFormat)
+	assert.Equal(t, "2023-08-01T00:00:00Z", bom.Metadata.Timestamp)
+	assert.Equal(t, "otelcol-custom", bom.Metadata.Component.Name)
+	assert.Equal(t, "pkg:golang/example.com/otelcol-custom@1.0.0", bom.Metadata.Component.PURL)
+
+	var purls []string
+	for _, c := range bom.Components {
+		purls = append(purls, c.PURL)
+	}
+	assert.Equal(t, []string{
+		"pkg:golang/go.opentelemetry.io/collector/receiver/otlpreceiver@v0.83.0",
+		"pkg:golang/go.opentelemetry.io/collector/exporter/loggingexporter@v0.83.0",
+		"pkg:golang/github.com/fork/repo@v0.1.1",
+	}, purls)
+	require.Len(t, bom.Dependencies, 1)
+	assert.Equal(t, purls, bom.Dependencies[0].DependsOn)
+}
+
+func TestNewSPDXDocument(t *testing.T) {
+	mods, err := parseGoModules(strings.NewReader(goListOutput))
+	require.NoError(t, err)
+
+	doc := newSPDXDocument(testDistribution, mods, time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, "SPDX-2.3", doc.SPDXVersion)
+	assert.Equal(t, "2023-08-01T00:00:00Z", doc.CreationInfo.Created)
+	require.Len(t, doc.Packages, 4)
+	assert.Equal(t, "otelcol-custom", doc.Packages[0].Name)
+	assert.Equal(t, "SPDXRef-Package-example.com-otelcol-custom-1.0.0", doc.Packages[0].SPDXID)
+	assert.Equal(t, "SPDXRef-Package-github.com-fork-repo-v0.1.1", doc.Packages[3].SPDXID)
+	assert.Equal(t, "pkg:golang/github.com/fork/repo@v0.1.1", doc.Packages[3].ExternalRefs[0].ReferenceLocator)
+
+	require.Len(t, doc.Relationships, 4)
+	assert.Equal(t, spdxRelationship{
+		SPDXElementID:      "SPDXRef-DOCUMENT",
+		RelationshipType:   "DESCRIBES",
+		RelatedSPDXElement: doc.Packages[0].SPDXID,
+	}, doc.Relationships[0])
+	for i, rel := range doc.Relationships[1:] {
+		assert.Equal(t, "DEPENDS_ON", rel.RelationshipType)
+		assert.Equal(t, doc.Packages[i+1].SPDXID, rel.RelatedSPDXElement)
+	}
+}
+
+func TestGenerateSBOMDisabled(t *testing.T) {
+	cfg := Config{Logger: zap.NewNop(), Distribution: Distribution{OutputPath: "/:invalid"}}
+	assert.NoError(t, GenerateSBOM(cfg))
+}
@@ -29,6 +29,10 @@ var (
 	mainWindowsBytes    []byte
 	mainWindowsTemplate = parseTemplate("main_windows.go", mainWindowsBytes)
 
+	//go:embed templates/Dockerfile.tmpl
+	dockerfileBytes    []byte
+	dockerfileTemplate = parseTemplate("Dockerfile", dockerfileBytes)
+
 	//go:embed templates/go.mod.tmpl
 	goModBytes    []byte
 	goModTemplate = parseTemplate("go.mod", goModBytes)
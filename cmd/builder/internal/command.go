@@ -109,6 +109,8 @@ configuration is provided, ocb will generate a default Collector.
 	}
 	// version of this binary
 	cmd.AddCommand(versionCommand())
+	// skeleton of new components
+	cmd.AddCommand(newComponentCommand())
 
 	return cmd, nil
 }
@@ -159,6 +161,8 @@ func applyCfgFromFile(flags *flag.FlagSet, cfgFromFile builder.Config) {
 	cfg.Receivers = cfgFromFile.Receivers
 	cfg.Processors = cfgFromFile.Processors
 	cfg.Connectors = cfgFromFile.Connectors
+	cfg.Providers = cfgFromFile.Providers
+	cfg.Converters = cfgFromFile.Converters
 	cfg.Replaces = cfgFromFile.Replaces
 	cfg.Excludes = cfgFromFile.Excludes
 
@@ -193,4 +197,6 @@ func applyCfgFromFile(flags *flag.FlagSet, cfgFromFile builder.Config) {
 		cfg.Distribution.Module = cfgFromFile.Distribution.Module
 	}
 	cfg.Distribution.DebugCompilation = cfgFromFile.Distribution.DebugCompilation
+	cfg.Distribution.Dockerfile = cfgFromFile.Distribution.Dockerfile
+	cfg.Distribution.SBOM = cfgFromFile.Distribution.SBOM
 }
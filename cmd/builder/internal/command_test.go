@@ -65,6 +65,8 @@ func Test_applyCfgFromFile(t *testing.T) {
 		Version:          "testVersion",
 		BuildTags:        "",
 		DebugCompilation: true,
+		Dockerfile:       true,
+		SBOM:             "spdx",
 	}
 	testStringTable := []string{"A", "B", "C"}
 	testModule := builder.Module{
@@ -108,6 +110,25 @@ func Test_applyCfgFromFile(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "providers and converters are applied correctly",
+			args: args{
+				flags: flag.NewFlagSet("version=1.0.0", 1),
+				cfgFromFile: builder.Config{
+					Logger:       zap.NewNop(),
+					Distribution: testDistribution,
+					Providers:    []builder.Module{testModule},
+					Converters:   []builder.Module{testModule},
+				},
+			},
+			want: builder.Config{
+				Logger:       zap.NewNop(),
+				Distribution: testDistribution,
+				Providers:    []builder.Module{testModule},
+				Converters:   []builder.Module{testModule},
+			},
+			wantErr: false,
+		},
 		{
 			name: "Skip compilation false",
 			args: args{
@@ -233,6 +254,8 @@ func Test_applyCfgFromFile(t *testing.T) {
 			assert.Equal(t, tt.want.Receivers, cfg.Receivers)
 			assert.Equal(t, tt.want.Processors, cfg.Processors)
 			assert.Equal(t, tt.want.Replaces, cfg.Replaces)
+			assert.Equal(t, tt.want.Providers, cfg.Providers)
+			assert.Equal(t, tt.want.Converters, cfg.Converters)
 		})
 	}
 }
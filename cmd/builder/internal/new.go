@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "go.opentelemetry.io/collector/cmd/builder/internal"
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go.opentelemetry.io/collector/cmd/builder/internal/scaffold"
+)
+
+func newComponentCommand() *cobra.Command {
+	set := scaffold.Settings{}
+	var manifest string
+	var skipGetModules bool
+
+	cmd := &cobra.Command{
+		Use:   "new <kind> <name>",
+		Short: "Generates the skeleton of a new component",
+		Long: fmt.Sprintf(`Generates a Go module with the skeleton of a new component, with its tests and README.
+
+The kind of the component is one of: %s.
+The name is the name of the Go package, e.g. "fooreceiver" for a receiver of type "foo".`, strings.Join(scaffold.Kinds, ", ")),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			set.Kind, set.Name = args[0], args[1]
+			if set.Module == "" {
+				set.Module = set.Name
+			}
+			if set.OutputPath == "" {
+				set.OutputPath = set.Name
+			}
+			if !skipGetModules {
+				path, err := exec.LookPath("go")
+				if err != nil {
+					return fmt.Errorf("go not found: %w", err)
+				}
+				set.Go = path
+			}
+
+			if err := scaffold.Generate(set); err != nil {
+				return err
+			}
+			cmd.Printf("Generated the %s %q in %s\n", set.Kind, set.Name, set.OutputPath)
+
+			if manifest == "" {
+				return nil
+			}
+			if err := scaffold.AddToManifest(manifest, set); err != nil {
+				return err
+			}
+			cmd.Printf("Added the %s %q to %s\n", set.Kind, set.Name, manifest)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&set.Module, "module", "", "The Go module of the component. Default: the name of the component")
+	cmd.Flags().StringVar(&set.OutputPath, "output-path", "", "Where to write the component. Default: a directory named after the component")
+	cmd.Flags().StringVar(&set.OtelColVersion, "otelcol-version", cfg.Distribution.OtelColVersion, "Which version of the OpenTelemetry Collector modules the component depends on")
+	cmd.Flags().StringVar(&manifest, "manifest", "", "An existing build configuration file to add the component to")
+	cmd.Flags().BoolVar(&set.Force, "force", false, "Whether to write the component to a non-empty output path, overwriting its files (default false)")
+	cmd.Flags().BoolVar(&skipGetModules, skipGetModulesFlag, false, "Whether to skip updating go.mod and retrieving the Go modules of the component (default false)")
+	return cmd
+}
@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scaffold // import "go.opentelemetry.io/collector/cmd/builder/internal/scaffold"
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// localModuleVersion is the version used in the manifest for the generated
+// modules, which are replaced by their local path.
+const localModuleVersion = "v0.0.0"
+
+// AddToManifest adds the generated component to the given builder manifest,
+// in the section of its kind. The path of the component is written relative
+// to the directory of the manifest.
+func AddToManifest(manifest string, set Settings) error {
+	data, err := os.ReadFile(filepath.Clean(manifest))
+	if err != nil {
+		return fmt.Errorf("failed to read the manifest: %w", err)
+	}
+
+	var doc yaml.Node
+	if err = yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("failed to parse the manifest: %w", err)
+	}
+	if doc.Kind == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return errors.New("failed to parse the manifest: not a mapping")
+	}
+
+	modulePath, err := filepath.Rel(filepath.Dir(manifest), set.OutputPath)
+	if err != nil {
+		modulePath = set.OutputPath
+	}
+	if !filepath.IsAbs(modulePath) && !strings.HasPrefix(modulePath, ".") {
+		modulePath = "./" + modulePath
+	}
+
+	section := findSection(root, set.Kind+"s")
+	for _, entry := range section.Content {
+		if gomod := mappingValue(entry, "gomod"); gomod == set.Module || strings.HasPrefix(gomod, set.Module+" ") {
+			return fmt.Errorf("module %q is already in the manifest", set.Module)
+		}
+	}
+	section.Content = append(section.Content, &yaml.Node{
+		Kind: yaml.MappingNode,
+		Content: []*yaml.Node{
+			{Kind: yaml.ScalarNode, Value: "gomod"},
+			{Kind: yaml.ScalarNode, Value: set.Module + " " + localModuleVersion},
+			{Kind: yaml.ScalarNode, Value: "path"},
+			{Kind: yaml.ScalarNode, Value: filepath.ToSlash(modulePath)},
+		},
+	})
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err = enc.Encode(&doc); err != nil {
+		return fmt.Errorf("failed to write the manifest: %w", err)
+	}
+	if err = enc.Close(); err != nil {
+		return fmt.Errorf("failed to write the manifest: %w", err)
+	}
+	return os.WriteFile(manifest, buf.Bytes(), 0600)
+}
+
+// findSection returns the sequence with the given key, adding it when missing.
+func findSection(root *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value == key {
+			section := root.Content[i+1]
+			// An empty section, e.g. "receivers:", is parsed as a null scalar.
+			if section.Kind != yaml.SequenceNode {
+				*section = yaml.Node{Kind: yaml.SequenceNode}
+			}
+			return section
+		}
+	}
+	section := &yaml.Node{Kind: yaml.SequenceNode}
+	root.Content = append(root.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, section)
+	return section
+}
+
+func mappingValue(node *yaml.Node, key string) string {
+	if node.Kind != yaml.MappingNode {
+		return ""
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1].Value
+		}
+	}
+	return ""
+}
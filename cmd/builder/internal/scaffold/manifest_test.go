@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scaffold
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testManifest = `dist:
+  name: otelcol-custom # the binary name
+receivers:
+  - gomod: go.opentelemetry.io/collector/receiver/otlpreceiver v0.83.0
+exporters:
+`
+
+func TestAddToManifest(t *testing.T) {
+	dir := t.TempDir()
+	manifest := filepath.Join(dir, "builder-config.yaml")
+	require.NoError(t, os.WriteFile(manifest, []byte(testManifest), 0600))
+
+	require.NoError(t, AddToManifest(manifest, Settings{
+		Kind:       "receiver",
+		Module:     "example.com/fooreceiver",
+		OutputPath: filepath.Join(dir, "fooreceiver"),
+	}))
+	require.NoError(t, AddToManifest(manifest, Settings{
+		Kind:       "exporter",
+		Module:     "example.com/fooexporter",
+		OutputPath: filepath.Join(dir, "components", "fooexporter"),
+	}))
+	require.NoError(t, AddToManifest(manifest, Settings{
+		Kind:       "processor",
+		Module:     "example.com/fooprocessor",
+		OutputPath: filepath.Join(dir, "fooprocessor"),
+	}))
+
+	data, err := os.ReadFile(manifest)
+	require.NoError(t, err)
+	assert.Equal(t, `dist:
+  name: otelcol-custom # the binary name
+receivers:
+  - gomod: go.opentelemetry.io/collector/receiver/otlpreceiver v0.83.0
+  - gomod: example.com/fooreceiver v0.0.0
+    path: ./fooreceiver
+exporters:
+  - gomod: example.com/fooexporter v0.0.0
+    path: ./components/fooexporter
+processors:
+  - gomod: example.com/fooprocessor v0.0.0
+    path: ./fooprocessor
+`, string(data))
+
+	assert.ErrorContains(t, AddToManifest(manifest, Settings{
+		Kind:       "receiver",
+		Module:     "example.com/fooreceiver",
+		OutputPath: filepath.Join(dir, "fooreceiver"),
+	}), `module "example.com/fooreceiver" is already in the manifest`)
+}
+
+func TestAddToManifestErrors(t *testing.T) {
+	dir := t.TempDir()
+	set := Settings{Kind: "receiver", Module: "example.com/fooreceiver", OutputPath: dir}
+	assert.ErrorContains(t, AddToManifest(filepath.Join(dir, "missing.yaml"), set), "failed to read the manifest")
+
+	manifest := filepath.Join(dir, "builder-config.yaml")
+	require.NoError(t, os.WriteFile(manifest, []byte("- not a mapping"), 0600))
+	assert.ErrorContains(t, AddToManifest(manifest, set), "not a mapping")
+}
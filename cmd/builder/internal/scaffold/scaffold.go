@@ -0,0 +1,166 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package scaffold generates the skeleton of new collector components.
+package scaffold // import "go.opentelemetry.io/collector/cmd/builder/internal/scaffold"
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+// Kinds lists the kinds of components that can be generated.
+var Kinds = []string{"receiver", "processor", "exporter", "connector", "extension"}
+
+var (
+	errInvalidKind = fmt.Errorf("invalid kind, must be one of %s", strings.Join(Kinds, ", "))
+	errInvalidName = errors.New("invalid name, must be a lowercase Go package name, e.g. \"fooreceiver\"")
+
+	nameRegexp = regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+
+	//go:embed templates
+	templatesFS embed.FS
+)
+
+// Settings configures the generation of a component.
+type Settings struct {
+	// Kind is the kind of the component, one of Kinds.
+	Kind string
+	// Name is the name of the Go package of the component, e.g. "fooreceiver".
+	Name string
+	// Module is the path of the Go module of the component.
+	Module string
+	// OutputPath is the directory where the module is written.
+	OutputPath string
+	// OtelColVersion is the version of the collector modules the component depends on.
+	OtelColVersion string
+	// Replaces are "replace" directives added to the go.mod of the component.
+	Replaces []string
+	// Go is the Go binary used to update the go.mod of the component.
+	// The go.mod is not updated when empty.
+	Go string
+	// Force allows writing to a non-empty output path, overwriting the existing files.
+	Force bool
+}
+
+// templateData is the data available to the templates.
+type templateData struct {
+	Settings
+	// Type is the type of the component, the name without the kind suffix, e.g. "foo".
+	Type string
+	// KindTitle is the kind of the component starting with an uppercase letter.
+	KindTitle string
+}
+
+// Validate checks whether the settings are valid.
+func (s Settings) Validate() error {
+	valid := false
+	for _, kind := range Kinds {
+		valid = valid || s.Kind == kind
+	}
+	if !valid {
+		return fmt.Errorf("%q: %w", s.Kind, errInvalidKind)
+	}
+	if !nameRegexp.MatchString(s.Name) {
+		return fmt.Errorf("%q: %w", s.Name, errInvalidName)
+	}
+	if s.Module == "" {
+		return errors.New("module must not be empty")
+	}
+	if s.OutputPath == "" {
+		return errors.New("output path must not be empty")
+	}
+	return nil
+}
+
+func (s Settings) componentType() string {
+	if t := strings.TrimSuffix(s.Name, s.Kind); t != "" {
+		return t
+	}
+	return s.Name
+}
+
+// Generate writes the skeleton of a new component module, with its tests and README.
+func Generate(set Settings) error {
+	if err := set.Validate(); err != nil {
+		return err
+	}
+	if !set.Force {
+		entries, err := os.ReadDir(set.OutputPath)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to read output path: %w", err)
+		}
+		if len(entries) > 0 {
+			return fmt.Errorf("output path %q is not empty, force the generation to overwrite its files", set.OutputPath)
+		}
+	}
+	if err := os.MkdirAll(set.OutputPath, 0750); err != nil {
+		return fmt.Errorf("failed to create output path: %w", err)
+	}
+
+	data := templateData{
+		Settings:  set,
+		Type:      set.componentType(),
+		KindTitle: strings.ToUpper(set.Kind[:1]) + set.Kind[1:],
+	}
+	for _, dir := range []string{"templates/common", path.Join("templates", set.Kind)} {
+		if err := generateDir(dir, set.OutputPath, data); err != nil {
+			return err
+		}
+	}
+
+	if set.Go == "" {
+		return nil
+	}
+	// #nosec G204 -- set.Go is trusted to be a safe path
+	cmd := exec.Command(set.Go, "mod", "tidy", "-compat=1.20")
+	cmd.Dir = set.OutputPath
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to update go.mod: %w. Output:\n%s", err, out)
+	}
+	return nil
+}
+
+func generateDir(dir string, outputPath string, data templateData) error {
+	entries, err := fs.ReadDir(templatesFS, dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		name := strings.TrimSuffix(entry.Name(), ".tmpl")
+		tmpl, err := template.ParseFS(templatesFS, path.Join(dir, entry.Name()))
+		if err != nil {
+			return err
+		}
+		if err = writeTemplate(tmpl, filepath.Join(outputPath, name), data, data.Force); err != nil {
+			return fmt.Errorf("failed to generate file %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// writeTemplate writes the template to the file, which must not exist unless overwrite is true.
+func writeTemplate(tmpl *template.Template, file string, data templateData, overwrite bool) error {
+	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if !overwrite {
+		flags |= os.O_EXCL
+	}
+	out, err := os.OpenFile(filepath.Clean(file), flags, 0600)
+	if err != nil {
+		return err
+	}
+	if err = tmpl.Execute(out, data); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,191 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scaffold
+
+import (
+	"bufio"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	valid := Settings{Kind: "receiver", Name: "fooreceiver", Module: "example.com/fooreceiver", OutputPath: "fooreceiver"}
+	assert.NoError(t, valid.Validate())
+
+	invalid := valid
+	invalid.Kind = "invalid"
+	assert.ErrorIs(t, invalid.Validate(), errInvalidKind)
+
+	for _, name := range []string{"", "FooReceiver", "foo-receiver", "foo_receiver", "1receiver"} {
+		invalid = valid
+		invalid.Name = name
+		assert.ErrorIs(t, invalid.Validate(), errInvalidName)
+	}
+
+	invalid = valid
+	invalid.Module = ""
+	assert.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.OutputPath = ""
+	assert.Error(t, invalid.Validate())
+}
+
+func TestComponentType(t *testing.T) {
+	assert.Equal(t, "foo", Settings{Kind: "receiver", Name: "fooreceiver"}.componentType())
+	assert.Equal(t, "foo", Settings{Kind: "exporter", Name: "foo"}.componentType())
+	assert.Equal(t, "exporter", Settings{Kind: "exporter", Name: "exporter"}.componentType())
+}
+
+func TestGenerateFiles(t *testing.T) {
+	set := Settings{
+		Kind:           "processor",
+		Name:           "fooprocessor",
+		Module:         "example.com/fooprocessor",
+		OutputPath:     filepath.Join(t.TempDir(), "fooprocessor"),
+		OtelColVersion: "0.83.0",
+		Replaces:       []string{"go.opentelemetry.io/collector => ../collector"},
+	}
+	require.NoError(t, Generate(set))
+
+	var files []string
+	entries, err := os.ReadDir(set.OutputPath)
+	require.NoError(t, err)
+	for _, entry := range entries {
+		files = append(files, entry.Name())
+	}
+	assert.ElementsMatch(t, []string{
+		"README.md", "config.go", "config_test.go", "doc.go", "factory.go", "factory_test.go", "go.mod", "processor.go",
+	}, files)
+
+	goMod, err := os.ReadFile(filepath.Join(set.OutputPath, "go.mod"))
+	require.NoError(t, err)
+	assert.Contains(t, string(goMod), "module example.com/fooprocessor")
+	assert.Contains(t, string(goMod), "go.opentelemetry.io/collector/processor v0.83.0")
+	assert.Contains(t, string(goMod), "replace go.opentelemetry.io/collector => ../collector")
+
+	factory, err := os.ReadFile(filepath.Join(set.OutputPath, "factory.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(factory), `typeStr   = "foo"`)
+}
+
+func TestGenerateExistingFiles(t *testing.T) {
+	set := Settings{
+		Kind:       "exporter",
+		Name:       "fooexporter",
+		Module:     "example.com/fooexporter",
+		OutputPath: t.TempDir(),
+	}
+	config := filepath.Join(set.OutputPath, "config.go")
+	require.NoError(t, os.WriteFile(config, []byte("package fooexporter\n"), 0600))
+
+	// The existing files are not overwritten.
+	err := Generate(set)
+	assert.ErrorContains(t, err, "is not empty")
+	content, err := os.ReadFile(config)
+	require.NoError(t, err)
+	assert.Equal(t, "package fooexporter\n", string(content))
+	_, err = os.Stat(filepath.Join(set.OutputPath, "factory.go"))
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+
+	set.Force = true
+	require.NoError(t, Generate(set))
+	content, err = os.ReadFile(config)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "type Config struct")
+}
+
+func TestWriteTemplateExistingFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "doc.go")
+	require.NoError(t, os.WriteFile(file, []byte("existing"), 0600))
+	tmpl := template.Must(template.New("doc").Parse("generated"))
+
+	assert.ErrorIs(t, writeTemplate(tmpl, file, templateData{}, false), fs.ErrExist)
+	content, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.Equal(t, "existing", string(content))
+
+	require.NoError(t, writeTemplate(tmpl, file, templateData{}, true))
+	content, err = os.ReadFile(file)
+	require.NoError(t, err)
+	assert.Equal(t, "generated", string(content))
+}
+
+func TestGenerateAndTest(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping the test on Windows, see https://github.com/open-telemetry/opentelemetry-collector/issues/5403")
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go binary not found")
+	}
+	replaces := workspaceReplaces(t)
+
+	for _, kind := range Kinds {
+		t.Run(kind, func(t *testing.T) {
+			set := Settings{
+				Kind:           kind,
+				Name:           "foo" + kind,
+				Module:         "example.com/foo" + kind,
+				OutputPath:     filepath.Join(t.TempDir(), "foo"+kind),
+				OtelColVersion: "0.83.0",
+				Replaces:       replaces,
+				Go:             goBin,
+			}
+			require.NoError(t, Generate(set))
+
+			for _, args := range [][]string{{"vet", "./..."}, {"test", "./..."}} {
+				// #nosec G204
+				cmd := exec.Command(goBin, args...)
+				cmd.Dir = set.OutputPath
+				out, err := cmd.CombinedOutput()
+				require.NoError(t, err, string(out))
+			}
+		})
+	}
+}
+
+// workspaceReplaces returns the "replace" directives for all the modules of this repository.
+func workspaceReplaces(t *testing.T) []string {
+	// This test is dependent on the current file structure.
+	_, thisFile, _, _ := runtime.Caller(0)
+	workspaceDir := filepath.Dir(filepath.Dir(filepath.Dir(filepath.Dir(filepath.Dir(thisFile)))))
+
+	var replaces []string
+	err := filepath.WalkDir(workspaceDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() && (d.Name() == ".git" || d.Name() == "testdata") {
+			return filepath.SkipDir
+		}
+		if d.Name() != "go.mod" {
+			return nil
+		}
+		f, err := os.Open(filepath.Clean(path))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			if module, ok := strings.CutPrefix(scanner.Text(), "module "); ok {
+				replaces = append(replaces, module+" => "+filepath.Dir(path))
+				break
+			}
+		}
+		return scanner.Err()
+	})
+	require.NoError(t, err)
+	return replaces
+}
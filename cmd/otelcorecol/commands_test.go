@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/otelcol"
+)
+
+func TestPrintConfigOTLPHTTP(t *testing.T) {
+	factories, err := components()
+	require.NoError(t, err)
+	set := otelcol.CollectorSettings{Factories: factories}
+
+	unmarshalers := map[string]func([]byte, any) error{
+		"yaml": yaml.Unmarshal,
+		"json": json.Unmarshal,
+	}
+	for format, unmarshal := range unmarshalers {
+		t.Run(format, func(t *testing.T) {
+			out := new(bytes.Buffer)
+			cmd := otelcol.NewCommand(set)
+			cmd.SetOut(out)
+			cmd.SetArgs([]string{"print-config", "--config", filepath.Join("testdata", "otlphttp.yaml"), "--format", format})
+			require.NoError(t, cmd.Execute())
+
+			var printed map[string]any
+			require.NoError(t, unmarshal(out.Bytes(), &printed))
+			conf := confmap.NewFromStringMap(printed)
+			assert.Equal(t, "https://1.2.3.4:1234", conf.Get("exporters::otlphttp::endpoint"))
+			// Only the sensitive values which are set are redacted.
+			assert.Equal(t, "[REDACTED]", conf.Get("exporters::otlphttp::headers::authorization"))
+			assert.Equal(t, "", conf.Get("exporters::otlphttp::headers::empty"))
+			assert.False(t, conf.IsSet("exporters::otlphttp::customroundtripper"))
+		})
+	}
+}
+
+func TestConfigSchemaOTLPHTTP(t *testing.T) {
+	factories, err := components()
+	require.NoError(t, err)
+
+	out := new(bytes.Buffer)
+	cmd := otelcol.NewCommand(otelcol.CollectorSettings{Factories: factories})
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{"components", "--schema"})
+	require.NoError(t, cmd.Execute())
+
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(out.Bytes(), &schema))
+	conf := confmap.NewFromStringMap(schema)
+	exporter, ok := conf.Get(`properties::exporters::patternProperties::^otlphttp(/.+)?$`).(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, false, exporter["additionalProperties"])
+	properties, ok := exporter["properties"].(map[string]any)
+	require.True(t, ok)
+	for _, key := range []string{"endpoint", "headers", "timeout", "tls", "sending_queue", "retry_on_failure", "compression"} {
+		assert.Contains(t, properties, key)
+	}
+	// The hooks set by the components are not part of the configuration.
+	assert.NotContains(t, properties, "CustomRoundTripper")
+	assert.NotContains(t, properties, "customroundtripper")
+}
@@ -3,7 +3,12 @@
 package main
 
 import (
+	"runtime/debug"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/connector"
+	countconnector "go.opentelemetry.io/collector/connector/countconnector"
 	forwardconnector "go.opentelemetry.io/collector/connector/forwardconnector"
 	"go.opentelemetry.io/collector/exporter"
 	loggingexporter "go.opentelemetry.io/collector/exporter/loggingexporter"
@@ -18,6 +23,7 @@ import (
 	memorylimiterprocessor "go.opentelemetry.io/collector/processor/memorylimiterprocessor"
 	"go.opentelemetry.io/collector/receiver"
 	otlpreceiver "go.opentelemetry.io/collector/receiver/otlpreceiver"
+	selftelemetryreceiver "go.opentelemetry.io/collector/receiver/selftelemetryreceiver"
 )
 
 func components() (otelcol.Factories, error) {
@@ -34,6 +40,7 @@ func components() (otelcol.Factories, error) {
 
 	factories.Receivers, err = receiver.MakeFactoryMap(
 		otlpreceiver.NewFactory(),
+		selftelemetryreceiver.NewFactory(),
 	)
 	if err != nil {
 		return otelcol.Factories{}, err
@@ -57,6 +64,7 @@ func components() (otelcol.Factories, error) {
 	}
 
 	factories.Connectors, err = connector.MakeFactoryMap(
+		countconnector.NewFactory(),
 		forwardconnector.NewFactory(),
 	)
 	if err != nil {
@@ -65,3 +73,54 @@ func components() (otelcol.Factories, error) {
 
 	return factories, nil
 }
+
+// componentModules returns the Go module providing each component, with the
+// version it was built with, as recorded in the binary.
+func componentModules() map[component.Kind]map[component.Type]string {
+	if _, ok := debug.ReadBuildInfo(); !ok {
+		return nil
+	}
+	return map[component.Kind]map[component.Type]string{
+		component.KindExtension: {
+			ballastextension.NewFactory().Type(): componentModule("go.opentelemetry.io/collector/extension/ballastextension"),
+			zpagesextension.NewFactory().Type():  componentModule("go.opentelemetry.io/collector/extension/zpagesextension"),
+		},
+		component.KindReceiver: {
+			otlpreceiver.NewFactory().Type():          componentModule("go.opentelemetry.io/collector/receiver/otlpreceiver"),
+			selftelemetryreceiver.NewFactory().Type(): componentModule("go.opentelemetry.io/collector/receiver/selftelemetryreceiver"),
+		},
+		component.KindExporter: {
+			loggingexporter.NewFactory().Type():  componentModule("go.opentelemetry.io/collector/exporter/loggingexporter"),
+			otlpexporter.NewFactory().Type():     componentModule("go.opentelemetry.io/collector/exporter/otlpexporter"),
+			otlphttpexporter.NewFactory().Type(): componentModule("go.opentelemetry.io/collector/exporter/otlphttpexporter"),
+		},
+		component.KindProcessor: {
+			batchprocessor.NewFactory().Type():         componentModule("go.opentelemetry.io/collector/processor/batchprocessor"),
+			memorylimiterprocessor.NewFactory().Type(): componentModule("go.opentelemetry.io/collector/processor/memorylimiterprocessor"),
+		},
+		component.KindConnector: {
+			countconnector.NewFactory().Type():   componentModule("go.opentelemetry.io/collector/connector/countconnector"),
+			forwardconnector.NewFactory().Type(): componentModule("go.opentelemetry.io/collector/connector/forwardconnector"),
+		},
+	}
+}
+
+// componentModule returns the module of the build providing the package, e.g.
+// "go.opentelemetry.io/collector/receiver/otlpreceiver v0.83.0".
+func componentModule(pkg string) string {
+	info, _ := debug.ReadBuildInfo()
+	mod := &info.Main
+	for _, dep := range info.Deps {
+		if (pkg == dep.Path || strings.HasPrefix(pkg, dep.Path+"/")) && len(dep.Path) > len(mod.Path) {
+			mod = dep
+		}
+	}
+	version := mod.Version
+	if mod.Replace != nil {
+		version = mod.Replace.Version
+	}
+	if version == "" {
+		version = "(devel)"
+	}
+	return mod.Path + " " + version
+}
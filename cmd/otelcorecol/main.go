@@ -20,9 +20,12 @@ func main() {
 		Command:     "otelcorecol",
 		Description: "Local OpenTelemetry Collector binary, testing only.",
 		Version:     "0.83.0-dev",
+		Modules:     componentModules(),
 	}
 
-	if err := run(otelcol.CollectorSettings{BuildInfo: info, Factories: factories}); err != nil {
+	set := otelcol.CollectorSettings{BuildInfo: info, Factories: factories}
+
+	if err := run(set); err != nil {
 		log.Fatal(err)
 	}
 }
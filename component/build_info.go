@@ -14,6 +14,11 @@ type BuildInfo struct {
 
 	// Version string.
 	Version string
+
+	// Modules maps the type of each component, per kind, to the Go module
+	// providing it, e.g. "go.opentelemetry.io/collector/receiver/otlpreceiver v0.83.0".
+	// It is optional, and set by the builder for the distributions it generates.
+	Modules map[Kind]map[Type]string `yaml:"-"`
 }
 
 // NewDefaultBuildInfo returns a default BuildInfo.
@@ -24,4 +24,8 @@ func TestNewNopTelemetrySettings(t *testing.T) {
 	})
 	assert.Equal(t, configtelemetry.LevelNone, nts.MetricsLevel)
 	assert.Equal(t, nts.Resource.Attributes().Len(), 0)
+	assert.NotNil(t, nts.FeatureGates)
+	assert.NotPanics(t, func() {
+		nts.FeatureGates.IsEnabled("test")
+	})
 }
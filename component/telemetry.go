@@ -29,4 +29,17 @@ type TelemetrySettings struct {
 
 	// Resource contains the resource attributes for the collector's telemetry.
 	Resource pcommon.Resource
+
+	// FeatureGates resolves the state of the feature gates for the component, with the overrides of
+	// the `feature_gates` key of its configuration. The gates must be resolved when the component is
+	// created: the service refuses overriding a gate which the component did not resolve.
+	// Experimental: *NOTE* this field is experimental and may be changed or removed.
+	FeatureGates FeatureGates
+}
+
+// FeatureGates resolves the state of the feature gates, e.g. a featuregate.View.
+// Experimental: *NOTE* this interface is experimental and may be changed or removed.
+type FeatureGates interface {
+	// IsEnabled returns true if the feature gate with the given ID is enabled.
+	IsEnabled(id string) bool
 }
@@ -14,7 +14,10 @@ const maskedString = "[REDACTED]"
 
 var _ encoding.TextMarshaler = String("")
 
-// MarshalText marshals the string as `[REDACTED]`.
+// MarshalText marshals the string as `[REDACTED]`, unless it is empty.
 func (s String) MarshalText() ([]byte, error) {
+	if s == "" {
+		return nil, nil
+	}
 	return []byte(maskedString), nil
 }
@@ -18,3 +18,9 @@ func TestStringMarshalText(t *testing.T) {
 		assert.Equal(t, "[REDACTED]", string(opaque))
 	}
 }
+
+func TestStringMarshalTextEmpty(t *testing.T) {
+	opaque, err := String("").MarshalText()
+	require.NoError(t, err)
+	assert.Empty(t, opaque)
+}
@@ -38,6 +38,8 @@ func NewFromStringMap(data map[string]any) *Conf {
 // The confmap.Conf can be unmarshalled into the Collector's config using the "service" package.
 type Conf struct {
 	k *koanf.Koanf
+	// origins holds where the value of each key comes from, see Origin.
+	origins map[string]Origin
 }
 
 // AllKeys returns all keys holding a value, regardless of where they are set.
@@ -110,9 +112,24 @@ func (l *Conf) IsSet(key string) bool {
 }
 
 // Merge merges the input given configuration into the existing config.
+// The lists of the input replace the existing lists, unless another MergeStrategy is set
+// with WithMergeStrategy, or by the key of the list with the "key@strategy" directive,
+// e.g. "exporters@append".
 // Note that the given map may be modified.
-func (l *Conf) Merge(in *Conf) error {
-	return l.k.Merge(in.k)
+func (l *Conf) Merge(in *Conf, opts ...MergeOption) error {
+	set := mergeOption{strategy: MergeReplace}
+	for _, opt := range opts {
+		opt.apply(&set)
+	}
+	if err := set.strategy.Validate(); err != nil {
+		return err
+	}
+	merged := mergeLists(l.ToStringMap(), in.ToStringMap(), set.strategy)
+	if err := l.k.Merge(NewFromStringMap(merged).k); err != nil {
+		return err
+	}
+	l.mergeOrigins(in)
+	return nil
 }
 
 // Sub returns new Conf instance representing a sub-config of this instance.
@@ -125,7 +142,9 @@ func (l *Conf) Sub(key string) (*Conf, error) {
 	}
 
 	if v, ok := data.(map[string]any); ok {
-		return NewFromStringMap(v), nil
+		sub := NewFromStringMap(v)
+		sub.origins = l.subOrigins(key)
+		return sub, nil
 	}
 
 	return nil, fmt.Errorf("unexpected sub-config value kind for key:%s value:%v kind:%v)", key, data, reflect.TypeOf(data).Kind())
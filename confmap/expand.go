@@ -11,6 +11,8 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+
+	"gopkg.in/yaml.v3"
 )
 
 // schemePattern defines the regexp pattern for scheme names.
@@ -139,7 +141,7 @@ func toString(strURI string, input any) (string, error) {
 }
 
 func (mr *Resolver) expandURI(ctx context.Context, uri string) (any, bool, error) {
-	lURI, err := newLocation(uri[2 : len(uri)-1])
+	lURI, fb, err := newLocationWithFallback(uri[2 : len(uri)-1])
 	if err != nil {
 		return nil, false, err
 	}
@@ -148,11 +150,84 @@ func (mr *Resolver) expandURI(ctx context.Context, uri string) (any, bool, error
 	}
 	ret, err := mr.retrieveValue(ctx, lURI)
 	if err != nil {
-		return nil, false, err
+		// The uri has no value when its provider fails to retrieve it, e.g. for a missing file,
+		// but an unsupported scheme is an error of the configuration.
+		if _, ok := mr.providers[lURI.scheme]; !ok || fb == nil {
+			return nil, false, err
+		}
+		return fb.resolve(lURI, err)
 	}
 	mr.closers = append(mr.closers, ret.Close)
+	mr.expanded = append(mr.expanded, lURI.asString())
 	val, err := ret.AsRaw()
-	return val, true, err
+	if err != nil || fb == nil || !isEmpty(val) {
+		return val, true, err
+	}
+	return fb.resolve(lURI, nil)
+}
+
+// fallback is what is used when an URI has no value, i.e. its provider returns an empty value or fails
+// to retrieve it, set after the location in the shell style: "${scheme:value:-default}" or
+// "${scheme:value:?error message}".
+type fallback struct {
+	// required is true if an URI without value is an error, with value as the message.
+	required bool
+	value    string
+}
+
+// resolve returns the value of the uri without value: the default value, or an error if a value is
+// required. The cause is the error of the provider failing to retrieve the value, if any.
+func (fb *fallback) resolve(lURI location, cause error) (any, bool, error) {
+	if !fb.required {
+		return fb.defaultValue(), true, nil
+	}
+	msg := fb.value
+	if msg == "" {
+		msg = "a value is required"
+	}
+	if cause != nil {
+		return nil, false, fmt.Errorf("the uri %q has no value: %s: %w", lURI.asString(), msg, cause)
+	}
+	return nil, false, fmt.Errorf("the uri %q has no value: %s", lURI.asString(), msg)
+}
+
+// defaultValue returns the default value, parsed as YAML like the values of the providers.
+func (fb *fallback) defaultValue() any {
+	var val any
+	if err := yaml.Unmarshal([]byte(fb.value), &val); err != nil {
+		return fb.value
+	}
+	return val
+}
+
+// isEmpty returns true if an URI has no value, e.g. an unset environment variable.
+func isEmpty(val any) bool {
+	if val == nil {
+		return true
+	}
+	rv := reflect.ValueOf(val)
+	return rv.Kind() == reflect.String && rv.Len() == 0
+}
+
+// newLocationWithFallback returns the location of the uri, and the fallback set after it, if any.
+func newLocationWithFallback(uri string) (location, *fallback, error) {
+	lURI, err := newLocation(uri)
+	if err != nil {
+		return location{}, nil, err
+	}
+	idx := strings.Index(lURI.opaqueValue, ":-")
+	if reqIdx := strings.Index(lURI.opaqueValue, ":?"); reqIdx >= 0 && (idx < 0 || reqIdx < idx) {
+		idx = reqIdx
+	}
+	if idx < 0 {
+		return lURI, nil, nil
+	}
+	fb := &fallback{
+		required: lURI.opaqueValue[idx+1] == '?',
+		value:    lURI.opaqueValue[idx+2:],
+	}
+	lURI.opaqueValue = lURI.opaqueValue[:idx]
+	return lURI, fb, nil
 }
 
 type location struct {
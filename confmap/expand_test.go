@@ -6,6 +6,7 @@ package confmap // import "go.opentelemetry.io/collector/confmap"
 import (
 	"context"
 	"errors"
+	"fmt"
 	"path/filepath"
 	"testing"
 
@@ -409,7 +410,7 @@ func TestResolverExpandInvalidScheme(t *testing.T) {
 
 	_, err = resolver.Resolve(context.Background())
 
-	assert.EqualError(t, err, `invalid uri: "g_c_s:VALUE"`)
+	assert.EqualError(t, err, `cannot expand the value of "test": invalid uri: "g_c_s:VALUE"`)
 }
 
 func TestResolverExpandInvalidOpaqueValue(t *testing.T) {
@@ -425,7 +426,7 @@ func TestResolverExpandInvalidOpaqueValue(t *testing.T) {
 	require.NoError(t, err)
 
 	_, err = resolver.Resolve(context.Background())
-	assert.EqualError(t, err, `the uri "test:$VALUE" contains unsupported characters ('$')`)
+	assert.EqualError(t, err, `cannot expand the value of "test": the uri "test:$VALUE" contains unsupported characters ('$')`)
 }
 
 func TestResolverExpandUnsupportedScheme(t *testing.T) {
@@ -441,7 +442,7 @@ func TestResolverExpandUnsupportedScheme(t *testing.T) {
 	require.NoError(t, err)
 
 	_, err = resolver.Resolve(context.Background())
-	assert.EqualError(t, err, `scheme "unsupported" is not supported for uri "unsupported:VALUE"`)
+	assert.EqualError(t, err, `cannot expand the value of "test": scheme "unsupported" is not supported for uri "unsupported:VALUE"`)
 }
 
 func TestResolverExpandStringValueInvalidReturnValue(t *testing.T) {
@@ -457,5 +458,131 @@ func TestResolverExpandStringValueInvalidReturnValue(t *testing.T) {
 	require.NoError(t, err)
 
 	_, err = resolver.Resolve(context.Background())
-	assert.EqualError(t, err, `expanding ${test:PORT}, expected convertable to string value type, got ['ӛ']([]interface {})`)
+	assert.EqualError(t, err, `cannot expand the value of "test": expanding ${test:PORT}, expected convertable to string value type, got ['ӛ']([]interface {})`)
+}
+
+func TestResolverExpandFallback(t *testing.T) {
+	envs := map[string]any{"SET": "value", "EMPTY": "", "PORT": 4317}
+	envProvider := newFakeProvider("env", func(_ context.Context, uri string, _ WatcherFunc) (*Retrieved, error) {
+		return NewRetrieved(envs[uri[4:]])
+	})
+	fileProvider := newFakeProvider("file", func(_ context.Context, uri string, _ WatcherFunc) (*Retrieved, error) {
+		if uri == "file:endpoint.txt" {
+			return NewRetrieved("localhost:4317")
+		}
+		return nil, fmt.Errorf("unable to read the file %v", uri)
+	})
+
+	var testCases = []struct {
+		name     string
+		input    string
+		expected any
+	}{
+		{name: "default_not_used", input: "${env:SET:-default}", expected: "value"},
+		{name: "default_unset", input: "${env:UNSET:-default}", expected: "default"},
+		{name: "default_empty", input: "${env:EMPTY:-default}", expected: "default"},
+		{name: "default_empty_value", input: "${env:UNSET:-}", expected: nil},
+		{name: "default_parsed_as_yaml", input: "${env:UNSET:-4318}", expected: 4318},
+		{name: "default_with_colons", input: "${env:UNSET:-localhost:4318}", expected: "localhost:4318"},
+		{name: "default_embedded", input: "localhost:${env:UNSET:-4318}", expected: "localhost:4318"},
+		{name: "default_typed_value", input: "${env:PORT:-4318}", expected: 4317},
+		{name: "default_nested_uri", input: "${env:UNSET:-${env:SET}}", expected: "value"},
+		{name: "default_nested_default", input: "${env:UNSET:-${env:UNSET2:-default}}", expected: "default"},
+		{name: "required_set", input: "${env:SET:?SET must be set}", expected: "value"},
+		{name: "default_retrieved", input: "${file:endpoint.txt:-localhost:4318}", expected: "localhost:4317"},
+		{name: "default_retrieve_error", input: "${file:missing.txt:-localhost:4318}", expected: "localhost:4318"},
+		{name: "default_embedded_retrieve_error", input: "http://${file:missing.txt:-localhost:4318}", expected: "http://localhost:4318"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := newFakeProvider("input", func(context.Context, string, WatcherFunc) (*Retrieved, error) {
+				return NewRetrieved(map[string]any{"test": tt.input})
+			})
+
+			resolver, err := NewResolver(ResolverSettings{URIs: []string{"input:"}, Providers: makeMapProvidersMap(provider, envProvider, fileProvider), Converters: nil})
+			require.NoError(t, err)
+
+			cfgMap, err := resolver.Resolve(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, map[string]any{"test": tt.expected}, cfgMap.ToStringMap())
+		})
+	}
+}
+
+func TestResolverExpandRequired(t *testing.T) {
+	envProvider := newFakeProvider("env", func(_ context.Context, uri string, _ WatcherFunc) (*Retrieved, error) {
+		return NewRetrieved(map[string]any{"EMPTY": ""}[uri[4:]])
+	})
+	fileProvider := newFakeProvider("file", func(_ context.Context, uri string, _ WatcherFunc) (*Retrieved, error) {
+		return nil, fmt.Errorf("unable to read the file %v", uri)
+	})
+
+	var testCases = []struct {
+		name        string
+		input       string
+		expectedErr string
+	}{
+		{
+			name:        "unset_with_message",
+			input:       "${env:API_KEY:?API_KEY must be set}",
+			expectedErr: `cannot expand the value of "exporters::otlp::headers": the uri "env:API_KEY" has no value: API_KEY must be set`,
+		},
+		{
+			name:        "empty_without_message",
+			input:       "Bearer ${env:EMPTY:?}",
+			expectedErr: `cannot expand the value of "exporters::otlp::headers": the uri "env:EMPTY" has no value: a value is required`,
+		},
+		{
+			name:  "retrieve_error",
+			input: "${file:token.txt:?the token file must exist}",
+			expectedErr: `cannot expand the value of "exporters::otlp::headers": the uri "file:token.txt" has no value: ` +
+				`the token file must exist: unable to read the file file:token.txt`,
+		},
+		{
+			name:        "unsupported_scheme_with_default",
+			input:       "${vault:token:-default}",
+			expectedErr: `cannot expand the value of "exporters::otlp::headers": scheme "vault" is not supported for uri "vault:token"`,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := newFakeProvider("input", func(context.Context, string, WatcherFunc) (*Retrieved, error) {
+				return NewRetrieved(map[string]any{"exporters": map[string]any{"otlp": map[string]any{"headers": tt.input}}})
+			})
+
+			resolver, err := NewResolver(ResolverSettings{URIs: []string{"input:"}, Providers: makeMapProvidersMap(provider, envProvider, fileProvider), Converters: nil})
+			require.NoError(t, err)
+
+			_, err = resolver.Resolve(context.Background())
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestResolverExpandStringType(t *testing.T) {
+	provider := newFakeProvider("input", func(context.Context, string, WatcherFunc) (*Retrieved, error) {
+		return NewRetrieved(map[string]any{"value": "${secret:key}", "embedded": "Bearer ${secret:key}", "default": "${secret:empty:-default}"})
+	})
+	secretProvider := newFakeProvider("secret", func(_ context.Context, uri string, _ WatcherFunc) (*Retrieved, error) {
+		if uri == "secret:empty" {
+			return NewRetrieved(opaqueString(""))
+		}
+		return NewRetrieved(opaqueString("token"))
+	})
+
+	resolver, err := NewResolver(ResolverSettings{URIs: []string{"input:"}, Providers: makeMapProvidersMap(provider, secretProvider), Converters: nil})
+	require.NoError(t, err)
+
+	cfgMap, err := resolver.Resolve(context.Background())
+	require.NoError(t, err)
+	// The type of the value is kept when the value is the URI.
+	assert.Equal(t, map[string]any{"value": opaqueString("token"), "embedded": "Bearer token", "default": "default"}, cfgMap.ToStringMap())
+
+	var cfg struct {
+		Value string `mapstructure:"value"`
+	}
+	require.NoError(t, cfgMap.Unmarshal(&cfg))
+	assert.Equal(t, "token", cfg.Value)
 }
@@ -113,7 +113,7 @@ func (e *Encoder) encodeStruct(value reflect.Value) (any, error) {
 		field := value.Field(i)
 		if field.CanInterface() {
 			info := getTagInfo(value.Type().Field(i))
-			if (info.omitEmpty && field.IsZero()) || info.name == optionSkip {
+			if (info.omitEmpty && field.IsZero()) || info.name == optionSkip || !isEncodable(field) {
 				continue
 			}
 			encoded, err := e.encode(field)
@@ -205,6 +205,16 @@ func getTagInfo(field reflect.StructField) *tagInfo {
 	return &info
 }
 
+// isEncodable reports whether the field can be represented in a configuration,
+// unlike functions and channels, e.g. hooks set by the components themselves.
+func isEncodable(field reflect.Value) bool {
+	switch field.Kind() {
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return false
+	}
+	return true
+}
+
 // TextMarshalerHookFunc returns a DecodeHookFuncValue that checks
 // for the encoding.TextMarshaler interface and calls the MarshalText
 // function if found.
@@ -30,6 +30,12 @@ type TestSimpleStruct struct {
 	err     error
 }
 
+type TestFuncStruct struct {
+	Value string `mapstructure:"value"`
+	Func  func() error
+	Chan  chan struct{}
+}
+
 type TestEmptyStruct struct {
 	Value string `mapstructure:"-"`
 }
@@ -111,6 +117,12 @@ func TestEncode(t *testing.T) {
 				"remain2":   "value",
 			},
 		},
+		"WithFuncStruct": {
+			input: TestFuncStruct{Value: "test", Func: func() error { return nil }, Chan: make(chan struct{})},
+			want: map[string]any{
+				"value": "test",
+			},
+		},
 	}
 	for name, testCase := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package confmap // import "go.opentelemetry.io/collector/confmap"
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// MergeStrategy defines how a list is merged with the list it overrides.
+// The maps are always merged key by key, and the other values are replaced.
+type MergeStrategy string
+
+const (
+	// MergeReplace replaces the existing list. This is the default strategy.
+	MergeReplace MergeStrategy = "replace"
+	// MergeAppend appends the values of the list to the existing list.
+	MergeAppend MergeStrategy = "append"
+	// MergePrepend prepends the values of the list to the existing list.
+	MergePrepend MergeStrategy = "prepend"
+	// MergeUniqueAppend appends the values of the list not in the existing list yet.
+	MergeUniqueAppend MergeStrategy = "unique-append"
+)
+
+// mergeDirectiveSeparator separates a key from the strategy used to merge its list value,
+// e.g. "exporters@append: [otlp/2]".
+const mergeDirectiveSeparator = "@"
+
+// Validate checks that the strategy is a supported one. The empty strategy is MergeReplace.
+func (s MergeStrategy) Validate() error {
+	switch s {
+	case "", MergeReplace, MergeAppend, MergePrepend, MergeUniqueAppend:
+		return nil
+	}
+	return fmt.Errorf("unsupported merge strategy %q, must be one of %q, %q, %q or %q",
+		string(s), MergeReplace, MergeAppend, MergePrepend, MergeUniqueAppend)
+}
+
+type MergeOption interface {
+	apply(*mergeOption)
+}
+
+type mergeOption struct {
+	strategy MergeStrategy
+}
+
+type mergeOptionFunc func(*mergeOption)
+
+func (fn mergeOptionFunc) apply(set *mergeOption) {
+	fn(set)
+}
+
+// WithMergeStrategy sets the strategy used to merge the lists, unless the key of a
+// list sets its own strategy with the "key@strategy" directive.
+func WithMergeStrategy(strategy MergeStrategy) MergeOption {
+	return mergeOptionFunc(func(mo *mergeOption) {
+		mo.strategy = strategy
+	})
+}
+
+// mergeLists returns the maps of src with their lists merged with the lists of dst,
+// and the merge directives removed from their keys.
+func mergeLists(dst map[string]any, src map[string]any, strategy MergeStrategy) map[string]any {
+	ret := make(map[string]any, len(src))
+	for key, val := range src {
+		keyStrategy := strategy
+		if name, directive, ok := parseMergeDirective(key, val); ok {
+			key, keyStrategy = name, directive
+			if val == nil {
+				val = []any(nil)
+			}
+		}
+
+		switch v := val.(type) {
+		case map[string]any:
+			dstMap, _ := dst[key].(map[string]any)
+			ret[key] = mergeLists(dstMap, v, strategy)
+		case []any:
+			dstList, _ := dst[key].([]any)
+			ret[key] = mergeList(dstList, v, keyStrategy)
+		default:
+			ret[key] = val
+		}
+	}
+	return ret
+}
+
+// parseMergeDirective returns the key without its merge directive and the strategy set by
+// the directive, if any. Only the keys of lists, or of null values, can set a directive,
+// and the keys ending with an unsupported strategy are left unchanged.
+func parseMergeDirective(key string, val any) (string, MergeStrategy, bool) {
+	if _, ok := val.([]any); !ok && val != nil {
+		return key, "", false
+	}
+	idx := strings.LastIndex(key, mergeDirectiveSeparator)
+	if idx <= 0 {
+		return key, "", false
+	}
+	strategy := MergeStrategy(key[idx+len(mergeDirectiveSeparator):])
+	if strategy == "" || strategy.Validate() != nil {
+		return key, "", false
+	}
+	return key[:idx], strategy, true
+}
+
+func mergeList(dst []any, src []any, strategy MergeStrategy) []any {
+	switch strategy {
+	case MergeAppend:
+		return append(append(make([]any, 0, len(dst)+len(src)), dst...), src...)
+	case MergePrepend:
+		return append(append(make([]any, 0, len(dst)+len(src)), src...), dst...)
+	case MergeUniqueAppend:
+		ret := append(make([]any, 0, len(dst)+len(src)), dst...)
+		for _, v := range src {
+			if !containsValue(ret, v) {
+				ret = append(ret, v)
+			}
+		}
+		return ret
+	}
+	return src
+}
+
+func containsValue(list []any, val any) bool {
+	for _, v := range list {
+		if reflect.DeepEqual(v, val) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,163 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package confmap
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeStrategies(t *testing.T) {
+	var testCases = []struct {
+		strategy MergeStrategy
+		expected []any
+	}{
+		{strategy: "", expected: []any{"b", "c"}},
+		{strategy: MergeReplace, expected: []any{"b", "c"}},
+		{strategy: MergeAppend, expected: []any{"a", "b", "b", "c"}},
+		{strategy: MergePrepend, expected: []any{"b", "c", "a", "b"}},
+		{strategy: MergeUniqueAppend, expected: []any{"a", "b", "c"}},
+	}
+	for _, tt := range testCases {
+		t.Run(string(tt.strategy), func(t *testing.T) {
+			conf := NewFromStringMap(map[string]any{"key": []any{"a", "b"}, "other": "value"})
+			require.NoError(t, conf.Merge(NewFromStringMap(map[string]any{"key": []any{"b", "c"}}), WithMergeStrategy(tt.strategy)))
+			assert.Equal(t, map[string]any{"key": tt.expected, "other": "value"}, conf.ToStringMap())
+		})
+	}
+}
+
+func TestMergeInvalidStrategy(t *testing.T) {
+	conf := NewFromStringMap(map[string]any{"key": []any{"a"}})
+	assert.EqualError(t, conf.Merge(New(), WithMergeStrategy("invalid")),
+		`unsupported merge strategy "invalid", must be one of "replace", "append", "prepend" or "unique-append"`)
+}
+
+func TestMergeDirectives(t *testing.T) {
+	conf := NewFromStringMap(map[string]any{
+		"list":      []any{"a"},
+		"nested":    map[string]any{"list": []any{"a"}},
+		"map":       map[string]any{"key": "value"},
+		"unchanged": []any{"a"},
+	})
+	require.NoError(t, conf.Merge(NewFromStringMap(map[string]any{
+		"list@append": []any{"b"},
+		"nested": map[string]any{
+			// The strategy of a parent does not apply to its children.
+			"list@prepend": []any{"b"},
+			"new@append":   []any{"c"},
+		},
+		// Only the keys of lists set a strategy.
+		"map@append": map[string]any{"key": "value"},
+		// Unsupported strategies are not directives.
+		"list@invalid": []any{"d"},
+		// Null values leave the list unchanged.
+		"unchanged@append": nil,
+	})))
+	assert.Equal(t, map[string]any{
+		"list":         []any{"a", "b"},
+		"nested":       map[string]any{"list": []any{"b", "a"}, "new": []any{"c"}},
+		"map":          map[string]any{"key": "value"},
+		"map@append":   map[string]any{"key": "value"},
+		"list@invalid": []any{"d"},
+		"unchanged":    []any{"a"},
+	}, conf.ToStringMap())
+
+	// The directives override the default strategy.
+	conf = NewFromStringMap(map[string]any{"list": []any{"a"}, "other": []any{"a"}})
+	require.NoError(t, conf.Merge(NewFromStringMap(map[string]any{
+		"list@replace": []any{"b"},
+		"other":        []any{"b"},
+	}), WithMergeStrategy(MergeAppend)))
+	assert.Equal(t, map[string]any{"list": []any{"b"}, "other": []any{"a", "b"}}, conf.ToStringMap())
+}
+
+func TestResolverMergeStrategy(t *testing.T) {
+	var testCases = []struct {
+		name     string
+		strategy MergeStrategy
+		override string
+		expected map[string]any
+	}{
+		{
+			name:     "replace",
+			override: "merge-override.yaml",
+			expected: map[string]any{
+				"extensions": []any{"pprof", "zpages"},
+				"processors": []any{"memory_limiter"},
+				"exporters":  []any{"otlp/backup"},
+			},
+		},
+		{
+			name:     "append",
+			strategy: MergeAppend,
+			override: "merge-override.yaml",
+			expected: map[string]any{
+				"extensions": []any{"health_check", "pprof", "pprof", "zpages"},
+				"processors": []any{"batch", "memory_limiter"},
+				"exporters":  []any{"otlp", "otlp/backup"},
+			},
+		},
+		{
+			name:     "prepend",
+			strategy: MergePrepend,
+			override: "merge-override.yaml",
+			expected: map[string]any{
+				"extensions": []any{"pprof", "zpages", "health_check", "pprof"},
+				"processors": []any{"memory_limiter", "batch"},
+				"exporters":  []any{"otlp/backup", "otlp"},
+			},
+		},
+		{
+			name:     "unique-append",
+			strategy: MergeUniqueAppend,
+			override: "merge-override.yaml",
+			expected: map[string]any{
+				"extensions": []any{"health_check", "pprof", "zpages"},
+				"processors": []any{"batch", "memory_limiter"},
+				"exporters":  []any{"otlp", "otlp/backup"},
+			},
+		},
+		{
+			name:     "directives",
+			override: "merge-directives.yaml",
+			expected: map[string]any{
+				"extensions": []any{"health_check", "pprof", "zpages"},
+				"processors": []any{"memory_limiter", "batch"},
+				"exporters":  []any{"otlp/backup"},
+			},
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver, err := NewResolver(ResolverSettings{
+				URIs:          []string{filepath.Join("testdata", "merge-base.yaml"), "file:" + filepath.Join("testdata", tt.override)},
+				Providers:     makeMapProvidersMap(newFileProvider(t)),
+				MergeStrategy: tt.strategy,
+			})
+			require.NoError(t, err)
+
+			conf, err := resolver.Resolve(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected["extensions"], conf.Get("service::extensions"))
+			assert.Equal(t, []any{"otlp"}, conf.Get("service::pipelines::traces::receivers"))
+			assert.Equal(t, tt.expected["processors"], conf.Get("service::pipelines::traces::processors"))
+			assert.Equal(t, tt.expected["exporters"], conf.Get("service::pipelines::traces::exporters"))
+			assert.NoError(t, resolver.Shutdown(context.Background()))
+		})
+	}
+}
+
+func TestResolverInvalidMergeStrategy(t *testing.T) {
+	_, err := NewResolver(ResolverSettings{
+		URIs:          []string{filepath.Join("testdata", "merge-base.yaml")},
+		Providers:     makeMapProvidersMap(newFileProvider(t)),
+		MergeStrategy: "invalid",
+	})
+	assert.ErrorContains(t, err, `unsupported merge strategy "invalid"`)
+}
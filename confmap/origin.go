@@ -0,0 +1,185 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package confmap // import "go.opentelemetry.io/collector/confmap"
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Origin is where a value of the configuration comes from.
+type Origin struct {
+	// URI is the URI the value was retrieved from, e.g. "file:config.yaml".
+	URI string
+	// Line is the line of the key in the content retrieved from the URI, 0 if unknown.
+	Line int
+	// Expanded are the URIs expanded in the value, e.g. "env:ENDPOINT".
+	Expanded []string
+}
+
+// String returns the origin as "uri:line (expanded from uri, ...)".
+func (o Origin) String() string {
+	var b strings.Builder
+	b.WriteString(o.URI)
+	if o.Line > 0 {
+		b.WriteString(":" + strconv.Itoa(o.Line))
+	}
+	if len(o.Expanded) > 0 {
+		b.WriteString(" (expanded from " + strings.Join(o.Expanded, ", ") + ")")
+	}
+	return b.String()
+}
+
+// Origin returns where the value of the key comes from. It returns false if the origin
+// is unknown, e.g. if the Conf was not created by a Resolver.
+func (l *Conf) Origin(key string) (Origin, bool) {
+	o, ok := l.origins[key]
+	return o, ok
+}
+
+// Origins returns where the value of the key, and the values of the keys under it, come from.
+// There is an origin per URI, with the line of the key closest to the given key, and all the
+// URIs expanded in the values. The empty key returns the origins of the whole Conf.
+func (l *Conf) Origins(key string) []Origin {
+	type found struct {
+		Origin
+		depth int
+	}
+	byURI := map[string]*found{}
+	for k, o := range l.origins {
+		depth, ok := keyDepth(key, k)
+		if !ok {
+			continue
+		}
+		f, ok := byURI[o.URI]
+		if !ok {
+			f = &found{Origin: Origin{URI: o.URI, Line: o.Line}, depth: depth}
+			byURI[o.URI] = f
+		} else if depth < f.depth || (depth == f.depth && o.Line < f.Line) {
+			f.Line, f.depth = o.Line, depth
+		}
+		for _, e := range o.Expanded {
+			if !contains(f.Expanded, e) {
+				f.Expanded = append(f.Expanded, e)
+			}
+		}
+	}
+
+	ret := make([]Origin, 0, len(byURI))
+	for _, f := range byURI {
+		sort.Strings(f.Expanded)
+		ret = append(ret, f.Origin)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].URI < ret[j].URI })
+	return ret
+}
+
+// setOrigin sets the origin of the key, and of all the keys under it.
+func (l *Conf) setOrigin(key string, o Origin) {
+	if l.origins == nil {
+		l.origins = map[string]Origin{}
+	}
+	walkKeys(key, l.Get(key), func(k string) {
+		l.origins[k] = o
+	})
+}
+
+// mergeOrigins updates the origins with the origins of the merged Conf. The origins
+// are kept when the merged Conf has none, e.g. if it was created from a map.
+func (l *Conf) mergeOrigins(in *Conf) {
+	if len(in.origins) == 0 {
+		return
+	}
+	if l.origins == nil {
+		l.origins = make(map[string]Origin, len(in.origins))
+	}
+	for k, o := range in.origins {
+		key := stripMergeDirectives(k)
+		if _, ok := in.Get(k).(map[string]any); ok {
+			// The maps are merged, they keep the origin of the first one.
+			if _, exists := l.origins[key]; !exists {
+				l.origins[key] = o
+			}
+			continue
+		}
+		// The value replaces the existing value, and the keys under it.
+		for existing := range l.origins {
+			if strings.HasPrefix(existing, key+KeyDelimiter) {
+				delete(l.origins, existing)
+			}
+		}
+		l.origins[key] = o
+	}
+}
+
+// subOrigins returns the origins of the keys under the key, relative to the key.
+func (l *Conf) subOrigins(key string) map[string]Origin {
+	var ret map[string]Origin
+	for k, o := range l.origins {
+		if !strings.HasPrefix(k, key+KeyDelimiter) {
+			continue
+		}
+		if ret == nil {
+			ret = map[string]Origin{}
+		}
+		ret[k[len(key)+len(KeyDelimiter):]] = o
+	}
+	return ret
+}
+
+// walkKeys calls fn with the key, unless empty, and with all the keys of the maps under it.
+func walkKeys(key string, val any, fn func(string)) {
+	if key != "" {
+		fn(key)
+	}
+	m, ok := val.(map[string]any)
+	if !ok {
+		return
+	}
+	for k, v := range m {
+		if key != "" {
+			k = key + KeyDelimiter + k
+		}
+		walkKeys(k, v, fn)
+	}
+}
+
+// keyDepth returns how many levels the key is under the prefix, and false if the key is not the prefix
+// or under it.
+func keyDepth(prefix, key string) (int, bool) {
+	if prefix == "" {
+		return strings.Count(key, KeyDelimiter) + 1, true
+	}
+	if key == prefix {
+		return 0, true
+	}
+	if !strings.HasPrefix(key, prefix+KeyDelimiter) {
+		return 0, false
+	}
+	return strings.Count(key[len(prefix):], KeyDelimiter), true
+}
+
+// stripMergeDirectives removes the merge directives of the parts of the key, e.g. "exporters@append".
+func stripMergeDirectives(key string) string {
+	if !strings.Contains(key, mergeDirectiveSeparator) {
+		return key
+	}
+	parts := strings.Split(key, KeyDelimiter)
+	for i, part := range parts {
+		if name, _, ok := parseMergeDirective(part, nil); ok {
+			parts[i] = name
+		}
+	}
+	return strings.Join(parts, KeyDelimiter)
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package confmap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOriginString(t *testing.T) {
+	assert.Equal(t, "env:CONFIG", Origin{URI: "env:CONFIG"}.String())
+	assert.Equal(t, "file:config.yaml:12", Origin{URI: "file:config.yaml", Line: 12}.String())
+	assert.Equal(t, "file:config.yaml:12 (expanded from env:HOST, env:PORT)",
+		Origin{URI: "file:config.yaml", Line: 12, Expanded: []string{"env:HOST", "env:PORT"}}.String())
+}
+
+func TestResolverOrigins(t *testing.T) {
+	base := newFakeProvider("file", func(context.Context, string, WatcherFunc) (*Retrieved, error) {
+		return NewRetrieved(map[string]any{
+			"receivers": map[string]any{
+				"otlp": map[string]any{"endpoint": "${env:ENDPOINT}", "timeout": "5s"},
+			},
+			"exporters": map[string]any{
+				"otlp": map[string]any{"tls": map[string]any{"insecure": true}},
+			},
+			"extensions": "${map:extensions}",
+		}, WithRetrievedLines(map[string]int{
+			"receivers":                 1,
+			"receivers::otlp":           2,
+			"receivers::otlp::endpoint": 3,
+			"receivers::otlp::timeout":  4,
+			"exporters":                 5,
+			"exporters::otlp":           6,
+			"exporters::otlp::tls":      7,
+			"extensions":                9,
+		}))
+	})
+	override := newFakeProvider("yaml", func(context.Context, string, WatcherFunc) (*Retrieved, error) {
+		return NewRetrieved(map[string]any{
+			"receivers": map[string]any{"otlp": map[string]any{"timeout": "10s"}},
+			"exporters": map[string]any{"otlp": map[string]any{"tls": "none"}},
+		})
+	})
+	env := newFakeProvider("env", func(context.Context, string, WatcherFunc) (*Retrieved, error) {
+		return NewRetrieved("localhost:4317")
+	})
+	extensions := newFakeProvider("map", func(context.Context, string, WatcherFunc) (*Retrieved, error) {
+		return NewRetrieved(map[string]any{"zpages": map[string]any{"endpoint": "localhost:55679"}})
+	})
+
+	resolver, err := NewResolver(ResolverSettings{
+		URIs:      []string{"file:config.yaml", "yaml:override"},
+		Providers: makeMapProvidersMap(base, override, env, extensions),
+	})
+	require.NoError(t, err)
+	conf, err := resolver.Resolve(context.Background())
+	require.NoError(t, err)
+
+	o, ok := conf.Origin("receivers::otlp::endpoint")
+	require.True(t, ok)
+	assert.Equal(t, Origin{URI: "file:config.yaml", Line: 3, Expanded: []string{"env:ENDPOINT"}}, o)
+	o, ok = conf.Origin("receivers::otlp::timeout")
+	require.True(t, ok)
+	assert.Equal(t, Origin{URI: "yaml:override"}, o)
+	o, ok = conf.Origin("extensions::zpages::endpoint")
+	require.True(t, ok)
+	assert.Equal(t, Origin{URI: "file:config.yaml", Line: 9, Expanded: []string{"map:extensions"}}, o)
+	_, ok = conf.Origin("unknown")
+	assert.False(t, ok)
+
+	assert.Equal(t, []Origin{
+		{URI: "file:config.yaml", Line: 2, Expanded: []string{"env:ENDPOINT"}},
+		{URI: "yaml:override"},
+	}, conf.Origins("receivers::otlp"))
+	// The values overridden by another URI have the origin of the other URI.
+	assert.Equal(t, []Origin{{URI: "yaml:override"}}, conf.Origins("exporters::otlp::tls"))
+	assert.Len(t, conf.Origins(""), 2)
+
+	sub, err := conf.Sub("receivers")
+	require.NoError(t, err)
+	o, ok = sub.Origin("otlp::endpoint")
+	require.True(t, ok)
+	assert.Equal(t, "file:config.yaml:3 (expanded from env:ENDPOINT)", o.String())
+}
+
+func TestMergeOrigins(t *testing.T) {
+	conf := NewFromStringMap(map[string]any{"exporters": []any{"otlp"}})
+	conf.origins = map[string]Origin{"exporters": {URI: "file:a.yaml", Line: 1}}
+	in := NewFromStringMap(map[string]any{"exporters@append": []any{"debug"}})
+	in.origins = map[string]Origin{"exporters@append": {URI: "file:b.yaml", Line: 1}}
+	require.NoError(t, conf.Merge(in))
+	o, ok := conf.Origin("exporters")
+	require.True(t, ok)
+	assert.Equal(t, "file:b.yaml:1", o.String())
+
+	// The origins are kept when merging a Conf without origins.
+	require.NoError(t, conf.Merge(NewFromStringMap(map[string]any{"exporters": []any{"otlp"}})))
+	_, ok = conf.Origin("exporters")
+	assert.True(t, ok)
+}
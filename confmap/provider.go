@@ -6,6 +6,7 @@ package confmap // import "go.opentelemetry.io/collector/confmap"
 import (
 	"context"
 	"fmt"
+	"reflect"
 )
 
 // Provider is an interface that helps to retrieve a config map and watch for any
@@ -77,10 +78,12 @@ type ChangeEvent struct {
 type Retrieved struct {
 	rawConf   any
 	closeFunc CloseFunc
+	lines     map[string]int
 }
 
 type retrievedSettings struct {
 	closeFunc CloseFunc
+	lines     map[string]int
 }
 
 // RetrievedOption options to customize Retrieved values.
@@ -94,6 +97,14 @@ func WithRetrievedClose(closeFunc CloseFunc) RetrievedOption {
 	}
 }
 
+// WithRetrievedLines sets the line of the keys in the retrieved content, with the nested keys
+// separated by KeyDelimiter. The lines are part of the Origin of the values.
+func WithRetrievedLines(lines map[string]int) RetrievedOption {
+	return func(settings *retrievedSettings) {
+		settings.lines = lines
+	}
+}
+
 // NewRetrieved returns a new Retrieved instance that contains the data from the raw deserialized config.
 // The rawConf can be one of the following types:
 //   - Primitives: int, int32, int64, float32, float64, bool, string;
@@ -107,7 +118,7 @@ func NewRetrieved(rawConf any, opts ...RetrievedOption) (*Retrieved, error) {
 	for _, opt := range opts {
 		opt(&set)
 	}
-	return &Retrieved{rawConf: rawConf, closeFunc: set.closeFunc}, nil
+	return &Retrieved{rawConf: rawConf, closeFunc: set.closeFunc, lines: set.lines}, nil
 }
 
 // AsConf returns the retrieved configuration parsed as a Conf.
@@ -153,9 +164,12 @@ func checkRawConfType(rawConf any) error {
 	switch rawConf.(type) {
 	case int, int32, int64, float32, float64, bool, string, []any, map[string]any:
 		return nil
-	default:
-		return fmt.Errorf(
-			"unsupported type=%T for retrieved config,"+
-				" ensure that values are wrapped in quotes", rawConf)
 	}
+	// Also allow the string types with a custom marshaling, e.g. configopaque.String.
+	if reflect.ValueOf(rawConf).Kind() == reflect.String {
+		return nil
+	}
+	return fmt.Errorf(
+		"unsupported type=%T for retrieved config,"+
+			" ensure that values are wrapped in quotes", rawConf)
 }
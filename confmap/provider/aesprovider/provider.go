@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aesprovider // import "go.opentelemetry.io/collector/confmap/provider/aesprovider"
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/confmap"
+)
+
+const schemeName = "aes"
+
+// KeyFileEnvVar is the environment variable with the path of the key file,
+// used when the provider is created without a key file.
+const KeyFileEnvVar = "OTEL_AES_KEY_FILE"
+
+type provider struct {
+	keyFile string
+}
+
+// New returns a new confmap.Provider that decrypts values encrypted with AES-GCM, using the key
+// in the file set by the OTEL_AES_KEY_FILE environment variable.
+//
+// This Provider supports "aes" scheme, and can be called with a "uri" that follows:
+//
+//	aes-uri		= "aes:" encrypted-value
+//
+// The "encrypted-value" is the base64 encoding of the nonce followed by the ciphertext, as returned by
+// Encrypt. The key file contains the base64 encoding of a 16, 24 or 32 bytes key, selecting AES-128,
+// AES-192 or AES-256.
+//
+// The value is the decrypted value as a configopaque.String, so that it is redacted when the
+// configuration is marshaled. The value is a plain string when the uri is embedded in another
+// value, e.g. "Bearer ${aes:...}".
+//
+// Examples:
+// `aes:11+auUJcqrWzACnty8RqzoEL0nlDbfEYkUF/vN8qflCLEw==`
+func New() confmap.Provider {
+	return &provider{}
+}
+
+// NewWithKeyFile returns a new confmap.Provider like New, using the key in the given file.
+func NewWithKeyFile(keyFile string) confmap.Provider {
+	return &provider{keyFile: keyFile}
+}
+
+func (ap *provider) Retrieve(_ context.Context, uri string, _ confmap.WatcherFunc) (*confmap.Retrieved, error) {
+	if !strings.HasPrefix(uri, schemeName+":") {
+		return nil, fmt.Errorf("%q uri is not supported by %q provider", uri, schemeName)
+	}
+
+	key, err := ap.readKey()
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := decrypt(key, uri[len(schemeName)+1:])
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt the value of %q: %w", uri, err)
+	}
+	return confmap.NewRetrieved(configopaque.String(plaintext))
+}
+
+func (*provider) Scheme() string {
+	return schemeName
+}
+
+func (*provider) Shutdown(context.Context) error {
+	return nil
+}
+
+// readKey reads the key from the key file. The key is read for each value, so
+// that the file can be updated, e.g. to rotate the key.
+func (ap *provider) readKey() ([]byte, error) {
+	keyFile := ap.keyFile
+	if keyFile == "" {
+		keyFile = os.Getenv(KeyFileEnvVar)
+	}
+	if keyFile == "" {
+		return nil, fmt.Errorf("no key file, the %s environment variable must be set", KeyFileEnvVar)
+	}
+	// Clean the path before using it.
+	content, err := os.ReadFile(filepath.Clean(keyFile))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the key file: %w", err)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(content)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode the key file: %w", err)
+	}
+	return key, nil
+}
+
+// Encrypt encrypts the given value with AES-GCM, and returns it as the
+// encrypted value of an "aes" uri: "${aes:<encrypted value>}".
+func Encrypt(key []byte, value []byte) (string, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, value, nil)), nil
+}
+
+func decrypt(key []byte, value string) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, errors.New("the value is too short")
+	}
+	return gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
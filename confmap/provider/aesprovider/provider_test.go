@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aesprovider
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/confmap/provider/yamlprovider"
+)
+
+const (
+	aesSchemePrefix = schemeName + ":"
+
+	// encryptedValue is "s3cr3t" encrypted with the key of testdata/key.
+	encryptedValue = "11+auUJcqrWzACnty8RqzoEL0nlDbfEYkUF/vN8qflCLEw=="
+)
+
+var keyFile = filepath.Join("testdata", "key")
+
+func TestValidateProviderScheme(t *testing.T) {
+	assert.NoError(t, confmaptest.ValidateProviderScheme(New()))
+}
+
+func TestUnsupportedScheme(t *testing.T) {
+	ap := NewWithKeyFile(keyFile)
+	_, err := ap.Retrieve(context.Background(), "file:"+encryptedValue, nil)
+	assert.Error(t, err)
+	assert.NoError(t, ap.Shutdown(context.Background()))
+}
+
+func TestRetrieve(t *testing.T) {
+	ap := NewWithKeyFile(keyFile)
+	ret, err := ap.Retrieve(context.Background(), aesSchemePrefix+encryptedValue, nil)
+	require.NoError(t, err)
+	raw, err := ret.AsRaw()
+	require.NoError(t, err)
+	assert.Equal(t, configopaque.String("s3cr3t"), raw)
+	assert.NoError(t, ap.Shutdown(context.Background()))
+}
+
+func TestRetrieveKeyFileFromEnv(t *testing.T) {
+	ap := New()
+	_, err := ap.Retrieve(context.Background(), aesSchemePrefix+encryptedValue, nil)
+	assert.ErrorContains(t, err, "the OTEL_AES_KEY_FILE environment variable must be set")
+
+	t.Setenv(KeyFileEnvVar, keyFile)
+	ret, err := ap.Retrieve(context.Background(), aesSchemePrefix+encryptedValue, nil)
+	require.NoError(t, err)
+	raw, err := ret.AsRaw()
+	require.NoError(t, err)
+	assert.Equal(t, configopaque.String("s3cr3t"), raw)
+	assert.NoError(t, ap.Shutdown(context.Background()))
+}
+
+func TestRetrieveErrors(t *testing.T) {
+	otherKey := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(otherKey, []byte(base64.StdEncoding.EncodeToString(make([]byte, 32))), 0600))
+	shortKey := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(shortKey, []byte(base64.StdEncoding.EncodeToString(make([]byte, 10))), 0600))
+
+	var testCases = []struct {
+		name        string
+		keyFile     string
+		value       string
+		expectedErr string
+	}{
+		{name: "missing_key_file", keyFile: filepath.Join("testdata", "non-existent"), value: encryptedValue, expectedErr: "unable to read the key file"},
+		{name: "invalid_key_file", keyFile: filepath.Join("testdata", "invalid-key"), value: encryptedValue, expectedErr: "unable to decode the key file"},
+		{name: "invalid_key_size", keyFile: shortKey, value: encryptedValue, expectedErr: "invalid key size 10"},
+		{name: "wrong_key", keyFile: otherKey, value: encryptedValue, expectedErr: "message authentication failed"},
+		{name: "invalid_base64", keyFile: keyFile, value: "not base64!", expectedErr: "illegal base64 data"},
+		{name: "too_short", keyFile: keyFile, value: base64.StdEncoding.EncodeToString([]byte("short")), expectedErr: "the value is too short"},
+		{name: "tampered", keyFile: keyFile, value: "A" + encryptedValue[1:], expectedErr: "message authentication failed"},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ap := NewWithKeyFile(tt.keyFile)
+			_, err := ap.Retrieve(context.Background(), aesSchemePrefix+tt.value, nil)
+			assert.ErrorContains(t, err, tt.expectedErr)
+			assert.NoError(t, ap.Shutdown(context.Background()))
+		})
+	}
+}
+
+func TestEncrypt(t *testing.T) {
+	content, err := os.ReadFile(keyFile)
+	require.NoError(t, err)
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(content)))
+	require.NoError(t, err)
+
+	value, err := Encrypt(key, []byte("multi\nline: value"))
+	require.NoError(t, err)
+	// The nonce is random.
+	other, err := Encrypt(key, []byte("multi\nline: value"))
+	require.NoError(t, err)
+	assert.NotEqual(t, value, other)
+
+	ret, err := NewWithKeyFile(keyFile).Retrieve(context.Background(), aesSchemePrefix+value, nil)
+	require.NoError(t, err)
+	raw, err := ret.AsRaw()
+	require.NoError(t, err)
+	// The value is not parsed as YAML.
+	assert.Equal(t, configopaque.String("multi\nline: value"), raw)
+
+	_, err = Encrypt(key[:10], []byte("value"))
+	assert.Error(t, err)
+}
+
+func TestRedacted(t *testing.T) {
+	resolver, err := confmap.NewResolver(confmap.ResolverSettings{
+		URIs: []string{"yaml:exporters::otlp::headers::api-key: ${aes:" + encryptedValue + "}"},
+		Providers: map[string]confmap.Provider{
+			"yaml":     yamlprovider.New(),
+			schemeName: NewWithKeyFile(keyFile),
+		},
+	})
+	require.NoError(t, err)
+	conf, err := resolver.Resolve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, configopaque.String("s3cr3t"), conf.Get("exporters::otlp::headers::api-key"))
+
+	// The value is redacted once the configuration is marshaled.
+	marshaled := confmap.New()
+	require.NoError(t, marshaled.Marshal(conf.ToStringMap()))
+	assert.Equal(t, "[REDACTED]", marshaled.Get("exporters::otlp::headers::api-key"))
+	require.NoError(t, resolver.Shutdown(context.Background()))
+}
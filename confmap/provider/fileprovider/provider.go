@@ -48,7 +48,7 @@ func (fmp *provider) Retrieve(_ context.Context, uri string, _ confmap.WatcherFu
 		return nil, fmt.Errorf("unable to read the file %v: %w", uri, err)
 	}
 
-	return internal.NewRetrievedFromYAML(content)
+	return internal.NewRetrievedFromYAMLWithLines(content)
 }
 
 func (*provider) Scheme() string {
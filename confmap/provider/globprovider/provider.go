@@ -0,0 +1,202 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package globprovider // import "go.opentelemetry.io/collector/confmap/provider/globprovider"
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/provider/internal"
+)
+
+const (
+	schemeName = "glob"
+
+	// defaultPollInterval is how often the matching files are listed to detect additions and removals.
+	defaultPollInterval = 5 * time.Second
+)
+
+// dirPatterns are the patterns of the files loaded from a directory.
+var dirPatterns = []string{"*.yaml", "*.yml"}
+
+type provider struct {
+	pollInterval time.Duration
+
+	mu sync.Mutex
+	// watches holds a channel for each running watch, closed to stop the watch.
+	watches map[chan struct{}]struct{}
+	wg      sync.WaitGroup
+}
+
+// New returns a new confmap.Provider that reads the configuration from all the files
+// matching a pattern, or all the YAML files of a directory.
+//
+// This Provider supports "glob" scheme, and can be called with a "uri" that follows:
+//
+//	glob-uri		= "glob:" pattern
+//
+// The "pattern" follows the syntax of filepath.Match, and can be relative or absolute.
+// If the pattern is a directory, all the "*.yaml" and "*.yml" files of the directory are used.
+// The matching files are merged in the lexical order of their path, the values of a file
+// overriding the values of the files before it. It is an error if no file matches the pattern,
+// e.g. because of a typo in the path.
+//
+// If a watcher is given to Retrieve, it is notified when files matching the pattern are added or removed.
+//
+// Examples:
+// `glob:path/to/conf.d` - all the YAML files in the directory
+// `glob:path/to/conf.d/*.yaml` - all the files with the ".yaml" extension in the directory
+// `glob:/path/to/pipelines/*/config.yaml` - the "config.yaml" file in each sub-directory
+func New() confmap.Provider {
+	return &provider{
+		pollInterval: defaultPollInterval,
+		watches:      map[chan struct{}]struct{}{},
+	}
+}
+
+func (gp *provider) Retrieve(_ context.Context, uri string, watcher confmap.WatcherFunc) (*confmap.Retrieved, error) {
+	if !strings.HasPrefix(uri, schemeName+":") {
+		return nil, fmt.Errorf("%q uri is not supported by %q provider", uri, schemeName)
+	}
+
+	pattern := uri[len(schemeName)+1:]
+	files, err := matchFiles(pattern)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no file matches the pattern %q", pattern)
+	}
+
+	conf := confmap.New()
+	for _, file := range files {
+		// Clean the path before using it.
+		content, err := os.ReadFile(filepath.Clean(file))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the file %v: %w", file, err)
+		}
+		ret, err := internal.NewRetrievedFromYAML(content)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse the file %v: %w", file, err)
+		}
+		fileConf, err := ret.AsConf()
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse the file %v: %w", file, err)
+		}
+		if err = conf.Merge(fileConf); err != nil {
+			return nil, err
+		}
+	}
+
+	if watcher == nil {
+		return confmap.NewRetrieved(conf.ToStringMap())
+	}
+	stop := gp.watch(pattern, files, watcher)
+	return confmap.NewRetrieved(conf.ToStringMap(), confmap.WithRetrievedClose(func(context.Context) error {
+		stop()
+		return nil
+	}))
+}
+
+func (*provider) Scheme() string {
+	return schemeName
+}
+
+func (gp *provider) Shutdown(context.Context) error {
+	gp.mu.Lock()
+	for done := range gp.watches {
+		close(done)
+		delete(gp.watches, done)
+	}
+	gp.mu.Unlock()
+	gp.wg.Wait()
+	return nil
+}
+
+// watch lists the files matching the pattern every poll interval, and notifies the watcher
+// once when the list changes. It returns the function stopping the watch.
+func (gp *provider) watch(pattern string, files []string, watcher confmap.WatcherFunc) func() {
+	done := make(chan struct{})
+	gp.mu.Lock()
+	gp.watches[done] = struct{}{}
+	gp.mu.Unlock()
+
+	gp.wg.Add(1)
+	go func() {
+		defer gp.wg.Done()
+		ticker := time.NewTicker(gp.pollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+			current, err := matchFiles(pattern)
+			if err != nil {
+				watcher(&confmap.ChangeEvent{Error: err})
+				return
+			}
+			if !equalFiles(files, current) {
+				watcher(&confmap.ChangeEvent{})
+				return
+			}
+		}
+	}()
+
+	return func() {
+		gp.mu.Lock()
+		defer gp.mu.Unlock()
+		if _, ok := gp.watches[done]; ok {
+			close(done)
+			delete(gp.watches, done)
+		}
+	}
+}
+
+// matchFiles returns the files matching the pattern, sorted in lexical order.
+func matchFiles(pattern string) ([]string, error) {
+	patterns := []string{pattern}
+	if info, err := os.Stat(pattern); err == nil && info.IsDir() {
+		patterns = patterns[:0]
+		for _, dirPattern := range dirPatterns {
+			patterns = append(patterns, filepath.Join(pattern, dirPattern))
+		}
+	}
+
+	var files []string
+	for _, p := range patterns {
+		matches, err := filepath.Glob(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", p, err)
+		}
+		for _, match := range matches {
+			// Skip the directories matching the pattern.
+			if info, err := os.Stat(match); err == nil && !info.IsDir() {
+				files = append(files, match)
+			}
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+func equalFiles(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package globprovider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+const globSchemePrefix = schemeName + ":"
+
+func TestValidateProviderScheme(t *testing.T) {
+	assert.NoError(t, confmaptest.ValidateProviderScheme(New()))
+}
+
+func TestUnsupportedScheme(t *testing.T) {
+	gp := New()
+	_, err := gp.Retrieve(context.Background(), "file:testdata", nil)
+	assert.Error(t, err)
+	assert.NoError(t, gp.Shutdown(context.Background()))
+}
+
+func TestInvalidPattern(t *testing.T) {
+	gp := New()
+	_, err := gp.Retrieve(context.Background(), globSchemePrefix+"testdata/[", nil)
+	assert.ErrorContains(t, err, "invalid pattern")
+	assert.NoError(t, gp.Shutdown(context.Background()))
+}
+
+func TestInvalidFiles(t *testing.T) {
+	gp := New()
+	_, err := gp.Retrieve(context.Background(), globSchemePrefix+filepath.Join("testdata", "invalid-yaml.yaml"), nil)
+	assert.ErrorContains(t, err, "unable to parse the file")
+	_, err = gp.Retrieve(context.Background(), globSchemePrefix+filepath.Join("testdata", "not-a-map.yaml"), nil)
+	assert.ErrorContains(t, err, "unable to parse the file")
+	assert.NoError(t, gp.Shutdown(context.Background()))
+}
+
+func TestNoMatch(t *testing.T) {
+	gp := New()
+	_, err := gp.Retrieve(context.Background(), globSchemePrefix+filepath.Join("testdata", "*.json"), nil)
+	assert.ErrorContains(t, err, "no file matches the pattern")
+	_, err = gp.Retrieve(context.Background(), globSchemePrefix+t.TempDir(), nil)
+	assert.ErrorContains(t, err, "no file matches the pattern")
+	assert.NoError(t, gp.Shutdown(context.Background()))
+}
+
+func TestDirectory(t *testing.T) {
+	gp := New()
+	ret, err := gp.Retrieve(context.Background(), globSchemePrefix+filepath.Join("testdata", "conf.d"), nil)
+	require.NoError(t, err)
+	retMap, err := ret.AsConf()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"receivers": map[string]any{
+			"otlp": map[string]any{"protocols": map[string]any{"grpc": nil}},
+		},
+		"exporters": map[string]any{
+			// The files are merged in lexical order.
+			"otlp": map[string]any{"endpoint": "collector:4317"},
+		},
+		"service": map[string]any{
+			"pipelines": map[string]any{
+				"traces": map[string]any{"receivers": []any{"otlp"}, "exporters": []any{"otlp"}},
+			},
+		},
+	}, retMap.ToStringMap())
+	assert.NoError(t, gp.Shutdown(context.Background()))
+}
+
+func TestPattern(t *testing.T) {
+	gp := New()
+	ret, err := gp.Retrieve(context.Background(), globSchemePrefix+filepath.Join("testdata", "conf.d", "*", "config.yaml"), nil)
+	require.NoError(t, err)
+	retMap, err := ret.AsConf()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"processors": map[string]any{"batch": nil}}, retMap.ToStringMap())
+
+	abs, err := filepath.Abs(filepath.Join("testdata", "conf.d", "*.yml"))
+	require.NoError(t, err)
+	ret, err = gp.Retrieve(context.Background(), globSchemePrefix+abs, nil)
+	require.NoError(t, err)
+	retMap, err = ret.AsConf()
+	require.NoError(t, err)
+	assert.True(t, retMap.IsSet("exporters::otlp::endpoint"))
+	assert.False(t, retMap.IsSet("receivers"))
+	assert.NoError(t, gp.Shutdown(context.Background()))
+}
+
+func TestWatch(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.yaml"), []byte("a: 1"), 0600))
+
+	for _, tt := range []struct {
+		name   string
+		update func(t *testing.T)
+	}{
+		{
+			name: "addition",
+			update: func(t *testing.T) {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "b.yaml"), []byte("b: 2"), 0600))
+			},
+		},
+		{
+			name: "removal",
+			update: func(t *testing.T) {
+				require.NoError(t, os.Remove(filepath.Join(dir, "b.yaml")))
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			gp := newTestProvider()
+			events := make(chan *confmap.ChangeEvent, 1)
+			ret, err := gp.Retrieve(context.Background(), globSchemePrefix+dir, func(event *confmap.ChangeEvent) {
+				events <- event
+			})
+			require.NoError(t, err)
+
+			// Files not matching the pattern are ignored.
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "ignored.txt"), []byte("c: 3"), 0600))
+			select {
+			case <-events:
+				t.Fatal("unexpected change event")
+			case <-time.After(50 * time.Millisecond):
+			}
+
+			tt.update(t)
+			select {
+			case event := <-events:
+				assert.NoError(t, event.Error)
+			case <-time.After(5 * time.Second):
+				t.Fatal("no change event")
+			}
+
+			require.NoError(t, ret.Close(context.Background()))
+			require.NoError(t, gp.Shutdown(context.Background()))
+		})
+	}
+}
+
+func TestShutdownStopsWatches(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.yaml"), []byte("a: 1"), 0600))
+	gp := newTestProvider()
+	ret, err := gp.Retrieve(context.Background(), globSchemePrefix+dir, func(*confmap.ChangeEvent) {
+		t.Error("unexpected change event")
+	})
+	require.NoError(t, err)
+	require.NoError(t, gp.Shutdown(context.Background()))
+	// Closing the retrieved value after the shutdown is a no-op.
+	require.NoError(t, ret.Close(context.Background()))
+}
+
+func newTestProvider() confmap.Provider {
+	gp := New().(*provider)
+	gp.pollInterval = 10 * time.Millisecond
+	return gp
+}
@@ -109,7 +109,7 @@ func (fmp *provider) Retrieve(_ context.Context, uri string, _ confmap.WatcherFu
 		return nil, fmt.Errorf("fail to read the response body from uri %q: %w", uri, err)
 	}
 
-	return internal.NewRetrievedFromYAML(body)
+	return internal.NewRetrievedFromYAMLWithLines(body)
 }
 
 func (fmp *provider) Scheme() string {
@@ -19,3 +19,42 @@ func NewRetrievedFromYAML(yamlBytes []byte, opts ...confmap.RetrievedOption) (*c
 	}
 	return confmap.NewRetrieved(rawConf, opts...)
 }
+
+// NewRetrievedFromYAMLWithLines returns a new Retrieved instance like NewRetrievedFromYAML, with the
+// line of each key of the yaml bytes, used by the Resolver as the origin of the values.
+func NewRetrievedFromYAMLWithLines(yamlBytes []byte, opts ...confmap.RetrievedOption) (*confmap.Retrieved, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(yamlBytes, &node); err != nil {
+		return nil, err
+	}
+	var rawConf any
+	if node.Kind != 0 {
+		if err := node.Decode(&rawConf); err != nil {
+			return nil, err
+		}
+	}
+	lines := map[string]int{}
+	addLines(lines, "", &node)
+	return confmap.NewRetrieved(rawConf, append(opts, confmap.WithRetrievedLines(lines))...)
+}
+
+// addLines adds the line of the keys of the mapping nodes under the node to lines.
+func addLines(lines map[string]int, prefix string, node *yaml.Node) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, n := range node.Content {
+			addLines(lines, prefix, n)
+		}
+	case yaml.AliasNode:
+		addLines(lines, prefix, node.Alias)
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i].Value
+			if prefix != "" {
+				key = prefix + confmap.KeyDelimiter + key
+			}
+			lines[key] = node.Content[i].Line
+			addLines(lines, key, node.Content[i+1])
+		}
+	}
+}
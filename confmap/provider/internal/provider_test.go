@@ -45,3 +45,60 @@ func TestNewRetrievedFromYAMLInvalidAsMap(t *testing.T) {
 	_, err = ret.AsConf()
 	assert.Error(t, err)
 }
+
+func TestNewRetrievedFromYAMLWithLines(t *testing.T) {
+	ret, err := NewRetrievedFromYAMLWithLines([]byte(`receivers:
+  otlp:
+    protocols:
+      grpc:
+
+exporters: &exporters
+  otlp:
+    endpoint: localhost:4317
+`))
+	require.NoError(t, err)
+	retMap, err := ret.AsConf()
+	require.NoError(t, err)
+	assert.True(t, retMap.IsSet("exporters::otlp::endpoint"))
+
+	resolver, err := confmap.NewResolver(confmap.ResolverSettings{
+		URIs:      []string{"test:config"},
+		Providers: map[string]confmap.Provider{"test": &testProvider{ret: ret}},
+	})
+	require.NoError(t, err)
+	conf, err := resolver.Resolve(context.Background())
+	require.NoError(t, err)
+	o, ok := conf.Origin("receivers::otlp::protocols::grpc")
+	require.True(t, ok)
+	assert.Equal(t, "test:config:4", o.String())
+	o, ok = conf.Origin("exporters::otlp::endpoint")
+	require.True(t, ok)
+	assert.Equal(t, "test:config:8", o.String())
+}
+
+func TestNewRetrievedFromYAMLWithLinesEmpty(t *testing.T) {
+	ret, err := NewRetrievedFromYAMLWithLines([]byte{})
+	require.NoError(t, err)
+	retMap, err := ret.AsConf()
+	require.NoError(t, err)
+	assert.Equal(t, confmap.New(), retMap)
+
+	_, err = NewRetrievedFromYAMLWithLines([]byte("[invalid:,"))
+	assert.Error(t, err)
+}
+
+type testProvider struct {
+	ret *confmap.Retrieved
+}
+
+func (p *testProvider) Retrieve(context.Context, string, confmap.WatcherFunc) (*confmap.Retrieved, error) {
+	return p.ret, nil
+}
+
+func (*testProvider) Scheme() string {
+	return "test"
+}
+
+func (*testProvider) Shutdown(context.Context) error {
+	return nil
+}
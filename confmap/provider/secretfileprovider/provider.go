@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretfileprovider // import "go.opentelemetry.io/collector/confmap/provider/secretfileprovider"
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/confmap"
+)
+
+const schemeName = "secretfile"
+
+type provider struct{}
+
+// New returns a new confmap.Provider that reads a secret from a file, e.g. a Kubernetes secret
+// mounted as a volume.
+//
+// This Provider supports "secretfile" scheme, and can be called with a "uri" that follows:
+//
+//	secretfile-uri	= "secretfile:" local-path
+//
+// Unlike the "file" scheme, the content of the file is not parsed as YAML: the value is the
+// content of the file as a configopaque.String, without its trailing new lines, so that it is
+// redacted when the configuration is marshaled. The value is a plain string when the uri is
+// embedded in another value, e.g. "Bearer ${secretfile:/path/to/token}".
+//
+// Examples:
+// `secretfile:/var/run/secrets/otel/api-key` - absolute path
+// `secretfile:secrets/api-key` - relative path
+func New() confmap.Provider {
+	return &provider{}
+}
+
+func (fmp *provider) Retrieve(_ context.Context, uri string, _ confmap.WatcherFunc) (*confmap.Retrieved, error) {
+	if !strings.HasPrefix(uri, schemeName+":") {
+		return nil, fmt.Errorf("%q uri is not supported by %q provider", uri, schemeName)
+	}
+
+	// Clean the path before using it.
+	content, err := os.ReadFile(filepath.Clean(uri[len(schemeName)+1:]))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the secret file %v: %w", uri, err)
+	}
+
+	return confmap.NewRetrieved(configopaque.String(strings.TrimRight(string(content), "\r\n")))
+}
+
+func (*provider) Scheme() string {
+	return schemeName
+}
+
+func (*provider) Shutdown(context.Context) error {
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretfileprovider
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/confmap/provider/yamlprovider"
+)
+
+const secretFileSchemePrefix = schemeName + ":"
+
+func TestValidateProviderScheme(t *testing.T) {
+	assert.NoError(t, confmaptest.ValidateProviderScheme(New()))
+}
+
+func TestUnsupportedScheme(t *testing.T) {
+	sp := New()
+	_, err := sp.Retrieve(context.Background(), "file:testdata/token", nil)
+	assert.Error(t, err)
+	assert.NoError(t, sp.Shutdown(context.Background()))
+}
+
+func TestNonExistent(t *testing.T) {
+	sp := New()
+	_, err := sp.Retrieve(context.Background(), secretFileSchemePrefix+filepath.Join("testdata", "non-existent"), nil)
+	assert.ErrorContains(t, err, "unable to read the secret file")
+	assert.NoError(t, sp.Shutdown(context.Background()))
+}
+
+func TestRetrieve(t *testing.T) {
+	sp := New()
+	ret, err := sp.Retrieve(context.Background(), secretFileSchemePrefix+filepath.Join("testdata", "token"), nil)
+	require.NoError(t, err)
+	raw, err := ret.AsRaw()
+	require.NoError(t, err)
+	assert.Equal(t, configopaque.String("s3cr3t"), raw)
+
+	// Only the trailing new lines are removed.
+	ret, err = sp.Retrieve(context.Background(), secretFileSchemePrefix+filepath.Join("testdata", "multiline"), nil)
+	require.NoError(t, err)
+	raw, err = ret.AsRaw()
+	require.NoError(t, err)
+	assert.Equal(t, configopaque.String("line1\nline2"), raw)
+	assert.NoError(t, sp.Shutdown(context.Background()))
+}
+
+func TestRedacted(t *testing.T) {
+	resolver, err := confmap.NewResolver(confmap.ResolverSettings{
+		URIs: []string{"yaml:exporters::otlp::headers::api-key: ${secretfile:testdata/token}"},
+		Providers: map[string]confmap.Provider{
+			"yaml":     yamlprovider.New(),
+			schemeName: New(),
+		},
+	})
+	require.NoError(t, err)
+	conf, err := resolver.Resolve(context.Background())
+	require.NoError(t, err)
+
+	var cfg struct {
+		Exporters map[string]struct {
+			Headers map[string]configopaque.String `mapstructure:"headers"`
+		} `mapstructure:"exporters"`
+	}
+	require.NoError(t, conf.Unmarshal(&cfg))
+	assert.Equal(t, configopaque.String("s3cr3t"), cfg.Exporters["otlp"].Headers["api-key"])
+
+	// The value is redacted once the configuration is marshaled.
+	marshaled := confmap.New()
+	require.NoError(t, marshaled.Marshal(conf.ToStringMap()))
+	assert.Equal(t, "[REDACTED]", marshaled.Get("exporters::otlp::headers::api-key"))
+	require.NoError(t, resolver.Shutdown(context.Background()))
+}
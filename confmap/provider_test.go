@@ -35,3 +35,17 @@ func TestNewRetrievedUnsupportedType(t *testing.T) {
 	_, err := NewRetrieved(errors.New("my error"))
 	require.Error(t, err)
 }
+
+type opaqueString string
+
+func (opaqueString) MarshalText() ([]byte, error) {
+	return []byte("[REDACTED]"), nil
+}
+
+func TestNewRetrievedStringType(t *testing.T) {
+	ret, err := NewRetrieved(opaqueString("secret"))
+	require.NoError(t, err)
+	raw, err := ret.AsRaw()
+	require.NoError(t, err)
+	assert.Equal(t, opaqueString("secret"), raw)
+}
@@ -30,9 +30,12 @@ type Resolver struct {
 	uris       []location
 	providers  map[string]Provider
 	converters []Converter
+	merge      MergeStrategy
 
 	closers []CloseFunc
 	watcher chan error
+	// expanded holds the URIs expanded in the value being resolved.
+	expanded []string
 }
 
 // ResolverSettings are the settings to configure the behavior of the Resolver.
@@ -47,12 +50,18 @@ type ResolverSettings struct {
 
 	// MapConverters is a slice of Converter.
 	Converters []Converter
+
+	// MergeStrategy is the strategy used to merge the lists of the configurations retrieved from the URIs.
+	// Optional, the lists are replaced by default. The key of a list can set its own strategy with
+	// the "key@strategy" directive, see Conf.Merge.
+	MergeStrategy MergeStrategy
 }
 
 // NewResolver returns a new Resolver that resolves configuration from multiple URIs.
 //
 // To resolve a configuration the following steps will happen:
-//  1. Retrieves individual configurations from all given "URIs", and merge them in the retrieve order.
+//  1. Retrieves individual configurations from all given "URIs", and merge them in the retrieve order,
+//     using the given "MergeStrategy" for the lists.
 //  2. Once the Conf is merged, apply the converters in the given order.
 //
 // After the configuration was resolved the `Resolver` can be used as a single point to watch for updates in
@@ -77,6 +86,10 @@ func NewResolver(set ResolverSettings) (*Resolver, error) {
 		return nil, errors.New("invalid map resolver config: no Providers")
 	}
 
+	if err := set.MergeStrategy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid map resolver config: %w", err)
+	}
+
 	// Safe copy, ensures the slices and maps cannot be changed from the caller.
 	uris := make([]location, len(set.URIs))
 	for i, uri := range set.URIs {
@@ -107,6 +120,7 @@ func NewResolver(set ResolverSettings) (*Resolver, error) {
 		uris:       uris,
 		providers:  providersCopy,
 		converters: convertersCopy,
+		merge:      set.MergeStrategy,
 		watcher:    make(chan error, 1),
 	}, nil
 }
@@ -132,20 +146,37 @@ func (mr *Resolver) Resolve(ctx context.Context) (*Conf, error) {
 		if err != nil {
 			return nil, err
 		}
-		if err = retMap.Merge(retCfgMap); err != nil {
+		retCfgMap.origins = map[string]Origin{}
+		walkKeys("", retCfgMap.ToStringMap(), func(k string) {
+			retCfgMap.origins[k] = Origin{URI: uri.asString(), Line: ret.lines[k]}
+		})
+		if err = retMap.Merge(retCfgMap, WithMergeStrategy(mr.merge)); err != nil {
 			return nil, err
 		}
 	}
 
 	cfgMap := make(map[string]any)
+	expanded := make(map[string][]string)
 	for _, k := range retMap.AllKeys() {
+		mr.expanded = nil
 		val, err := mr.expandValueRecursively(ctx, retMap.Get(k))
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("cannot expand the value of %q: %w", k, err)
 		}
 		cfgMap[k] = val
+		if len(mr.expanded) > 0 {
+			expanded[k] = mr.expanded
+		}
 	}
+	origins := retMap.origins
 	retMap = NewFromStringMap(cfgMap)
+	retMap.origins = origins
+	for k, uris := range expanded {
+		o := origins[k]
+		o.Expanded = uris
+		// The keys of the maps returned by the expansion have the origin of the expanded value.
+		retMap.setOrigin(k, o)
+	}
 
 	// Apply the converters in the given order.
 	for _, confConv := range mr.converters {
@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package countconnector // import "go.opentelemetry.io/collector/connector/countconnector"
+
+import (
+	"fmt"
+
+	"go.uber.org/multierr"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+const (
+	defaultMetricNameSpans      = "trace.span.count"
+	defaultMetricDescSpans      = "The number of spans observed."
+	defaultMetricNameSpanEvents = "trace.span.event.count"
+	defaultMetricDescSpanEvents = "The number of span events observed."
+	defaultMetricNameDataPoints = "metric.datapoint.count"
+	defaultMetricDescDataPoints = "The number of data points observed."
+	defaultMetricNameLogs       = "log.record.count"
+	defaultMetricDescLogs       = "The number of log records observed."
+)
+
+// Config defines configuration for the count connector.
+type Config struct {
+	// Spans configures the metric counting spans.
+	Spans MetricInfo `mapstructure:"spans"`
+	// SpanEvents configures the metric counting span events.
+	SpanEvents MetricInfo `mapstructure:"spanevents"`
+	// DataPoints configures the metric counting metric data points.
+	DataPoints MetricInfo `mapstructure:"datapoints"`
+	// Logs configures the metric counting log records.
+	Logs MetricInfo `mapstructure:"logs"`
+}
+
+// MetricInfo configures one of the metrics emitted by the connector.
+type MetricInfo struct {
+	// Name is the name of the emitted metric.
+	Name string `mapstructure:"name"`
+	// Description is the description of the emitted metric.
+	Description string `mapstructure:"description"`
+	// Attributes is the list of attributes the counts are grouped by.
+	// A separate data point is emitted for each distinct combination of values.
+	Attributes []AttributeConfig `mapstructure:"attributes"`
+}
+
+// AttributeConfig configures an attribute the counts are grouped by.
+type AttributeConfig struct {
+	// Key is the attribute key. The value is looked up in the attributes of the
+	// counted item first, then of its parents up to the resource.
+	Key string `mapstructure:"key"`
+	// DefaultValue is used when none of the attributes contain Key.
+	// When empty, the attribute is not set on the data point.
+	DefaultValue string `mapstructure:"default_value"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the connector configuration is valid.
+func (cfg *Config) Validate() error {
+	var errs error
+	errs = multierr.Append(errs, cfg.Spans.validate("spans"))
+	errs = multierr.Append(errs, cfg.SpanEvents.validate("spanevents"))
+	errs = multierr.Append(errs, cfg.DataPoints.validate("datapoints"))
+	errs = multierr.Append(errs, cfg.Logs.validate("logs"))
+	return errs
+}
+
+func (mi *MetricInfo) validate(section string) error {
+	if mi.Name == "" {
+		return fmt.Errorf("%s: metric name must not be empty", section)
+	}
+	uniq := map[string]bool{}
+	for _, attr := range mi.Attributes {
+		if attr.Key == "" {
+			return fmt.Errorf("%s: attribute key must not be empty", section)
+		}
+		if uniq[attr.Key] {
+			return fmt.Errorf("%s: duplicate attribute key: %q", section, attr.Key)
+		}
+		uniq[attr.Key] = true
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package countconnector
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+func TestUnmarshalDefaultConfig(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	assert.NoError(t, componenttest.CheckConfigStruct(cfg))
+	assert.NoError(t, component.UnmarshalConfig(confmap.New(), cfg))
+	assert.Equal(t, factory.CreateDefaultConfig(), cfg)
+	assert.NoError(t, component.ValidateConfig(cfg))
+}
+
+func TestUnmarshalConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	assert.NoError(t, component.UnmarshalConfig(cm, cfg))
+	expected := factory.CreateDefaultConfig().(*Config)
+	expected.Spans = MetricInfo{
+		Name:        "my.span.count",
+		Description: "My span count.",
+		Attributes: []AttributeConfig{
+			{Key: "service.name"},
+			{Key: "env", DefaultValue: "unknown"},
+		},
+	}
+	expected.Logs.Attributes = []AttributeConfig{{Key: "severity"}}
+	assert.Equal(t, expected, cfg)
+}
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(cfg *Config)
+		wantErr string
+	}{
+		{
+			name:    "empty name",
+			mutate:  func(cfg *Config) { cfg.SpanEvents.Name = "" },
+			wantErr: "spanevents: metric name must not be empty",
+		},
+		{
+			name:    "empty attribute key",
+			mutate:  func(cfg *Config) { cfg.DataPoints.Attributes = []AttributeConfig{{}} },
+			wantErr: "datapoints: attribute key must not be empty",
+		},
+		{
+			name: "duplicate attribute key",
+			mutate: func(cfg *Config) {
+				cfg.Logs.Attributes = []AttributeConfig{{Key: "foo"}, {Key: "foo", DefaultValue: "bar"}}
+			},
+			wantErr: `logs: duplicate attribute key: "foo"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.mutate(cfg)
+			assert.EqualError(t, cfg.Validate(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package countconnector // import "go.opentelemetry.io/collector/connector/countconnector"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const scopeName = "go.opentelemetry.io/collector/connector/countconnector"
+
+// count counts spans, span events, data points and log records, and sends
+// the counts as metrics to the next consumer. Counts are grouped by resource,
+// then by the configured attributes.
+type count struct {
+	consumer.Metrics
+	component.StartFunc
+	component.ShutdownFunc
+
+	cfg *Config
+}
+
+func (c *count) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+// ConsumeTraces counts the spans and span events of the ptrace.Traces.
+func (c *count) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	md := pmetric.NewMetrics()
+	timestamp := pcommon.NewTimestampFromTime(time.Now())
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		resourceAttrs := rs.Resource().Attributes()
+		spans := newCounter(c.cfg.Spans)
+		spanEvents := newCounter(c.cfg.SpanEvents)
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			scopeAttrs := ss.Scope().Attributes()
+			for k := 0; k < ss.Spans().Len(); k++ {
+				span := ss.Spans().At(k)
+				spans.update(span.Attributes(), scopeAttrs, resourceAttrs)
+				for l := 0; l < span.Events().Len(); l++ {
+					spanEvents.update(span.Events().At(l).Attributes(), span.Attributes(), scopeAttrs, resourceAttrs)
+				}
+			}
+		}
+		if spans.empty() && spanEvents.empty() {
+			continue
+		}
+		sm := appendScopeMetrics(md, rs.Resource())
+		spans.appendMetric(sm.Metrics(), timestamp)
+		spanEvents.appendMetric(sm.Metrics(), timestamp)
+	}
+	return c.export(ctx, md)
+}
+
+// ConsumeMetrics counts the data points of the pmetric.Metrics.
+func (c *count) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	countMd := pmetric.NewMetrics()
+	timestamp := pcommon.NewTimestampFromTime(time.Now())
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		resourceAttrs := rm.Resource().Attributes()
+		dataPoints := newCounter(c.cfg.DataPoints)
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			scopeAttrs := sm.Scope().Attributes()
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				forEachDataPointAttributes(sm.Metrics().At(k), func(attrs pcommon.Map) {
+					dataPoints.update(attrs, scopeAttrs, resourceAttrs)
+				})
+			}
+		}
+		if dataPoints.empty() {
+			continue
+		}
+		sm := appendScopeMetrics(countMd, rm.Resource())
+		dataPoints.appendMetric(sm.Metrics(), timestamp)
+	}
+	return c.export(ctx, countMd)
+}
+
+// ConsumeLogs counts the log records of the plog.Logs.
+func (c *count) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	md := pmetric.NewMetrics()
+	timestamp := pcommon.NewTimestampFromTime(time.Now())
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		resourceAttrs := rl.Resource().Attributes()
+		logs := newCounter(c.cfg.Logs)
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			scopeAttrs := sl.Scope().Attributes()
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				logs.update(sl.LogRecords().At(k).Attributes(), scopeAttrs, resourceAttrs)
+			}
+		}
+		if logs.empty() {
+			continue
+		}
+		sm := appendScopeMetrics(md, rl.Resource())
+		logs.appendMetric(sm.Metrics(), timestamp)
+	}
+	return c.export(ctx, md)
+}
+
+func (c *count) export(ctx context.Context, md pmetric.Metrics) error {
+	if md.ResourceMetrics().Len() == 0 {
+		return nil
+	}
+	return c.Metrics.ConsumeMetrics(ctx, md)
+}
+
+func appendScopeMetrics(md pmetric.Metrics, res pcommon.Resource) pmetric.ScopeMetrics {
+	rm := md.ResourceMetrics().AppendEmpty()
+	res.CopyTo(rm.Resource())
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName(scopeName)
+	return sm
+}
+
+func forEachDataPointAttributes(m pmetric.Metric, fn func(pcommon.Map)) {
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := m.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			fn(dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeSum:
+		dps := m.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			fn(dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeHistogram:
+		dps := m.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			fn(dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := m.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			fn(dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeSummary:
+		dps := m.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			fn(dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeEmpty:
+	}
+}
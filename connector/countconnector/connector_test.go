@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package countconnector
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/connector/connectortest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// dataPoints returns the attributes and value of each data point of the named metric.
+func dataPoints(t *testing.T, md pmetric.Metrics, name string) map[string]int64 {
+	res := map[string]int64{}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		require.Equal(t, 1, rm.ScopeMetrics().Len())
+		sm := rm.ScopeMetrics().At(0)
+		assert.Equal(t, scopeName, sm.Scope().Name())
+		for j := 0; j < sm.Metrics().Len(); j++ {
+			m := sm.Metrics().At(j)
+			if m.Name() != name {
+				continue
+			}
+			require.Equal(t, pmetric.MetricTypeSum, m.Type())
+			assert.True(t, m.Sum().IsMonotonic())
+			assert.Equal(t, pmetric.AggregationTemporalityDelta, m.Sum().AggregationTemporality())
+			for k := 0; k < m.Sum().DataPoints().Len(); k++ {
+				dp := m.Sum().DataPoints().At(k)
+				attrs := pcommon.NewMap()
+				rm.Resource().Attributes().CopyTo(attrs)
+				dp.Attributes().Range(func(k string, v pcommon.Value) bool {
+					v.CopyTo(attrs.PutEmpty("dp." + k))
+					return true
+				})
+				res[toString(attrs)] += dp.IntValue()
+			}
+		}
+	}
+	return res
+}
+
+func toString(attrs pcommon.Map) string {
+	keys := make([]string, 0, attrs.Len())
+	attrs.Range(func(k string, _ pcommon.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	sort.Strings(keys)
+	s := ""
+	for _, k := range keys {
+		v, _ := attrs.Get(k)
+		s += k + "=" + v.AsString() + ";"
+	}
+	return s
+}
+
+func TestTracesToMetrics(t *testing.T) {
+	ctx := context.Background()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Spans.Attributes = []AttributeConfig{{Key: "service.name"}, {Key: "http.method", DefaultValue: "none"}}
+	cfg.SpanEvents.Attributes = []AttributeConfig{{Key: "http.method"}}
+
+	sink := new(consumertest.MetricsSink)
+	conn, err := NewFactory().CreateTracesToMetrics(ctx, connectortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, conn.Start(ctx, componenttest.NewNopHost()))
+
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "foo")
+	spans := rs.ScopeSpans().AppendEmpty().Spans()
+	get := spans.AppendEmpty()
+	get.Attributes().PutStr("http.method", "GET")
+	get.Events().AppendEmpty()
+	get.Events().AppendEmpty().Attributes().PutStr("http.method", "POST")
+	spans.AppendEmpty().Attributes().PutStr("http.method", "GET")
+	spans.AppendEmpty()
+	td.ResourceSpans().AppendEmpty()
+
+	require.NoError(t, conn.ConsumeTraces(ctx, td))
+	require.Len(t, sink.AllMetrics(), 1)
+	md := sink.AllMetrics()[0]
+	assert.Equal(t, 1, md.ResourceMetrics().Len())
+	assert.Equal(t, map[string]int64{
+		"dp.http.method=GET;dp.service.name=foo;service.name=foo;":  2,
+		"dp.http.method=none;dp.service.name=foo;service.name=foo;": 1,
+	}, dataPoints(t, md, defaultMetricNameSpans))
+	assert.Equal(t, map[string]int64{
+		"dp.http.method=GET;service.name=foo;":  1,
+		"dp.http.method=POST;service.name=foo;": 1,
+	}, dataPoints(t, md, defaultMetricNameSpanEvents))
+
+	assert.NoError(t, conn.Shutdown(ctx))
+}
+
+func TestMetricsToMetrics(t *testing.T) {
+	ctx := context.Background()
+	cfg := createDefaultConfig().(*Config)
+	cfg.DataPoints.Attributes = []AttributeConfig{{Key: "host"}}
+
+	sink := new(consumertest.MetricsSink)
+	conn, err := NewFactory().CreateMetricsToMetrics(ctx, connectortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+
+	md := pmetric.NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	gauge := ms.AppendEmpty().SetEmptyGauge()
+	gauge.DataPoints().AppendEmpty().Attributes().PutStr("host", "a")
+	gauge.DataPoints().AppendEmpty().Attributes().PutStr("host", "b")
+	ms.AppendEmpty().SetEmptySum().DataPoints().AppendEmpty().Attributes().PutStr("host", "a")
+	ms.AppendEmpty().SetEmptyHistogram().DataPoints().AppendEmpty()
+	ms.AppendEmpty().SetEmptyExponentialHistogram().DataPoints().AppendEmpty()
+	ms.AppendEmpty().SetEmptySummary().DataPoints().AppendEmpty()
+
+	require.NoError(t, conn.ConsumeMetrics(ctx, md))
+	require.Len(t, sink.AllMetrics(), 1)
+	assert.Equal(t, map[string]int64{
+		"dp.host=a;": 2,
+		"dp.host=b;": 1,
+		"":           3,
+	}, dataPoints(t, sink.AllMetrics()[0], defaultMetricNameDataPoints))
+}
+
+func TestLogsToMetrics(t *testing.T) {
+	ctx := context.Background()
+	cfg := createDefaultConfig().(*Config)
+
+	sink := new(consumertest.MetricsSink)
+	conn, err := NewFactory().CreateLogsToMetrics(ctx, connectortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	for _, svc := range []string{"foo", "bar"} {
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("service.name", svc)
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	}
+
+	require.NoError(t, conn.ConsumeLogs(ctx, ld))
+	require.Len(t, sink.AllMetrics(), 1)
+	assert.Equal(t, map[string]int64{
+		"service.name=foo;": 2,
+		"service.name=bar;": 2,
+	}, dataPoints(t, sink.AllMetrics()[0], defaultMetricNameLogs))
+}
+
+func TestEmptyInput(t *testing.T) {
+	ctx := context.Background()
+	sink := new(consumertest.MetricsSink)
+	conn, err := NewFactory().CreateLogsToMetrics(ctx, connectortest.NewNopCreateSettings(), createDefaultConfig(), sink)
+	require.NoError(t, err)
+
+	require.NoError(t, conn.ConsumeLogs(ctx, plog.NewLogs()))
+	assert.Empty(t, sink.AllMetrics())
+}
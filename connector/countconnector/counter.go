@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package countconnector // import "go.opentelemetry.io/collector/connector/countconnector"
+
+import (
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// attrCount is the count of items sharing the same set of attribute values.
+type attrCount struct {
+	attrs pcommon.Map
+	count int64
+}
+
+// counter counts items grouped by the attributes configured in a MetricInfo.
+type counter struct {
+	info   MetricInfo
+	keys   []string
+	counts map[string]*attrCount
+}
+
+func newCounter(info MetricInfo) *counter {
+	return &counter{
+		info:   info,
+		counts: map[string]*attrCount{},
+	}
+}
+
+// update counts one item. The configured attributes are looked up in attrMaps
+// in order, so the attributes of the item itself must be passed first,
+// followed by those of its parents.
+func (c *counter) update(attrMaps ...pcommon.Map) {
+	attrs := pcommon.NewMap()
+	var key strings.Builder
+	for _, attr := range c.info.Attributes {
+		val, ok := lookup(attr.Key, attrMaps)
+		switch {
+		case ok:
+			val.CopyTo(attrs.PutEmpty(attr.Key))
+		case attr.DefaultValue != "":
+			attrs.PutStr(attr.Key, attr.DefaultValue)
+		default:
+			// Distinguish a missing attribute from an empty value.
+			key.WriteByte(0)
+			continue
+		}
+		key.WriteByte(1)
+		v, _ := attrs.Get(attr.Key)
+		key.WriteString(v.AsString())
+		key.WriteByte(0)
+	}
+
+	k := key.String()
+	ac, ok := c.counts[k]
+	if !ok {
+		ac = &attrCount{attrs: attrs}
+		c.counts[k] = ac
+		c.keys = append(c.keys, k)
+	}
+	ac.count++
+}
+
+func lookup(key string, attrMaps []pcommon.Map) (pcommon.Value, bool) {
+	for _, attrs := range attrMaps {
+		if val, ok := attrs.Get(key); ok {
+			return val, true
+		}
+	}
+	return pcommon.Value{}, false
+}
+
+// empty returns whether no item was counted.
+func (c *counter) empty() bool {
+	return len(c.keys) == 0
+}
+
+// appendMetric appends a delta sum with one data point per set of attribute values.
+func (c *counter) appendMetric(ms pmetric.MetricSlice, timestamp pcommon.Timestamp) {
+	if c.empty() {
+		return
+	}
+	m := ms.AppendEmpty()
+	m.SetName(c.info.Name)
+	m.SetDescription(c.info.Description)
+	sum := m.SetEmptySum()
+	sum.SetIsMonotonic(true)
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	// Emit data points in the order their attribute values were first seen.
+	for _, k := range c.keys {
+		ac := c.counts[k]
+		dp := sum.DataPoints().AppendEmpty()
+		ac.attrs.CopyTo(dp.Attributes())
+		dp.SetIntValue(ac.count)
+		dp.SetTimestamp(timestamp)
+	}
+}
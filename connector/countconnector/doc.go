@@ -0,0 +1,6 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package countconnector counts spans, span events, metric data points and log
+// records, and emits the counts as metrics.
+package countconnector // import "go.opentelemetry.io/collector/connector/countconnector"
@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package countconnector // import "go.opentelemetry.io/collector/connector/countconnector"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/consumer"
+)
+
+const (
+	typeStr = "count"
+)
+
+// NewFactory returns a connector.Factory.
+func NewFactory() connector.Factory {
+	return connector.NewFactory(
+		typeStr,
+		createDefaultConfig,
+		connector.WithTracesToMetrics(createTracesToMetrics, component.StabilityLevelDevelopment),
+		connector.WithMetricsToMetrics(createMetricsToMetrics, component.StabilityLevelDevelopment),
+		connector.WithLogsToMetrics(createLogsToMetrics, component.StabilityLevelDevelopment),
+	)
+}
+
+// createDefaultConfig creates the default configuration.
+func createDefaultConfig() component.Config {
+	return &Config{
+		Spans: MetricInfo{
+			Name:        defaultMetricNameSpans,
+			Description: defaultMetricDescSpans,
+		},
+		SpanEvents: MetricInfo{
+			Name:        defaultMetricNameSpanEvents,
+			Description: defaultMetricDescSpanEvents,
+		},
+		DataPoints: MetricInfo{
+			Name:        defaultMetricNameDataPoints,
+			Description: defaultMetricDescDataPoints,
+		},
+		Logs: MetricInfo{
+			Name:        defaultMetricNameLogs,
+			Description: defaultMetricDescLogs,
+		},
+	}
+}
+
+// createTracesToMetrics creates a traces to metrics connector based on provided config.
+func createTracesToMetrics(
+	_ context.Context,
+	_ connector.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (connector.Traces, error) {
+	return &count{Metrics: nextConsumer, cfg: cfg.(*Config)}, nil
+}
+
+// createMetricsToMetrics creates a metrics to metrics connector based on provided config.
+func createMetricsToMetrics(
+	_ context.Context,
+	_ connector.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (connector.Metrics, error) {
+	return &count{Metrics: nextConsumer, cfg: cfg.(*Config)}, nil
+}
+
+// createLogsToMetrics creates a logs to metrics connector based on provided config.
+func createLogsToMetrics(
+	_ context.Context,
+	_ connector.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (connector.Logs, error) {
+	return &count{Metrics: nextConsumer, cfg: cfg.(*Config)}, nil
+}
@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package failoverconnector // import "go.opentelemetry.io/collector/connector/failoverconnector"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Config defines configuration for the failover connector.
+type Config struct {
+	// Priority is the list of pipelines the connector sends data to, ordered
+	// from the most to the least preferred. Data is sent to the first pipeline
+	// in the list whose consumer accepts it without error.
+	Priority []component.ID `mapstructure:"priority"`
+
+	// RetryInterval is the time the connector keeps sending data to a lower
+	// priority pipeline before probing the higher priority pipelines again.
+	RetryInterval time.Duration `mapstructure:"retry_interval"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the connector configuration is valid.
+func (cfg *Config) Validate() error {
+	if len(cfg.Priority) == 0 {
+		return errors.New("priority must contain at least one pipeline")
+	}
+	uniq := map[component.ID]bool{}
+	for _, id := range cfg.Priority {
+		if uniq[id] {
+			return fmt.Errorf("duplicate entry in priority: %q", id)
+		}
+		uniq[id] = true
+	}
+	if cfg.RetryInterval <= 0 {
+		return errors.New("retry_interval must be greater than 0")
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package failoverconnector
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+func TestUnmarshalConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	assert.NoError(t, component.UnmarshalConfig(cm, cfg))
+	assert.Equal(t,
+		&Config{
+			Priority: []component.ID{
+				component.NewIDWithName(component.DataTypeTraces, "primary"),
+				component.NewIDWithName(component.DataTypeTraces, "backup"),
+			},
+			RetryInterval: 5 * time.Minute,
+		}, cfg)
+}
+
+func TestValidateConfig(t *testing.T) {
+	primary := component.NewIDWithName(component.DataTypeTraces, "primary")
+	backup := component.NewIDWithName(component.DataTypeTraces, "backup")
+	tests := []struct {
+		name    string
+		cfg     *Config
+		wantErr string
+	}{
+		{
+			name: "valid",
+			cfg:  &Config{Priority: []component.ID{primary, backup}, RetryInterval: time.Second},
+		},
+		{
+			name:    "empty priority",
+			cfg:     &Config{RetryInterval: time.Second},
+			wantErr: "priority must contain at least one pipeline",
+		},
+		{
+			name:    "duplicate pipeline",
+			cfg:     &Config{Priority: []component.ID{primary, primary}, RetryInterval: time.Second},
+			wantErr: `duplicate entry in priority: "traces/primary"`,
+		},
+		{
+			name:    "invalid retry interval",
+			cfg:     &Config{Priority: []component.ID{primary}},
+			wantErr: "retry_interval must be greater than 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,6 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package failoverconnector sends signals to the highest priority pipeline that
+// accepts them, falling back to lower priority pipelines on failure.
+package failoverconnector // import "go.opentelemetry.io/collector/connector/failoverconnector"
@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package failoverconnector // import "go.opentelemetry.io/collector/connector/failoverconnector"
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/consumer"
+)
+
+const (
+	typeStr = "failover"
+
+	defaultRetryInterval = time.Minute
+)
+
+var errNotRouter = errors.New("next consumer is not a connector router")
+
+// NewFactory returns a connector.Factory.
+func NewFactory() connector.Factory {
+	return connector.NewFactory(
+		typeStr,
+		createDefaultConfig,
+		connector.WithTracesToTraces(createTracesToTraces, component.StabilityLevelDevelopment),
+		connector.WithMetricsToMetrics(createMetricsToMetrics, component.StabilityLevelDevelopment),
+		connector.WithLogsToLogs(createLogsToLogs, component.StabilityLevelDevelopment),
+	)
+}
+
+// createDefaultConfig creates the default configuration.
+func createDefaultConfig() component.Config {
+	return &Config{
+		RetryInterval: defaultRetryInterval,
+	}
+}
+
+// createTracesToTraces creates a traces connector based on provided config.
+func createTracesToTraces(
+	_ context.Context,
+	set connector.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Traces,
+) (connector.Traces, error) {
+	r, ok := nextConsumer.(connector.TracesRouter)
+	if !ok {
+		return nil, errNotRouter
+	}
+	return newTracesFailover(set, cfg.(*Config), r)
+}
+
+// createMetricsToMetrics creates a metrics connector based on provided config.
+func createMetricsToMetrics(
+	_ context.Context,
+	set connector.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (connector.Metrics, error) {
+	r, ok := nextConsumer.(connector.MetricsRouter)
+	if !ok {
+		return nil, errNotRouter
+	}
+	return newMetricsFailover(set, cfg.(*Config), r)
+}
+
+// createLogsToLogs creates a logs connector based on provided config.
+func createLogsToLogs(
+	_ context.Context,
+	set connector.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Logs,
+) (connector.Logs, error) {
+	r, ok := nextConsumer.(connector.LogsRouter)
+	if !ok {
+		return nil, errNotRouter
+	}
+	return newLogsFailover(set, cfg.(*Config), r)
+}
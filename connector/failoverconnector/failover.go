@@ -0,0 +1,221 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package failoverconnector // import "go.opentelemetry.io/collector/connector/failoverconnector"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// priorityRouter keeps track of the pipeline that is currently receiving data
+// and decides when the higher priority pipelines should be probed again.
+type priorityRouter struct {
+	logger        *zap.Logger
+	pipelineIDs   []component.ID
+	retryInterval time.Duration
+	now           func() time.Time
+
+	mu        sync.Mutex
+	active    int
+	nextProbe time.Time
+}
+
+func newPriorityRouter(set connector.CreateSettings, cfg *Config) *priorityRouter {
+	return &priorityRouter{
+		logger:        set.Logger,
+		pipelineIDs:   cfg.Priority,
+		retryInterval: cfg.RetryInterval,
+		now:           time.Now,
+	}
+}
+
+// consume calls send with the index of each pipeline to try, in priority order,
+// until one of them succeeds. The last argument of send reports whether the
+// pipeline is the last one that will be tried for this request.
+func (r *priorityRouter) consume(send func(idx int, last bool) error) error {
+	start := r.startIndex()
+	var errs error
+	for i := start; i < len(r.pipelineIDs); i++ {
+		err := send(i, i == len(r.pipelineIDs)-1)
+		if err == nil {
+			r.reportSuccess(start, i)
+			return nil
+		}
+		errs = multierr.Append(errs, fmt.Errorf("pipeline %q: %w", r.pipelineIDs[i], err))
+	}
+	r.reportFailure()
+	return errs
+}
+
+// startIndex returns the index of the first pipeline to try. This is the active
+// pipeline, unless it is time to probe the higher priority pipelines again.
+func (r *priorityRouter) startIndex() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.active > 0 && !r.now().Before(r.nextProbe) {
+		return 0
+	}
+	return r.active
+}
+
+func (r *priorityRouter) reportSuccess(start, idx int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	switch {
+	case idx > r.active:
+		r.logger.Warn("Failing over to lower priority pipeline",
+			zap.Stringer("from", r.pipelineIDs[r.active]),
+			zap.Stringer("to", r.pipelineIDs[idx]))
+	case idx < r.active:
+		r.logger.Info("Failing back to higher priority pipeline",
+			zap.Stringer("from", r.pipelineIDs[r.active]),
+			zap.Stringer("to", r.pipelineIDs[idx]))
+	}
+	r.active = idx
+	// Some higher priority pipelines failed for this request,
+	// wait before trying them again.
+	if start < idx {
+		r.nextProbe = r.now().Add(r.retryInterval)
+	}
+}
+
+func (r *priorityRouter) reportFailure() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.active > 0 {
+		r.nextProbe = r.now().Add(r.retryInterval)
+	}
+}
+
+func (r *priorityRouter) activePipeline() component.ID {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.pipelineIDs[r.active]
+}
+
+type tracesFailover struct {
+	component.StartFunc
+	component.ShutdownFunc
+	router    *priorityRouter
+	consumers []consumer.Traces
+}
+
+func newTracesFailover(set connector.CreateSettings, cfg *Config, r connector.TracesRouter) (*tracesFailover, error) {
+	consumers := make([]consumer.Traces, 0, len(cfg.Priority))
+	for _, id := range cfg.Priority {
+		c, err := r.Consumer(id)
+		if err != nil {
+			return nil, err
+		}
+		consumers = append(consumers, c)
+	}
+	return &tracesFailover{router: newPriorityRouter(set, cfg), consumers: consumers}, nil
+}
+
+func (f *tracesFailover) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+// ConsumeTraces sends the ptrace.Traces to the highest priority pipeline that accepts them.
+func (f *tracesFailover) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	return f.router.consume(func(idx int, last bool) error {
+		tc := f.consumers[idx]
+		// A mutating pipeline must not modify the data a lower priority
+		// pipeline may receive if this one fails.
+		if !last && tc.Capabilities().MutatesData {
+			clone := ptrace.NewTraces()
+			td.CopyTo(clone)
+			return tc.ConsumeTraces(ctx, clone)
+		}
+		return tc.ConsumeTraces(ctx, td)
+	})
+}
+
+type metricsFailover struct {
+	component.StartFunc
+	component.ShutdownFunc
+	router    *priorityRouter
+	consumers []consumer.Metrics
+}
+
+func newMetricsFailover(set connector.CreateSettings, cfg *Config, r connector.MetricsRouter) (*metricsFailover, error) {
+	consumers := make([]consumer.Metrics, 0, len(cfg.Priority))
+	for _, id := range cfg.Priority {
+		c, err := r.Consumer(id)
+		if err != nil {
+			return nil, err
+		}
+		consumers = append(consumers, c)
+	}
+	return &metricsFailover{router: newPriorityRouter(set, cfg), consumers: consumers}, nil
+}
+
+func (f *metricsFailover) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+// ConsumeMetrics sends the pmetric.Metrics to the highest priority pipeline that accepts them.
+func (f *metricsFailover) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	return f.router.consume(func(idx int, last bool) error {
+		mc := f.consumers[idx]
+		// A mutating pipeline must not modify the data a lower priority
+		// pipeline may receive if this one fails.
+		if !last && mc.Capabilities().MutatesData {
+			clone := pmetric.NewMetrics()
+			md.CopyTo(clone)
+			return mc.ConsumeMetrics(ctx, clone)
+		}
+		return mc.ConsumeMetrics(ctx, md)
+	})
+}
+
+type logsFailover struct {
+	component.StartFunc
+	component.ShutdownFunc
+	router    *priorityRouter
+	consumers []consumer.Logs
+}
+
+func newLogsFailover(set connector.CreateSettings, cfg *Config, r connector.LogsRouter) (*logsFailover, error) {
+	consumers := make([]consumer.Logs, 0, len(cfg.Priority))
+	for _, id := range cfg.Priority {
+		c, err := r.Consumer(id)
+		if err != nil {
+			return nil, err
+		}
+		consumers = append(consumers, c)
+	}
+	return &logsFailover{router: newPriorityRouter(set, cfg), consumers: consumers}, nil
+}
+
+func (f *logsFailover) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+// ConsumeLogs sends the plog.Logs to the highest priority pipeline that accepts them.
+func (f *logsFailover) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	return f.router.consume(func(idx int, last bool) error {
+		lc := f.consumers[idx]
+		// A mutating pipeline must not modify the data a lower priority
+		// pipeline may receive if this one fails.
+		if !last && lc.Capabilities().MutatesData {
+			clone := plog.NewLogs()
+			ld.CopyTo(clone)
+			return lc.ConsumeLogs(ctx, clone)
+		}
+		return lc.ConsumeLogs(ctx, ld)
+	})
+}
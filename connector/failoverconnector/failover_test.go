@@ -0,0 +1,200 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package failoverconnector
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/connector/connectortest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/internal/fanoutconsumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var (
+	primaryID = component.NewIDWithName(component.DataTypeTraces, "primary")
+	backupID  = component.NewIDWithName(component.DataTypeTraces, "backup")
+	errBoom   = errors.New("boom")
+)
+
+func newConfig() *Config {
+	return &Config{
+		Priority:      []component.ID{primaryID, backupID},
+		RetryInterval: time.Minute,
+	}
+}
+
+func TestTracesFailover(t *testing.T) {
+	ctx := context.Background()
+	primaryFails := false
+	primarySink := new(consumertest.TracesSink)
+	primary, err := consumer.NewTraces(func(ctx context.Context, td ptrace.Traces) error {
+		if primaryFails {
+			return errBoom
+		}
+		return primarySink.ConsumeTraces(ctx, td)
+	})
+	require.NoError(t, err)
+	backupSink := new(consumertest.TracesSink)
+	router := fanoutconsumer.NewTracesRouter(map[component.ID]consumer.Traces{
+		primaryID: primary,
+		backupID:  backupSink,
+	})
+
+	f := NewFactory()
+	conn, err := f.CreateTracesToTraces(ctx, connectortest.NewNopCreateSettings(), newConfig(), router)
+	require.NoError(t, err)
+	require.NoError(t, conn.Start(ctx, componenttest.NewNopHost()))
+
+	now := time.Now()
+	fo := conn.(*tracesFailover)
+	fo.router.now = func() time.Time { return now }
+
+	assert.NoError(t, conn.ConsumeTraces(ctx, ptrace.NewTraces()))
+	assert.Len(t, primarySink.AllTraces(), 1)
+	assert.Len(t, backupSink.AllTraces(), 0)
+
+	// Primary fails, data goes to the backup pipeline which becomes active.
+	primaryFails = true
+	assert.NoError(t, conn.ConsumeTraces(ctx, ptrace.NewTraces()))
+	assert.Len(t, backupSink.AllTraces(), 1)
+	assert.Equal(t, backupID, fo.router.activePipeline())
+
+	// Primary recovers, but it is not probed before the retry interval elapsed.
+	primaryFails = false
+	assert.NoError(t, conn.ConsumeTraces(ctx, ptrace.NewTraces()))
+	assert.Len(t, primarySink.AllTraces(), 1)
+	assert.Len(t, backupSink.AllTraces(), 2)
+
+	// Once the retry interval elapsed, the primary is probed and the connector fails back.
+	now = now.Add(time.Minute)
+	assert.NoError(t, conn.ConsumeTraces(ctx, ptrace.NewTraces()))
+	assert.Len(t, primarySink.AllTraces(), 2)
+	assert.Len(t, backupSink.AllTraces(), 2)
+	assert.Equal(t, primaryID, fo.router.activePipeline())
+
+	assert.NoError(t, conn.Shutdown(ctx))
+}
+
+func TestTracesFailoverProbeFails(t *testing.T) {
+	ctx := context.Background()
+	primaryCalls := 0
+	primary, err := consumer.NewTraces(func(context.Context, ptrace.Traces) error {
+		primaryCalls++
+		return errBoom
+	})
+	require.NoError(t, err)
+	backupSink := new(consumertest.TracesSink)
+	router := fanoutconsumer.NewTracesRouter(map[component.ID]consumer.Traces{
+		primaryID: primary,
+		backupID:  backupSink,
+	})
+
+	conn, err := NewFactory().CreateTracesToTraces(ctx, connectortest.NewNopCreateSettings(), newConfig(), router)
+	require.NoError(t, err)
+	now := time.Now()
+	fo := conn.(*tracesFailover)
+	fo.router.now = func() time.Time { return now }
+
+	assert.NoError(t, conn.ConsumeTraces(ctx, ptrace.NewTraces()))
+	assert.NoError(t, conn.ConsumeTraces(ctx, ptrace.NewTraces()))
+	assert.Equal(t, 1, primaryCalls)
+
+	// The failed probe postpones the next one by the retry interval.
+	now = now.Add(time.Minute)
+	assert.NoError(t, conn.ConsumeTraces(ctx, ptrace.NewTraces()))
+	assert.NoError(t, conn.ConsumeTraces(ctx, ptrace.NewTraces()))
+	assert.Equal(t, 2, primaryCalls)
+	assert.Len(t, backupSink.AllTraces(), 4)
+	assert.Equal(t, backupID, fo.router.activePipeline())
+}
+
+func TestTracesFailoverAllFail(t *testing.T) {
+	ctx := context.Background()
+	router := fanoutconsumer.NewTracesRouter(map[component.ID]consumer.Traces{
+		primaryID: consumertest.NewErr(errBoom),
+		backupID:  consumertest.NewErr(errBoom),
+	})
+	conn, err := NewFactory().CreateTracesToTraces(ctx, connectortest.NewNopCreateSettings(), newConfig(), router)
+	require.NoError(t, err)
+
+	err = conn.ConsumeTraces(ctx, ptrace.NewTraces())
+	assert.ErrorIs(t, err, errBoom)
+	assert.ErrorContains(t, err, `pipeline "traces/primary"`)
+	assert.ErrorContains(t, err, `pipeline "traces/backup"`)
+}
+
+func TestTracesFailoverClonesForMutatingPipeline(t *testing.T) {
+	ctx := context.Background()
+	mutating, err := consumer.NewTraces(func(_ context.Context, td ptrace.Traces) error {
+		td.ResourceSpans().AppendEmpty()
+		return errBoom
+	}, consumer.WithCapabilities(consumer.Capabilities{MutatesData: true}))
+	require.NoError(t, err)
+	backupSink := new(consumertest.TracesSink)
+	router := fanoutconsumer.NewTracesRouter(map[component.ID]consumer.Traces{
+		primaryID: mutating,
+		backupID:  backupSink,
+	})
+	conn, err := NewFactory().CreateTracesToTraces(ctx, connectortest.NewNopCreateSettings(), newConfig(), router)
+	require.NoError(t, err)
+
+	assert.NoError(t, conn.ConsumeTraces(ctx, ptrace.NewTraces()))
+	require.Len(t, backupSink.AllTraces(), 1)
+	assert.Equal(t, 0, backupSink.AllTraces()[0].ResourceSpans().Len())
+}
+
+func TestMetricsFailover(t *testing.T) {
+	ctx := context.Background()
+	backupSink := new(consumertest.MetricsSink)
+	router := fanoutconsumer.NewMetricsRouter(map[component.ID]consumer.Metrics{
+		primaryID: consumertest.NewErr(errBoom),
+		backupID:  backupSink,
+	})
+	conn, err := NewFactory().CreateMetricsToMetrics(ctx, connectortest.NewNopCreateSettings(), newConfig(), router)
+	require.NoError(t, err)
+
+	assert.NoError(t, conn.ConsumeMetrics(ctx, pmetric.NewMetrics()))
+	assert.Len(t, backupSink.AllMetrics(), 1)
+	assert.Equal(t, backupID, conn.(*metricsFailover).router.activePipeline())
+}
+
+func TestLogsFailover(t *testing.T) {
+	ctx := context.Background()
+	backupSink := new(consumertest.LogsSink)
+	router := fanoutconsumer.NewLogsRouter(map[component.ID]consumer.Logs{
+		primaryID: consumertest.NewErr(errBoom),
+		backupID:  backupSink,
+	})
+	conn, err := NewFactory().CreateLogsToLogs(ctx, connectortest.NewNopCreateSettings(), newConfig(), router)
+	require.NoError(t, err)
+
+	assert.NoError(t, conn.ConsumeLogs(ctx, plog.NewLogs()))
+	assert.Len(t, backupSink.AllLogs(), 1)
+	assert.Equal(t, backupID, conn.(*logsFailover).router.activePipeline())
+}
+
+func TestCreateMissingPipeline(t *testing.T) {
+	ctx := context.Background()
+	router := connectortest.NewTracesRouter(connectortest.WithNopTraces(primaryID))
+	_, err := NewFactory().CreateTracesToTraces(ctx, connectortest.NewNopCreateSettings(), newConfig(), router.(consumer.Traces))
+	assert.ErrorContains(t, err, `missing consumer: "traces/backup"`)
+}
+
+func TestCreateNotRouter(t *testing.T) {
+	ctx := context.Background()
+	_, err := NewFactory().CreateTracesToTraces(ctx, connectortest.NewNopCreateSettings(), newConfig(), consumertest.NewNop())
+	assert.ErrorIs(t, err, errNotRouter)
+}
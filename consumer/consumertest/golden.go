@@ -0,0 +1,361 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package consumertest // import "go.opentelemetry.io/collector/consumer/consumertest"
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// ReadGoldenTraces reads the traces from a golden file. The file is OTLP JSON if its extension is
+// .json, or the same structure as YAML if its extension is .yaml or .yml.
+func ReadGoldenTraces(path string) (ptrace.Traces, error) {
+	buf, err := readGolden(path)
+	if err != nil {
+		return ptrace.Traces{}, err
+	}
+	unmarshaler := &ptrace.JSONUnmarshaler{}
+	return unmarshaler.UnmarshalTraces(buf)
+}
+
+// WriteGoldenTraces writes the traces to a golden file, in the format matching its extension.
+func WriteGoldenTraces(path string, td ptrace.Traces) error {
+	marshaler := &ptrace.JSONMarshaler{}
+	buf, err := marshaler.MarshalTraces(td)
+	if err != nil {
+		return err
+	}
+	return writeGolden(path, buf)
+}
+
+// ReadGoldenMetrics reads the metrics from a golden file. The file is OTLP JSON if its extension is
+// .json, or the same structure as YAML if its extension is .yaml or .yml.
+func ReadGoldenMetrics(path string) (pmetric.Metrics, error) {
+	buf, err := readGolden(path)
+	if err != nil {
+		return pmetric.Metrics{}, err
+	}
+	unmarshaler := &pmetric.JSONUnmarshaler{}
+	return unmarshaler.UnmarshalMetrics(buf)
+}
+
+// WriteGoldenMetrics writes the metrics to a golden file, in the format matching its extension.
+func WriteGoldenMetrics(path string, md pmetric.Metrics) error {
+	marshaler := &pmetric.JSONMarshaler{}
+	buf, err := marshaler.MarshalMetrics(md)
+	if err != nil {
+		return err
+	}
+	return writeGolden(path, buf)
+}
+
+// ReadGoldenLogs reads the logs from a golden file. The file is OTLP JSON if its extension is
+// .json, or the same structure as YAML if its extension is .yaml or .yml.
+func ReadGoldenLogs(path string) (plog.Logs, error) {
+	buf, err := readGolden(path)
+	if err != nil {
+		return plog.Logs{}, err
+	}
+	unmarshaler := &plog.JSONUnmarshaler{}
+	return unmarshaler.UnmarshalLogs(buf)
+}
+
+// WriteGoldenLogs writes the logs to a golden file, in the format matching its extension.
+func WriteGoldenLogs(path string, ld plog.Logs) error {
+	marshaler := &plog.JSONMarshaler{}
+	buf, err := marshaler.MarshalLogs(ld)
+	if err != nil {
+		return err
+	}
+	return writeGolden(path, buf)
+}
+
+// readGolden reads a golden file and returns its content as OTLP JSON.
+func readGolden(path string) ([]byte, error) {
+	buf, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	switch filepath.Ext(path) {
+	case ".json":
+		return buf, nil
+	case ".yaml", ".yml":
+		var content any
+		if err = yaml.Unmarshal(buf, &content); err != nil {
+			return nil, fmt.Errorf("failed to read golden file %q: %w", path, err)
+		}
+		return json.Marshal(content)
+	default:
+		return nil, fmt.Errorf("unsupported golden file extension %q, expected .json, .yaml or .yml", filepath.Ext(path))
+	}
+}
+
+// writeGolden writes OTLP JSON to a golden file, converted to the format matching its extension.
+func writeGolden(path string, buf []byte) error {
+	var out []byte
+	switch filepath.Ext(path) {
+	case ".json":
+		indented := &bytes.Buffer{}
+		if err := json.Indent(indented, buf, "", "  "); err != nil {
+			return err
+		}
+		out = append(indented.Bytes(), '\n')
+	case ".yaml", ".yml":
+		var content any
+		if err := json.Unmarshal(buf, &content); err != nil {
+			return err
+		}
+		var err error
+		if out, err = yaml.Marshal(content); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported golden file extension %q, expected .json, .yaml or .yml", filepath.Ext(path))
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, out, 0600)
+}
+
+// NormalizeTraces makes the traces reproducible, to compare them with a golden file. It resets all
+// the timestamps, and replaces the trace and span ids with sequential ids. The ids are assigned in
+// a canonical order of the spans, based on their content, their resource and their scope, so the
+// same spans get the same ids whatever order they arrive in. The relations between the spans,
+// their parents and their links are preserved.
+func NormalizeTraces(td ptrace.Traces) {
+	var items canonicalItems
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			for k := 0; k < ss.Spans().Len(); k++ {
+				span := ss.Spans().At(k)
+				span.SetStartTimestamp(0)
+				span.SetEndTimestamp(0)
+				for l := 0; l < span.Events().Len(); l++ {
+					span.Events().At(l).SetTimestamp(0)
+				}
+				items.add(spanKey(rs.Resource(), ss.Scope(), span), func(ids *idNormalizer) {
+					span.SetTraceID(ids.traceID(span.TraceID()))
+					span.SetSpanID(ids.spanID(span.SpanID()))
+					span.SetParentSpanID(ids.spanID(span.ParentSpanID()))
+					for l := 0; l < span.Links().Len(); l++ {
+						link := span.Links().At(l)
+						link.SetTraceID(ids.traceID(link.TraceID()))
+						link.SetSpanID(ids.spanID(link.SpanID()))
+					}
+				})
+			}
+		}
+	}
+	items.normalize()
+}
+
+// spanKey returns the canonical key of a span, which does not depend on its ids.
+func spanKey(resource pcommon.Resource, scope pcommon.InstrumentationScope, span ptrace.Span) string {
+	td := ptrace.NewTraces()
+	content := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.CopyTo(content)
+	content.SetTraceID(pcommon.NewTraceIDEmpty())
+	content.SetSpanID(pcommon.NewSpanIDEmpty())
+	content.SetParentSpanID(pcommon.NewSpanIDEmpty())
+	content.Attributes().Clear()
+	for i := 0; i < content.Links().Len(); i++ {
+		content.Links().At(i).SetTraceID(pcommon.NewTraceIDEmpty())
+		content.Links().At(i).SetSpanID(pcommon.NewSpanIDEmpty())
+	}
+	buf, _ := (&ptrace.JSONMarshaler{}).MarshalTraces(td)
+	return canonicalKey(resource, scope, span.Attributes(), buf)
+}
+
+// NormalizeMetrics makes the metrics reproducible, to compare them with a golden file. It resets
+// all the timestamps, and replaces the trace and span ids of the exemplars with sequential ids,
+// assigned in a canonical order of the exemplars, as NormalizeTraces does.
+func NormalizeMetrics(md pmetric.Metrics) {
+	var items canonicalItems
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			normalizeExemplars := func(metric pmetric.Metric, attrs pcommon.Map, exemplars pmetric.ExemplarSlice) {
+				for l := 0; l < exemplars.Len(); l++ {
+					exemplar := exemplars.At(l)
+					exemplar.SetTimestamp(0)
+					items.add(exemplarKey(rm.Resource(), sm.Scope(), metric, attrs, exemplar), func(ids *idNormalizer) {
+						exemplar.SetTraceID(ids.traceID(exemplar.TraceID()))
+						exemplar.SetSpanID(ids.spanID(exemplar.SpanID()))
+					})
+				}
+			}
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				metric := sm.Metrics().At(k)
+				switch metric.Type() {
+				case pmetric.MetricTypeGauge:
+					normalizeNumberDataPoints(metric, metric.Gauge().DataPoints(), normalizeExemplars)
+				case pmetric.MetricTypeSum:
+					normalizeNumberDataPoints(metric, metric.Sum().DataPoints(), normalizeExemplars)
+				case pmetric.MetricTypeHistogram:
+					for l := 0; l < metric.Histogram().DataPoints().Len(); l++ {
+						dp := metric.Histogram().DataPoints().At(l)
+						dp.SetStartTimestamp(0)
+						dp.SetTimestamp(0)
+						normalizeExemplars(metric, dp.Attributes(), dp.Exemplars())
+					}
+				case pmetric.MetricTypeExponentialHistogram:
+					for l := 0; l < metric.ExponentialHistogram().DataPoints().Len(); l++ {
+						dp := metric.ExponentialHistogram().DataPoints().At(l)
+						dp.SetStartTimestamp(0)
+						dp.SetTimestamp(0)
+						normalizeExemplars(metric, dp.Attributes(), dp.Exemplars())
+					}
+				case pmetric.MetricTypeSummary:
+					for l := 0; l < metric.Summary().DataPoints().Len(); l++ {
+						dp := metric.Summary().DataPoints().At(l)
+						dp.SetStartTimestamp(0)
+						dp.SetTimestamp(0)
+					}
+				}
+			}
+		}
+	}
+	items.normalize()
+}
+
+func normalizeNumberDataPoints(metric pmetric.Metric, dps pmetric.NumberDataPointSlice, normalizeExemplars func(pmetric.Metric, pcommon.Map, pmetric.ExemplarSlice)) {
+	for i := 0; i < dps.Len(); i++ {
+		dps.At(i).SetStartTimestamp(0)
+		dps.At(i).SetTimestamp(0)
+		normalizeExemplars(metric, dps.At(i).Attributes(), dps.At(i).Exemplars())
+	}
+}
+
+// exemplarKey returns the canonical key of an exemplar, from its metric, the attributes of its
+// data point and its value, which does not depend on its ids.
+func exemplarKey(resource pcommon.Resource, scope pcommon.InstrumentationScope, metric pmetric.Metric, attrs pcommon.Map, exemplar pmetric.Exemplar) string {
+	buf, _ := json.Marshal([]any{metric.Name(), exemplar.FilteredAttributes().AsRaw(), exemplar.DoubleValue(), exemplar.IntValue()})
+	return canonicalKey(resource, scope, attrs, buf)
+}
+
+// NormalizeLogs makes the logs reproducible, to compare them with a golden file. It resets the
+// timestamps and observed timestamps, and replaces the trace and span ids with sequential ids,
+// assigned in a canonical order of the log records, as NormalizeTraces does.
+func NormalizeLogs(ld plog.Logs) {
+	var items canonicalItems
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				lr := sl.LogRecords().At(k)
+				lr.SetTimestamp(0)
+				lr.SetObservedTimestamp(0)
+				items.add(logRecordKey(rl.Resource(), sl.Scope(), lr), func(ids *idNormalizer) {
+					lr.SetTraceID(ids.traceID(lr.TraceID()))
+					lr.SetSpanID(ids.spanID(lr.SpanID()))
+				})
+			}
+		}
+	}
+	items.normalize()
+}
+
+// logRecordKey returns the canonical key of a log record, which does not depend on its ids.
+func logRecordKey(resource pcommon.Resource, scope pcommon.InstrumentationScope, lr plog.LogRecord) string {
+	ld := plog.NewLogs()
+	content := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.CopyTo(content)
+	content.SetTraceID(pcommon.NewTraceIDEmpty())
+	content.SetSpanID(pcommon.NewSpanIDEmpty())
+	content.Attributes().Clear()
+	buf, _ := (&plog.JSONMarshaler{}).MarshalLogs(ld)
+	return canonicalKey(resource, scope, lr.Attributes(), buf)
+}
+
+// canonicalKey returns a key of an item of content, with its resource, its scope and its
+// attributes, which does not depend on the order of the attributes.
+func canonicalKey(resource pcommon.Resource, scope pcommon.InstrumentationScope, attrs pcommon.Map, content []byte) string {
+	key, _ := json.Marshal([]any{
+		resource.Attributes().AsRaw(),
+		scope.Name(),
+		scope.Version(),
+		scope.Attributes().AsRaw(),
+		attrs.AsRaw(),
+		json.RawMessage(content),
+	})
+	return string(key)
+}
+
+// canonicalItems are the items holding ids to normalize, with their canonical keys. The ids are
+// normalized in the order of the keys, so they do not depend on the order of the items.
+type canonicalItems []canonicalItem
+
+type canonicalItem struct {
+	key       string
+	normalize func(ids *idNormalizer)
+}
+
+func (items *canonicalItems) add(key string, normalize func(ids *idNormalizer)) {
+	*items = append(*items, canonicalItem{key: key, normalize: normalize})
+}
+
+func (items canonicalItems) normalize() {
+	sort.SliceStable(items, func(i, j int) bool { return items[i].key < items[j].key })
+	ids := newIDNormalizer()
+	for _, item := range items {
+		item.normalize(ids)
+	}
+}
+
+// idNormalizer replaces the ids with sequential ids, always replacing the same id with the same
+// sequential id. Empty ids are kept empty.
+type idNormalizer struct {
+	traceIDs map[pcommon.TraceID]pcommon.TraceID
+	spanIDs  map[pcommon.SpanID]pcommon.SpanID
+}
+
+func newIDNormalizer() *idNormalizer {
+	return &idNormalizer{
+		traceIDs: map[pcommon.TraceID]pcommon.TraceID{},
+		spanIDs:  map[pcommon.SpanID]pcommon.SpanID{},
+	}
+}
+
+func (n *idNormalizer) traceID(id pcommon.TraceID) pcommon.TraceID {
+	if id.IsEmpty() {
+		return id
+	}
+	if normalized, ok := n.traceIDs[id]; ok {
+		return normalized
+	}
+	var normalized pcommon.TraceID
+	binary.BigEndian.PutUint64(normalized[8:], uint64(len(n.traceIDs)+1))
+	n.traceIDs[id] = normalized
+	return normalized
+}
+
+func (n *idNormalizer) spanID(id pcommon.SpanID) pcommon.SpanID {
+	if id.IsEmpty() {
+		return id
+	}
+	if normalized, ok := n.spanIDs[id]; ok {
+		return normalized
+	}
+	var normalized pcommon.SpanID
+	binary.BigEndian.PutUint64(normalized[:], uint64(len(n.spanIDs)+1))
+	n.spanIDs[id] = normalized
+	return normalized
+}
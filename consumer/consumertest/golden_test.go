@@ -0,0 +1,170 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package consumertest
+
+import (
+	"context"
+	"flag"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pdatatest/plogtest"
+	"go.opentelemetry.io/collector/pdata/pdatatest/pmetrictest"
+	"go.opentelemetry.io/collector/pdata/pdatatest/ptracetest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var updateGolden = flag.Bool("update-golden", false, "Write the golden files instead of comparing them.")
+
+func newGoldenTraces(ts pcommon.Timestamp, traceID pcommon.TraceID) ptrace.Traces {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "frontend")
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Scope().SetName("instrumentation")
+	parent := ss.Spans().AppendEmpty()
+	parent.SetName("GET /")
+	parent.SetTraceID(traceID)
+	parent.SetSpanID(pcommon.SpanID{traceID[0], 1})
+	parent.SetStartTimestamp(ts)
+	parent.SetEndTimestamp(ts + 100)
+	parent.Attributes().PutInt("http.status_code", 200)
+	child := ss.Spans().AppendEmpty()
+	child.SetName("SELECT")
+	child.SetTraceID(traceID)
+	child.SetSpanID(pcommon.SpanID{traceID[0], 2})
+	child.SetParentSpanID(parent.SpanID())
+	child.SetStartTimestamp(ts + 10)
+	child.SetEndTimestamp(ts + 90)
+	return td
+}
+
+func newGoldenMetrics(ts pcommon.Timestamp) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("requests")
+	m.SetEmptySum().SetIsMonotonic(true)
+	m.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	dp := m.Sum().DataPoints().AppendEmpty()
+	dp.Attributes().PutStr("method", "GET")
+	dp.SetStartTimestamp(ts)
+	dp.SetTimestamp(ts + 100)
+	dp.SetDoubleValue(12.5)
+	return md
+}
+
+func newGoldenLogs(ts pcommon.Timestamp) plog.Logs {
+	ld := plog.NewLogs()
+	lr := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetTimestamp(ts)
+	lr.SetObservedTimestamp(ts + 1)
+	lr.SetSeverityNumber(plog.SeverityNumberWarn)
+	lr.Body().SetStr("disk almost full")
+	lr.SetTraceID(pcommon.TraceID{byte(ts)})
+	return ld
+}
+
+func TestGoldenRoundTrip(t *testing.T) {
+	for _, ext := range []string{".json", ".yaml", ".yml"} {
+		t.Run(ext, func(t *testing.T) {
+			dir := t.TempDir()
+			td := newGoldenTraces(1000, pcommon.TraceID{1})
+			require.NoError(t, WriteGoldenTraces(filepath.Join(dir, "traces"+ext), td))
+			readTraces, err := ReadGoldenTraces(filepath.Join(dir, "traces"+ext))
+			require.NoError(t, err)
+			assert.NoError(t, ptracetest.CompareTraces(td, readTraces))
+
+			md := newGoldenMetrics(1000)
+			require.NoError(t, WriteGoldenMetrics(filepath.Join(dir, "metrics"+ext), md))
+			readMetrics, err := ReadGoldenMetrics(filepath.Join(dir, "metrics"+ext))
+			require.NoError(t, err)
+			assert.NoError(t, pmetrictest.CompareMetrics(md, readMetrics))
+
+			ld := newGoldenLogs(1000)
+			require.NoError(t, WriteGoldenLogs(filepath.Join(dir, "logs"+ext), ld))
+			readLogs, err := ReadGoldenLogs(filepath.Join(dir, "logs"+ext))
+			require.NoError(t, err)
+			assert.NoError(t, plogtest.CompareLogs(ld, readLogs))
+		})
+	}
+}
+
+func TestGoldenUnsupportedExtension(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.txt")
+	assert.EqualError(t, WriteGoldenTraces(path, ptrace.NewTraces()), `unsupported golden file extension ".txt", expected .json, .yaml or .yml`)
+	_, err := ReadGoldenTraces(path)
+	assert.Error(t, err)
+}
+
+func TestNormalizeTraces(t *testing.T) {
+	td := newGoldenTraces(1000, pcommon.TraceID{5})
+	NormalizeTraces(td)
+	spans := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+	assert.Equal(t, pcommon.Timestamp(0), spans.At(0).StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(0), spans.At(1).EndTimestamp())
+	assert.Equal(t, pcommon.TraceID{15: 1}, spans.At(0).TraceID())
+	assert.Equal(t, pcommon.TraceID{15: 1}, spans.At(1).TraceID())
+	assert.Equal(t, pcommon.SpanID{7: 1}, spans.At(0).SpanID())
+	assert.Equal(t, pcommon.SpanID{7: 2}, spans.At(1).SpanID())
+	assert.Equal(t, spans.At(0).SpanID(), spans.At(1).ParentSpanID())
+	assert.True(t, spans.At(0).ParentSpanID().IsEmpty())
+}
+
+func TestSinkCompareGolden(t *testing.T) {
+	tracesSink := new(TracesSink)
+	metricsSink := new(MetricsSink)
+	logsSink := new(LogsSink)
+	for i := 0; i < 2; i++ {
+		ts := pcommon.Timestamp(1000 * (i + 1))
+		require.NoError(t, tracesSink.ConsumeTraces(context.Background(), newGoldenTraces(ts, pcommon.TraceID{byte(i + 1)})))
+		require.NoError(t, metricsSink.ConsumeMetrics(context.Background(), newGoldenMetrics(ts)))
+		require.NoError(t, logsSink.ConsumeLogs(context.Background(), newGoldenLogs(ts)))
+	}
+
+	assert.NoError(t, tracesSink.CompareGolden(filepath.Join("testdata", "traces.yaml"), *updateGolden))
+	assert.NoError(t, metricsSink.CompareGolden(filepath.Join("testdata", "metrics.yaml"), *updateGolden))
+	assert.NoError(t, logsSink.CompareGolden(filepath.Join("testdata", "logs.json"), *updateGolden))
+}
+
+func TestSinkCompareGoldenReordered(t *testing.T) {
+	if *updateGolden {
+		t.Skip("the golden file is updated by TestSinkCompareGolden")
+	}
+	sink := new(TracesSink)
+	for i := 1; i >= 0; i-- {
+		td := newGoldenTraces(pcommon.Timestamp(1000*(i+1)), pcommon.TraceID{byte(i + 1)})
+		td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().Sort(func(a, b ptrace.Span) bool { return a.Name() > b.Name() })
+		require.NoError(t, sink.ConsumeTraces(context.Background(), td))
+	}
+
+	assert.NoError(t, sink.CompareGolden(filepath.Join("testdata", "traces.yaml"), false,
+		ptracetest.IgnoreResourceSpansOrder(), ptracetest.IgnoreSpansOrder()))
+}
+
+func TestSinkCompareGoldenMismatch(t *testing.T) {
+	if *updateGolden {
+		t.Skip("the golden file is updated by TestSinkCompareGolden")
+	}
+	sink := new(TracesSink)
+	require.NoError(t, sink.ConsumeTraces(context.Background(), newGoldenTraces(1000, pcommon.TraceID{1})))
+	assert.ErrorContains(t, sink.CompareGolden(filepath.Join("testdata", "traces.yaml"), false), `missing expected resource [service.name="frontend"]`)
+}
+
+func TestSinkCompareGoldenUpdate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "golden", "traces.yaml")
+	sink := new(TracesSink)
+	require.NoError(t, sink.ConsumeTraces(context.Background(), newGoldenTraces(1000, pcommon.TraceID{1})))
+	require.NoError(t, sink.CompareGolden(path, true))
+
+	td, err := ReadGoldenTraces(path)
+	require.NoError(t, err)
+	assert.Equal(t, 2, td.SpanCount())
+	assert.Equal(t, pcommon.TraceID{15: 1}, td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).TraceID())
+}
@@ -8,6 +8,9 @@ import (
 	"sync"
 
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pdatatest/plogtest"
+	"go.opentelemetry.io/collector/pdata/pdatatest/pmetrictest"
+	"go.opentelemetry.io/collector/pdata/pdatatest/ptracetest"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
@@ -61,6 +64,30 @@ func (ste *TracesSink) Reset() {
 	ste.spanCount = 0
 }
 
+// CompareGolden compares the traces stored by this sink since last Reset with the traces of the
+// golden file at path, read with ReadGoldenTraces. Both are normalized with NormalizeTraces
+// first. If update is true, the stored traces are written to the golden file instead, e.g. when
+// the tests are run with a flag regenerating the golden files.
+func (ste *TracesSink) CompareGolden(path string, update bool, options ...ptracetest.CompareTracesOption) error {
+	actual := ptrace.NewTraces()
+	for _, td := range ste.AllTraces() {
+		for i := 0; i < td.ResourceSpans().Len(); i++ {
+			td.ResourceSpans().At(i).CopyTo(actual.ResourceSpans().AppendEmpty())
+		}
+	}
+	NormalizeTraces(actual)
+	if update {
+		return WriteGoldenTraces(path, actual)
+	}
+
+	expected, err := ReadGoldenTraces(path)
+	if err != nil {
+		return err
+	}
+	NormalizeTraces(expected)
+	return ptracetest.CompareTraces(expected, actual, options...)
+}
+
 // MetricsSink is a consumer.Metrics that acts like a sink that
 // stores all metrics and allows querying them for testing.
 type MetricsSink struct {
@@ -109,6 +136,30 @@ func (sme *MetricsSink) Reset() {
 	sme.dataPointCount = 0
 }
 
+// CompareGolden compares the metrics stored by this sink since last Reset with the metrics of the
+// golden file at path, read with ReadGoldenMetrics. Both are normalized with NormalizeMetrics
+// first. If update is true, the stored metrics are written to the golden file instead, e.g. when
+// the tests are run with a flag regenerating the golden files.
+func (sme *MetricsSink) CompareGolden(path string, update bool, options ...pmetrictest.CompareMetricsOption) error {
+	actual := pmetric.NewMetrics()
+	for _, md := range sme.AllMetrics() {
+		for i := 0; i < md.ResourceMetrics().Len(); i++ {
+			md.ResourceMetrics().At(i).CopyTo(actual.ResourceMetrics().AppendEmpty())
+		}
+	}
+	NormalizeMetrics(actual)
+	if update {
+		return WriteGoldenMetrics(path, actual)
+	}
+
+	expected, err := ReadGoldenMetrics(path)
+	if err != nil {
+		return err
+	}
+	NormalizeMetrics(expected)
+	return pmetrictest.CompareMetrics(expected, actual, options...)
+}
+
 // LogsSink is a consumer.Logs that acts like a sink that
 // stores all logs and allows querying them for testing.
 type LogsSink struct {
@@ -156,3 +207,27 @@ func (sle *LogsSink) Reset() {
 	sle.logs = nil
 	sle.logRecordCount = 0
 }
+
+// CompareGolden compares the logs stored by this sink since last Reset with the logs of the golden
+// file at path, read with ReadGoldenLogs. Both are normalized with NormalizeLogs first. If update
+// is true, the stored logs are written to the golden file instead, e.g. when the tests are run with
+// a flag regenerating the golden files.
+func (sle *LogsSink) CompareGolden(path string, update bool, options ...plogtest.CompareLogsOption) error {
+	actual := plog.NewLogs()
+	for _, ld := range sle.AllLogs() {
+		for i := 0; i < ld.ResourceLogs().Len(); i++ {
+			ld.ResourceLogs().At(i).CopyTo(actual.ResourceLogs().AppendEmpty())
+		}
+	}
+	NormalizeLogs(actual)
+	if update {
+		return WriteGoldenLogs(path, actual)
+	}
+
+	expected, err := ReadGoldenLogs(path)
+	if err != nil {
+		return err
+	}
+	NormalizeLogs(expected)
+	return plogtest.CompareLogs(expected, actual, options...)
+}
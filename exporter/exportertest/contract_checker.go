@@ -0,0 +1,346 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exportertest // import "go.opentelemetry.io/collector/exporter/exportertest"
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configtelemetry"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/internal/obsreportconfig"
+	"go.opentelemetry.io/collector/internal/obsreportconfig/obsmetrics"
+)
+
+// Protocol is the protocol spoken by the mock backend the exporter sends the data to.
+type Protocol string
+
+const (
+	// ProtocolGRPC is OTLP/gRPC.
+	ProtocolGRPC Protocol = "grpc"
+	// ProtocolHTTP is OTLP/HTTP, with protobuf payloads.
+	ProtocolHTTP Protocol = "http"
+)
+
+type CheckConsumeContractParams struct {
+	T *testing.T
+	// Factory that allows to create an exporter.
+	Factory exporter.Factory
+	// DataType to test for.
+	DataType component.DataType
+	// Protocol of the mock backend the exporter sends the data to.
+	Protocol Protocol
+	// CreateConfig returns the config of the exporter to use, sending the data to the given
+	// endpoint of the mock backend: "localhost:<port>" for ProtocolGRPC and
+	// "http://localhost:<port>" for ProtocolHTTP.
+	CreateConfig func(endpoint string) component.Config
+	// NumberOfTestElements is the number of elements (spans, metric data points or log records)
+	// to send for each test scenario. Each element is sent with a separate Consume*() call.
+	NumberOfTestElements int
+}
+
+// CheckConsumeContract checks the contract between the exporter and its backend, for exporters built
+// with exporterhelper. The exporter sends the data to a mock backend answering with successes, retryable
+// errors, throttling, permanent errors and partial successes. The checker will detect duplicate or lost data,
+// throttled data sent again too early, counters of sent and failed data that don't match what the backend
+// received, and data sent after the shutdown or not drained from the queue on shutdown.
+//
+// What is expected depends on the "sending_queue" and "retry_on_failure" settings of the config:
+// the data refused with a retryable error is expected to be eventually delivered only when the retries
+// are enabled, and the Consume*() calls are expected to fail only when the queue is disabled or full.
+func CheckConsumeContract(params CheckConsumeContractParams) {
+	// Different scenarios to test for.
+	// The decision function defines how the backend answers to the requests of the exporter.
+	scenarios := []contractScenario{
+		{
+			name: "always_succeed",
+			// Always succeed. We expect all data to be delivered as is.
+			decisionFunc: func() backendResponse { return responseSuccess },
+		},
+		{
+			name:         "random_retryable_error",
+			decisionFunc: randomDecision(responseRetryable, 0.5),
+		},
+		{
+			name: "random_throttling",
+			// Throttling makes the exporter wait, throttle rarely to keep the test short.
+			decisionFunc: randomDecision(responseThrottle, 0.05),
+		},
+		{
+			name:         "random_permanent_error",
+			decisionFunc: randomDecision(responsePermanent, 0.5),
+		},
+		{
+			name:         "random_partial_success",
+			decisionFunc: randomDecision(responsePartialSuccess, 0.5),
+		},
+		{
+			name:         "random_error",
+			decisionFunc: randomErrorsDecision,
+		},
+		{
+			name: "shutdown_drains_queue",
+			// The backend is slow, so that the queue is not empty when the exporter is shut down.
+			decisionFunc:      blockingDecision(func() backendResponse { return responseSuccess }),
+			shutdownRightAway: true,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		params.T.Run(
+			scenario.name, func(t *testing.T) {
+				scenarioParams := params
+				scenarioParams.T = t
+				checkConsumeContractScenario(scenarioParams, scenario)
+			},
+		)
+	}
+}
+
+// contractScenario is a scenario tested by CheckConsumeContract.
+type contractScenario struct {
+	name         string
+	decisionFunc backendDecisionFunc
+	// shutdownRightAway shuts down the exporter as soon as all the data is consumed, instead of
+	// waiting for the exporter to send all the data.
+	shutdownRightAway bool
+}
+
+// concurrency is the number of goroutines consuming the data.
+const concurrency = 4
+
+func checkConsumeContractScenario(params CheckConsumeContractParams, scenario contractScenario) {
+	ctx := context.Background()
+
+	backend := newMockBackend(params.T, params.Protocol, scenario.decisionFunc)
+	endpoint, err := backend.start()
+	require.NoError(params.T, err)
+	defer backend.stop()
+
+	cfg := params.CreateConfig(endpoint)
+	settings, err := readHelperSettings(cfg)
+	require.NoError(params.T, err)
+
+	// Record the counters of the exporter. Each scenario uses its own exporter id, so that the
+	// counters of the scenarios are distinct.
+	views := obsreportconfig.AllViews(configtelemetry.LevelNormal)
+	require.NoError(params.T, view.Register(views...))
+	defer view.Unregister(views...)
+
+	set := NewNopCreateSettings()
+	set.ID = component.NewIDWithName(params.Factory.Type(), scenario.name)
+	set.TelemetrySettings.MetricsLevel = configtelemetry.LevelNormal
+
+	// Create and start the exporter.
+	var exp component.Component
+	var consume func(ctx context.Context, id uniqueIDAttrVal) error
+	switch params.DataType {
+	case component.DataTypeLogs:
+		var logs exporter.Logs
+		logs, err = params.Factory.CreateLogsExporter(ctx, set, cfg)
+		exp = logs
+		consume = func(ctx context.Context, id uniqueIDAttrVal) error {
+			return logs.ConsumeLogs(ctx, createOneLogWithID(id))
+		}
+	case component.DataTypeTraces:
+		var traces exporter.Traces
+		traces, err = params.Factory.CreateTracesExporter(ctx, set, cfg)
+		exp = traces
+		consume = func(ctx context.Context, id uniqueIDAttrVal) error {
+			return traces.ConsumeTraces(ctx, createOneSpanWithID(id))
+		}
+	case component.DataTypeMetrics:
+		var metrics exporter.Metrics
+		metrics, err = params.Factory.CreateMetricsExporter(ctx, set, cfg)
+		exp = metrics
+		consume = func(ctx context.Context, id uniqueIDAttrVal) error {
+			return metrics.ConsumeMetrics(ctx, createOneMetricWithID(id))
+		}
+	default:
+		require.FailNow(params.T, "must specify a valid DataType to test for")
+	}
+
+	require.NoError(params.T, err)
+
+	err = exp.Start(ctx, componenttest.NewNopHost())
+	require.NoError(params.T, err)
+
+	// Begin consuming data with the exporter.
+
+	var generatedIds, refusedIds idSet
+	var generatedIndex int64
+	var mux sync.Mutex
+	var wg sync.WaitGroup
+
+	// Create concurrent goroutines that consume the data.
+	// The total number of consumed elements will be equal to params.NumberOfTestElements.
+
+	for j := 0; j < concurrency; j++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				index := atomic.AddInt64(&generatedIndex, 1)
+				if index > int64(params.NumberOfTestElements) {
+					return
+				}
+				id := uniqueIDAttrVal(strconv.FormatInt(index, 10))
+				consumeErr := consume(ctx, id)
+
+				mux.Lock()
+				_ = generatedIds.merge(idSet{id: true})
+				if consumeErr != nil {
+					// The exporter refused the data, it is up to the caller to decide what to do with it.
+					_ = refusedIds.merge(idSet{id: true})
+				}
+				mux.Unlock()
+			}
+		}()
+	}
+
+	// Wait until all consuming goroutines are done.
+	wg.Wait()
+
+	// The elements refused because the queue is full are not counted as sent or failed,
+	// all the other elements are counted once the exporter is done with them.
+	expectedCounted := int64(len(generatedIds))
+	if settings.queueEnabled {
+		expectedCounted -= int64(len(refusedIds))
+	}
+
+	if !scenario.shutdownRightAway {
+		// Wait until the exporter is done with all the data.
+		assert.Eventually(params.T, func() bool {
+			sent, failed, counterErr := exporterCounters(set.ID, params.DataType)
+			return counterErr == nil && sent+failed >= expectedCounted
+		}, 10*time.Second, 10*time.Millisecond)
+	}
+
+	// Shut down the exporter, which must drain the queue before returning.
+	err = exp.Shutdown(ctx)
+	assert.NoError(params.T, err)
+	backend.shutdownExporter()
+
+	accepted, dropped, retry := backend.state()
+
+	// Check that the backend answered to each element at most once with a success, a permanent error
+	// or a partial success.
+	delivered, duplicates := accepted.union(dropped)
+	if len(duplicates) != 0 {
+		assert.Failf(params.T, "found duplicate elements in accepted and dropped data", "keys=%v", duplicates)
+	}
+	_, onlyInOther := generatedIds.compare(delivered)
+	if len(onlyInOther) != 0 {
+		assert.Failf(params.T, "found elements in accepted and dropped data that was never sent", "keys=%v", onlyInOther)
+	}
+
+	// Check that no data is lost. The data refused with a retryable error may only be lost when
+	// the retries are disabled.
+	expectedDelivered := idSet{}
+	for id := range generatedIds {
+		if !refusedIds[id] {
+			expectedDelivered[id] = true
+		}
+	}
+	if !settings.retryEnabled {
+		delivered, _ = delivered.union(retry)
+	}
+	missingInOther, _ := expectedDelivered.compare(delivered)
+	if len(missingInOther) != 0 {
+		assert.Failf(params.T, "found elements sent that were not delivered", "keys=%v", missingInOther)
+	}
+
+	if settings.queueEnabled {
+		// The data is only refused when the queue is full, and is never sent to the backend.
+		if common := delivered.intersection(refusedIds); len(common) != 0 {
+			assert.Failf(params.T, "found elements refused by the exporter that were sent", "keys=%v", common)
+		}
+	} else if common := accepted.intersection(refusedIds); len(common) != 0 {
+		// The caller is told that the data failed, it must not be accepted by the backend.
+		assert.Failf(params.T, "found elements refused by the exporter that were accepted", "keys=%v", common)
+	}
+
+	// Check that the counters of the exporter match what the backend received. All the data
+	// must have been counted once the exporter is shut down.
+	sent, failed, err := exporterCounters(set.ID, params.DataType)
+	require.NoError(params.T, err)
+	assert.Equal(params.T, int64(len(accepted)), sent, "the number of sent elements counted by the exporter doesn't match the number of elements accepted by the backend")
+	assert.Equal(params.T, expectedCounted-int64(len(accepted)), failed, "the number of failed elements counted by the exporter doesn't match the number of elements not accepted by the backend")
+
+	backend.printStats(len(generatedIds))
+}
+
+// helperSettings are the exporterhelper settings of the exporter config that change what to expect from the exporter.
+type helperSettings struct {
+	queueEnabled bool
+	retryEnabled bool
+}
+
+// readHelperSettings reads the "sending_queue" and "retry_on_failure" settings of the config.
+// The queue and the retries are disabled by default in exporterhelper.
+func readHelperSettings(cfg component.Config) (helperSettings, error) {
+	conf := confmap.New()
+	if err := conf.Marshal(cfg); err != nil {
+		return helperSettings{}, fmt.Errorf("unable to marshal the exporter config: %w", err)
+	}
+	return helperSettings{
+		queueEnabled: conf.Get("sending_queue::enabled") == true,
+		retryEnabled: conf.Get("retry_on_failure::enabled") == true,
+	}, nil
+}
+
+// exporterCounters returns the number of elements the exporter with the given id counted as sent and as
+// failed to send.
+func exporterCounters(id component.ID, dataType component.DataType) (sent int64, failed int64, err error) {
+	var sentMeasure, failedMeasure stats.Measure
+	switch dataType {
+	case component.DataTypeTraces:
+		sentMeasure, failedMeasure = obsmetrics.ExporterSentSpans, obsmetrics.ExporterFailedToSendSpans
+	case component.DataTypeMetrics:
+		sentMeasure, failedMeasure = obsmetrics.ExporterSentMetricPoints, obsmetrics.ExporterFailedToSendMetricPoints
+	case component.DataTypeLogs:
+		sentMeasure, failedMeasure = obsmetrics.ExporterSentLogRecords, obsmetrics.ExporterFailedToSendLogRecords
+	default:
+		return 0, 0, fmt.Errorf("unsupported data type %q", dataType)
+	}
+	if sent, err = counterValue(sentMeasure.Name(), id); err != nil {
+		return 0, 0, err
+	}
+	if failed, err = counterValue(failedMeasure.Name(), id); err != nil {
+		return 0, 0, err
+	}
+	return sent, failed, nil
+}
+
+// counterValue returns the value of the counter view for the exporter with the given id, 0 if nothing was recorded.
+func counterValue(viewName string, id component.ID) (int64, error) {
+	rows, err := view.RetrieveData(viewName)
+	if err != nil {
+		return 0, err
+	}
+	for _, row := range rows {
+		for _, t := range row.Tags {
+			if t.Key == obsmetrics.TagKeyExporter && t.Value == id.String() {
+				if sum, ok := row.Data.(*view.SumData); ok {
+					return int64(sum.Value), nil
+				}
+			}
+		}
+	}
+	return 0, nil
+}
@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exportertest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+type enabledSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+type helperConfig struct {
+	Queue enabledSettings `mapstructure:"sending_queue"`
+	Retry enabledSettings `mapstructure:"retry_on_failure"`
+}
+
+func TestReadHelperSettings(t *testing.T) {
+	settings, err := readHelperSettings(&helperConfig{Queue: enabledSettings{Enabled: true}})
+	require.NoError(t, err)
+	assert.Equal(t, helperSettings{queueEnabled: true, retryEnabled: false}, settings)
+
+	settings, err = readHelperSettings(&helperConfig{Retry: enabledSettings{Enabled: true}})
+	require.NoError(t, err)
+	assert.Equal(t, helperSettings{queueEnabled: false, retryEnabled: true}, settings)
+
+	// The queue and the retries are disabled when the config doesn't have the settings.
+	settings, err = readHelperSettings(&nopConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, helperSettings{}, settings)
+}
+
+func TestIDSetFromMetrics(t *testing.T) {
+	ids, err := idSetFromMetrics(createOneMetricWithID("1"))
+	require.NoError(t, err)
+	assert.Equal(t, idSet{"1": true}, ids)
+
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptySum().DataPoints().AppendEmpty()
+	_, err = idSetFromMetrics(md)
+	assert.EqualError(t, err, "invalid data element, metric type Sum is not generated")
+}
+
+func TestIDSetUnion(t *testing.T) {
+	union, duplicates := idSet{"1": true, "2": true}.union(idSet{"2": true, "3": true})
+	assert.Equal(t, idSet{"1": true, "2": true, "3": true}, union)
+	assert.Equal(t, []uniqueIDAttrVal{"2"}, duplicates)
+	assert.Equal(t, []uniqueIDAttrVal{"2"}, idSet{"1": true, "2": true}.intersection(idSet{"2": true, "3": true}))
+}
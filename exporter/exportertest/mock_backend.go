@@ -0,0 +1,626 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exportertest // import "go.opentelemetry.io/collector/exporter/exportertest"
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+)
+
+// uniqueIDAttrName is the attribute name that is used in log records/spans/datapoints as the unique identifier.
+const uniqueIDAttrName = "test_id"
+
+// uniqueIDAttrVal is the value type of the uniqueIDAttrName.
+type uniqueIDAttrVal string
+
+// backendResponse is the answer of the mock backend to a request.
+type backendResponse int
+
+const (
+	// responseSuccess accepts the data.
+	responseSuccess backendResponse = iota
+	// responseRetryable is a retryable error: Unavailable for gRPC, 503 for HTTP.
+	responseRetryable
+	// responseThrottle is a retryable error asking to retry after a delay: ResourceExhausted
+	// with a RetryInfo for gRPC, 429 with a Retry-After header for HTTP.
+	responseThrottle
+	// responsePermanent is a non retryable error: InvalidArgument for gRPC, 400 for HTTP.
+	responsePermanent
+	// responsePartialSuccess is a successful response rejecting all the elements of the request.
+	responsePartialSuccess
+)
+
+// A function that returns the answer of the mock backend to a request. Supplying different
+// decision functions allows to test different scenarios.
+type backendDecisionFunc func() backendResponse
+
+// randomDecision returns a decision function that answers with the given response approximately
+// with the given probability, and succeeds the rest of the time.
+func randomDecision(response backendResponse, probability float32) backendDecisionFunc {
+	return func() backendResponse {
+		if rand.Float32() < probability {
+			return response
+		}
+		return responseSuccess
+	}
+}
+
+// randomErrorsDecision is a decision function that succeeds approximately half of the time
+// and answers with any of the other responses the rest of the time. Throttling is less frequent,
+// since it makes the exporter wait.
+func randomErrorsDecision() backendResponse {
+	r := rand.Float32()
+	switch {
+	case r < 0.05:
+		return responseThrottle
+	case r < 0.2:
+		return responseRetryable
+	case r < 0.35:
+		return responsePermanent
+	case r < 0.5:
+		return responsePartialSuccess
+	}
+	return responseSuccess
+}
+
+// blockingDecision returns a decision function that blocks for a random duration of up to 10ms,
+// like a slow backend, before deciding with the given decision function.
+func blockingDecision(decisionFunc backendDecisionFunc) backendDecisionFunc {
+	return func() backendResponse {
+		time.Sleep(time.Duration(rand.Int63n(int64(10 * time.Millisecond))))
+		return decisionFunc()
+	}
+}
+
+// mockBackend is an OTLP gRPC or HTTP server answering to the exporter based on the decision made
+// by decisionFunc, and remembering the accepted and dropped data sets for later checks.
+type mockBackend struct {
+	t            *testing.T
+	protocol     Protocol
+	decisionFunc backendDecisionFunc
+	// throttleDelay is the delay to wait before retrying, sent with the responseThrottle responses.
+	// HTTP only supports a number of seconds in the Retry-After header.
+	throttleDelay time.Duration
+
+	listener   net.Listener
+	grpcServer *grpc.Server
+	httpServer *http.Server
+
+	mux sync.Mutex
+	// acceptedIds are the ids of the elements accepted by the backend.
+	acceptedIds idSet
+	// droppedIds are the ids of the elements rejected by the backend with a permanent error
+	// or a partial success, that the exporter must not send again.
+	droppedIds idSet
+	// retryIds are the ids of the elements that got a retryable answer and were not accepted
+	// or dropped since.
+	retryIds idSet
+	// retryNotBefore is the earliest time the exporter is allowed to send again the throttled elements.
+	retryNotBefore     map[uniqueIDAttrVal]time.Time
+	retryableResponses int
+	throttleResponses  int
+	// exporterShutdown is set once the exporter is shut down. The exporter must not send
+	// data anymore after that.
+	exporterShutdown bool
+}
+
+func newMockBackend(t *testing.T, protocol Protocol, decisionFunc backendDecisionFunc) *mockBackend {
+	b := &mockBackend{
+		t:              t,
+		protocol:       protocol,
+		decisionFunc:   decisionFunc,
+		throttleDelay:  100 * time.Millisecond,
+		retryNotBefore: map[uniqueIDAttrVal]time.Time{},
+	}
+	if protocol == ProtocolHTTP {
+		b.throttleDelay = time.Second
+	}
+	return b
+}
+
+// start listens on a random local port and returns the endpoint of the backend.
+func (b *mockBackend) start() (string, error) {
+	ln, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return "", err
+	}
+	b.listener = ln
+
+	switch b.protocol {
+	case ProtocolGRPC:
+		b.grpcServer = grpc.NewServer()
+		ptraceotlp.RegisterGRPCServer(b.grpcServer, &tracesServer{backend: b})
+		pmetricotlp.RegisterGRPCServer(b.grpcServer, &metricsServer{backend: b})
+		plogotlp.RegisterGRPCServer(b.grpcServer, &logsServer{backend: b})
+		go func() { _ = b.grpcServer.Serve(ln) }()
+		return ln.Addr().String(), nil
+	case ProtocolHTTP:
+		mux := http.NewServeMux()
+		mux.HandleFunc("/v1/traces", b.handleHTTP(httpTraces))
+		mux.HandleFunc("/v1/metrics", b.handleHTTP(httpMetrics))
+		mux.HandleFunc("/v1/logs", b.handleHTTP(httpLogs))
+		b.httpServer = &http.Server{Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+		go func() { _ = b.httpServer.Serve(ln) }()
+		return "http://" + ln.Addr().String(), nil
+	}
+	_ = ln.Close()
+	return "", fmt.Errorf("unsupported protocol %q", b.protocol)
+}
+
+func (b *mockBackend) stop() {
+	if b.grpcServer != nil {
+		b.grpcServer.Stop()
+	}
+	if b.httpServer != nil {
+		_ = b.httpServer.Close()
+	}
+}
+
+// consume the elements with the specified ids, regardless of the element data type, and
+// returns the answer to send to the exporter.
+func (b *mockBackend) consume(ids idSet) backendResponse {
+	// The decision function may block, so it is called without holding the lock to let
+	// the exporter send requests concurrently.
+	b.mux.Lock()
+	if b.exporterShutdown {
+		assert.Fail(b.t, "the exporter sent data after it was shut down")
+	}
+	now := time.Now()
+	for id := range ids {
+		if notBefore, ok := b.retryNotBefore[id]; ok && now.Before(notBefore) {
+			assert.Failf(b.t, "throttled element sent again too early", "key=%v, %v before the end of the throttling", id, notBefore.Sub(now))
+		}
+	}
+	b.mux.Unlock()
+
+	response := b.decisionFunc()
+
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	switch response {
+	case responseRetryable, responseThrottle:
+		// Don't add it to the accepted or dropped lists, the exporter must send it again
+		// unless it doesn't retry.
+		_ = b.retryIds.merge(ids)
+		if response == responseThrottle {
+			b.throttleResponses++
+			for id := range ids {
+				b.retryNotBefore[id] = time.Now().Add(b.throttleDelay)
+			}
+		} else {
+			b.retryableResponses++
+		}
+	case responsePermanent, responsePartialSuccess:
+		b.removeRetry(ids)
+		duplicates := b.droppedIds.merge(ids)
+		assert.Empty(b.t, duplicates, "elements that were dropped previously were sent again")
+		duplicates = b.acceptedIds.intersection(ids)
+		assert.Empty(b.t, duplicates, "elements that were accepted previously were sent again")
+	default:
+		b.removeRetry(ids)
+		duplicates := b.acceptedIds.merge(ids)
+		assert.Empty(b.t, duplicates, "elements that were accepted previously were sent again")
+		duplicates = b.droppedIds.intersection(ids)
+		assert.Empty(b.t, duplicates, "elements that were dropped previously were sent again")
+	}
+	return response
+}
+
+func (b *mockBackend) removeRetry(ids idSet) {
+	for id := range ids {
+		delete(b.retryIds, id)
+		delete(b.retryNotBefore, id)
+	}
+}
+
+// shutdownExporter records that the exporter was shut down.
+func (b *mockBackend) shutdownExporter() {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	b.exporterShutdown = true
+}
+
+// state returns a copy of the accepted, dropped and waiting for a retry ids.
+func (b *mockBackend) state() (accepted, dropped, retry idSet) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	return b.acceptedIds.clone(), b.droppedIds.clone(), b.retryIds.clone()
+}
+
+// printStats prints some stats to help debug test failures.
+func (b *mockBackend) printStats(generated int) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	fmt.Printf(
+		"Sent %d, accepted=%d, dropped=%d, retryable errors=%d, throttled=%d\n",
+		generated,
+		len(b.acceptedIds),
+		len(b.droppedIds),
+		b.retryableResponses,
+		b.throttleResponses,
+	)
+}
+
+// grpcStatus returns the gRPC error for the response, nil if the response is a success.
+func (b *mockBackend) grpcStatus(response backendResponse) error {
+	switch response {
+	case responseRetryable:
+		return status.Error(codes.Unavailable, "the mock backend is unavailable")
+	case responseThrottle:
+		st, err := status.New(codes.ResourceExhausted, "the mock backend is throttling").
+			WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(b.throttleDelay)})
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		return st.Err()
+	case responsePermanent:
+		return status.Error(codes.InvalidArgument, "the mock backend refused the data")
+	}
+	return nil
+}
+
+type tracesServer struct {
+	ptraceotlp.UnimplementedGRPCServer
+	backend *mockBackend
+}
+
+func (s *tracesServer) Export(_ context.Context, req ptraceotlp.ExportRequest) (ptraceotlp.ExportResponse, error) {
+	resp := ptraceotlp.NewExportResponse()
+	ids, err := idSetFromTraces(req.Traces())
+	if err != nil {
+		assert.NoError(s.backend.t, err)
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+	response := s.backend.consume(ids)
+	if response == responsePartialSuccess {
+		resp.PartialSuccess().SetRejectedSpans(int64(len(ids)))
+		resp.PartialSuccess().SetErrorMessage("rejected by the mock backend")
+	}
+	return resp, s.backend.grpcStatus(response)
+}
+
+type metricsServer struct {
+	pmetricotlp.UnimplementedGRPCServer
+	backend *mockBackend
+}
+
+func (s *metricsServer) Export(_ context.Context, req pmetricotlp.ExportRequest) (pmetricotlp.ExportResponse, error) {
+	resp := pmetricotlp.NewExportResponse()
+	ids, err := idSetFromMetrics(req.Metrics())
+	if err != nil {
+		assert.NoError(s.backend.t, err)
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+	response := s.backend.consume(ids)
+	if response == responsePartialSuccess {
+		resp.PartialSuccess().SetRejectedDataPoints(int64(len(ids)))
+		resp.PartialSuccess().SetErrorMessage("rejected by the mock backend")
+	}
+	return resp, s.backend.grpcStatus(response)
+}
+
+type logsServer struct {
+	plogotlp.UnimplementedGRPCServer
+	backend *mockBackend
+}
+
+func (s *logsServer) Export(_ context.Context, req plogotlp.ExportRequest) (plogotlp.ExportResponse, error) {
+	resp := plogotlp.NewExportResponse()
+	ids, err := idSetFromLogs(req.Logs())
+	if err != nil {
+		assert.NoError(s.backend.t, err)
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+	response := s.backend.consume(ids)
+	if response == responsePartialSuccess {
+		resp.PartialSuccess().SetRejectedLogRecords(int64(len(ids)))
+		resp.PartialSuccess().SetErrorMessage("rejected by the mock backend")
+	}
+	return resp, s.backend.grpcStatus(response)
+}
+
+// httpSignal decodes the OTLP/HTTP requests of a signal, and encodes the partial success responses.
+type httpSignal struct {
+	unmarshal      func(body []byte) (idSet, error)
+	partialSuccess func(rejected int) ([]byte, error)
+}
+
+var httpTraces = httpSignal{
+	unmarshal: func(body []byte) (idSet, error) {
+		req := ptraceotlp.NewExportRequest()
+		if err := req.UnmarshalProto(body); err != nil {
+			return nil, err
+		}
+		return idSetFromTraces(req.Traces())
+	},
+	partialSuccess: func(rejected int) ([]byte, error) {
+		resp := ptraceotlp.NewExportResponse()
+		resp.PartialSuccess().SetRejectedSpans(int64(rejected))
+		resp.PartialSuccess().SetErrorMessage("rejected by the mock backend")
+		return resp.MarshalProto()
+	},
+}
+
+var httpMetrics = httpSignal{
+	unmarshal: func(body []byte) (idSet, error) {
+		req := pmetricotlp.NewExportRequest()
+		if err := req.UnmarshalProto(body); err != nil {
+			return nil, err
+		}
+		return idSetFromMetrics(req.Metrics())
+	},
+	partialSuccess: func(rejected int) ([]byte, error) {
+		resp := pmetricotlp.NewExportResponse()
+		resp.PartialSuccess().SetRejectedDataPoints(int64(rejected))
+		resp.PartialSuccess().SetErrorMessage("rejected by the mock backend")
+		return resp.MarshalProto()
+	},
+}
+
+var httpLogs = httpSignal{
+	unmarshal: func(body []byte) (idSet, error) {
+		req := plogotlp.NewExportRequest()
+		if err := req.UnmarshalProto(body); err != nil {
+			return nil, err
+		}
+		return idSetFromLogs(req.Logs())
+	},
+	partialSuccess: func(rejected int) ([]byte, error) {
+		resp := plogotlp.NewExportResponse()
+		resp.PartialSuccess().SetRejectedLogRecords(int64(rejected))
+		resp.PartialSuccess().SetErrorMessage("rejected by the mock backend")
+		return resp.MarshalProto()
+	},
+}
+
+func (b *mockBackend) handleHTTP(signal httpSignal) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := readHTTPBody(r)
+		if err != nil {
+			assert.NoError(b.t, err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		ids, err := signal.unmarshal(body)
+		if err != nil {
+			assert.NoError(b.t, err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		switch b.consume(ids) {
+		case responseRetryable:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case responseThrottle:
+			w.Header().Set("Retry-After", strconv.Itoa(int(b.throttleDelay/time.Second)))
+			w.WriteHeader(http.StatusTooManyRequests)
+		case responsePermanent:
+			w.WriteHeader(http.StatusBadRequest)
+		case responsePartialSuccess:
+			resp, err := signal.partialSuccess(len(ids))
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", "application/x-protobuf")
+			_, _ = w.Write(resp)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}
+}
+
+// readHTTPBody reads the body of the request, decompressed according to its Content-Encoding.
+func readHTTPBody(r *http.Request) ([]byte, error) {
+	var reader io.Reader = r.Body
+	switch r.Header.Get("Content-Encoding") {
+	case "":
+	case "gzip":
+		gr, err := gzip.NewReader(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		defer gr.Close()
+		reader = gr
+	case "deflate", "zlib":
+		zr, err := zlib.NewReader(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		reader = zr
+	default:
+		return nil, fmt.Errorf("unsupported Content-Encoding %q", r.Header.Get("Content-Encoding"))
+	}
+	return io.ReadAll(reader)
+}
+
+// idSet is a set of unique ids of data elements used in the test (logs, spans or metric data points).
+type idSet map[uniqueIDAttrVal]bool
+
+// compare to another set and calculate the differences from this set.
+func (ds idSet) compare(other idSet) (missingInOther, onlyInOther []uniqueIDAttrVal) {
+	for k := range ds {
+		if _, ok := other[k]; !ok {
+			missingInOther = append(missingInOther, k)
+		}
+	}
+	for k := range other {
+		if _, ok := ds[k]; !ok {
+			onlyInOther = append(onlyInOther, k)
+		}
+	}
+	return
+}
+
+// merge another set into this one and return a list of duplicate ids.
+func (ds *idSet) merge(other idSet) (duplicates []uniqueIDAttrVal) {
+	if *ds == nil {
+		*ds = map[uniqueIDAttrVal]bool{}
+	}
+	for k, v := range other {
+		if _, ok := (*ds)[k]; ok {
+			duplicates = append(duplicates, k)
+		} else {
+			(*ds)[k] = v
+		}
+	}
+	return
+}
+
+// intersection returns the ids of another set that are also in this set.
+func (ds idSet) intersection(other idSet) (common []uniqueIDAttrVal) {
+	for k := range other {
+		if _, ok := ds[k]; ok {
+			common = append(common, k)
+		}
+	}
+	return
+}
+
+// union computes the union of this and another sets. A new set if created to return the result.
+// Also returns a list of any duplicate ids found.
+func (ds idSet) union(other idSet) (union idSet, duplicates []uniqueIDAttrVal) {
+	union = ds.clone()
+	duplicates = union.merge(other)
+	return
+}
+
+func (ds idSet) clone() idSet {
+	ret := make(idSet, len(ds))
+	for k, v := range ds {
+		ret[k] = v
+	}
+	return ret
+}
+
+// idFromAttributes returns the id of a data element from its attributes.
+func idFromAttributes(attrs pcommon.Map) (uniqueIDAttrVal, error) {
+	key, exists := attrs.Get(uniqueIDAttrName)
+	if !exists {
+		return "", fmt.Errorf("invalid data element, attribute %q is missing", uniqueIDAttrName)
+	}
+	if key.Type() != pcommon.ValueTypeStr {
+		return "", fmt.Errorf("invalid data element, attribute %q is wrong type %v", uniqueIDAttrName, key.Type())
+	}
+	return uniqueIDAttrVal(key.Str()), nil
+}
+
+// idSetFromTraces computes an idSet from given ptrace.Traces. The idSet will contain ids of all spans.
+func idSetFromTraces(data ptrace.Traces) (idSet, error) {
+	ds := map[uniqueIDAttrVal]bool{}
+	rss := data.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		ils := rss.At(i).ScopeSpans()
+		for j := 0; j < ils.Len(); j++ {
+			ss := ils.At(j).Spans()
+			for k := 0; k < ss.Len(); k++ {
+				id, err := idFromAttributes(ss.At(k).Attributes())
+				if err != nil {
+					return ds, err
+				}
+				ds[id] = true
+			}
+		}
+	}
+	return ds, nil
+}
+
+// idSetFromMetrics computes an idSet from given pmetric.Metrics. The idSet will contain ids of all
+// gauge data points, the only metric type generated by the checker.
+func idSetFromMetrics(data pmetric.Metrics) (idSet, error) {
+	ds := map[uniqueIDAttrVal]bool{}
+	rms := data.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		ilms := rms.At(i).ScopeMetrics()
+		for j := 0; j < ilms.Len(); j++ {
+			ms := ilms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				if ms.At(k).Type() != pmetric.MetricTypeGauge {
+					return ds, fmt.Errorf("invalid data element, metric type %v is not generated", ms.At(k).Type())
+				}
+				dps := ms.At(k).Gauge().DataPoints()
+				for l := 0; l < dps.Len(); l++ {
+					id, err := idFromAttributes(dps.At(l).Attributes())
+					if err != nil {
+						return ds, err
+					}
+					ds[id] = true
+				}
+			}
+		}
+	}
+	return ds, nil
+}
+
+// idSetFromLogs computes an idSet from given plog.Logs. The idSet will contain ids of all log records.
+func idSetFromLogs(data plog.Logs) (idSet, error) {
+	ds := map[uniqueIDAttrVal]bool{}
+	rls := data.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		ils := rls.At(i).ScopeLogs()
+		for j := 0; j < ils.Len(); j++ {
+			lrs := ils.At(j).LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				id, err := idFromAttributes(lrs.At(k).Attributes())
+				if err != nil {
+					return ds, err
+				}
+				ds[id] = true
+			}
+		}
+	}
+	return ds, nil
+}
+
+func createOneSpanWithID(id uniqueIDAttrVal) ptrace.Traces {
+	data := ptrace.NewTraces()
+	data.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().Attributes().PutStr(
+		uniqueIDAttrName,
+		string(id),
+	)
+	return data
+}
+
+func createOneMetricWithID(id uniqueIDAttrVal) pmetric.Metrics {
+	data := pmetric.NewMetrics()
+	metric := data.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	metric.SetName("test_metric")
+	metric.SetEmptyGauge().DataPoints().AppendEmpty().Attributes().PutStr(uniqueIDAttrName, string(id))
+	return data
+}
+
+func createOneLogWithID(id uniqueIDAttrVal) plog.Logs {
+	data := plog.NewLogs()
+	data.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Attributes().PutStr(
+		uniqueIDAttrName,
+		string(id),
+	)
+	return data
+}
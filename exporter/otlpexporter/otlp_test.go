@@ -23,11 +23,13 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/configgrpc"
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.opentelemetry.io/collector/exporter/exportertest"
 	"go.opentelemetry.io/collector/internal/testdata"
 	"go.opentelemetry.io/collector/pdata/plog"
@@ -772,3 +774,46 @@ func TestSendLogData(t *testing.T) {
 	err = exp.ConsumeLogs(context.Background(), ld)
 	assert.Error(t, err)
 }
+
+func TestConsumeContract(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		dataType component.DataType
+		queue    bool
+		retry    bool
+	}{
+		{name: "traces", dataType: component.DataTypeTraces, queue: true, retry: true},
+		{name: "metrics", dataType: component.DataTypeMetrics, queue: true, retry: true},
+		{name: "logs", dataType: component.DataTypeLogs, queue: true, retry: true},
+		{name: "traces_without_queue", dataType: component.DataTypeTraces, queue: false, retry: true},
+		{name: "traces_without_retry", dataType: component.DataTypeTraces, queue: true, retry: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			exportertest.CheckConsumeContract(exportertest.CheckConsumeContractParams{
+				T:        t,
+				Factory:  NewFactory(),
+				DataType: tt.dataType,
+				Protocol: exportertest.ProtocolGRPC,
+				CreateConfig: func(endpoint string) component.Config {
+					cfg := NewFactory().CreateDefaultConfig().(*Config)
+					cfg.QueueSettings.Enabled = tt.queue
+					cfg.RetrySettings = exporterhelper.RetrySettings{
+						Enabled:         tt.retry,
+						InitialInterval: 10 * time.Millisecond,
+						MaxInterval:     100 * time.Millisecond,
+						Multiplier:      1.5,
+						MaxElapsedTime:  time.Minute,
+					}
+					cfg.GRPCClientSettings = configgrpc.GRPCClientSettings{
+						Endpoint: endpoint,
+						TLSSetting: configtls.TLSClientSetting{
+							Insecure: true,
+						},
+					}
+					return cfg
+				},
+				NumberOfTestElements: 100,
+			})
+		})
+	}
+}
@@ -893,3 +893,41 @@ type badReader struct{}
 func (b badReader) Read([]byte) (int, error) {
 	return 0, errors.New("Bad read")
 }
+
+func TestConsumeContract(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		dataType component.DataType
+		queue    bool
+		retry    bool
+	}{
+		{name: "traces", dataType: component.DataTypeTraces, queue: true, retry: true},
+		{name: "metrics", dataType: component.DataTypeMetrics, queue: true, retry: true},
+		{name: "logs", dataType: component.DataTypeLogs, queue: true, retry: true},
+		{name: "traces_without_queue", dataType: component.DataTypeTraces, queue: false, retry: true},
+		{name: "traces_without_retry", dataType: component.DataTypeTraces, queue: true, retry: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			exportertest.CheckConsumeContract(exportertest.CheckConsumeContractParams{
+				T:        t,
+				Factory:  NewFactory(),
+				DataType: tt.dataType,
+				Protocol: exportertest.ProtocolHTTP,
+				CreateConfig: func(endpoint string) component.Config {
+					cfg := NewFactory().CreateDefaultConfig().(*Config)
+					cfg.Endpoint = endpoint
+					cfg.QueueSettings.Enabled = tt.queue
+					cfg.RetrySettings = exporterhelper.RetrySettings{
+						Enabled:         tt.retry,
+						InitialInterval: 10 * time.Millisecond,
+						MaxInterval:     100 * time.Millisecond,
+						Multiplier:      1.5,
+						MaxElapsedTime:  time.Minute,
+					}
+					return cfg
+				},
+				NumberOfTestElements: 100,
+			})
+		})
+	}
+}
@@ -3,7 +3,10 @@
 
 package featuregate // import "go.opentelemetry.io/collector/featuregate"
 
-import "sync/atomic"
+import (
+	"fmt"
+	"sync/atomic"
+)
 
 // Gate is an immutable object that is owned by the Registry and represents an individual feature that
 // may be enabled or disabled based on the lifecycle state of the feature and CLI flags specified by the user.
@@ -51,3 +54,36 @@ func (g *Gate) FromVersion() string {
 func (g *Gate) ToVersion() string {
 	return g.toVersion
 }
+
+// validateValue returns an error if the Gate can't be set to the given value in its stage.
+func (g *Gate) validateValue(enabled bool) error {
+	switch {
+	case g.stage == StageStable && !enabled:
+		return fmt.Errorf("feature gate %q is stable, can not be disabled", g.id)
+	case g.stage == StageDeprecated && enabled:
+		return fmt.Errorf("feature gate %q is deprecated, can not be enabled", g.id)
+	}
+	return nil
+}
+
+// IsExpired returns true if the Gate should no longer be used in the given Collector version, that is
+// if the version is after the Gate's ToVersion. The stable and deprecated gates are also expired in
+// their ToVersion, which is the release removing them. It returns false if the Gate has no ToVersion,
+// or if one of the versions is not a valid semantic version.
+func (g *Gate) IsExpired(collectorVersion string) bool {
+	if g.toVersion == "" {
+		return false
+	}
+	to, ok := parseVersion(g.toVersion)
+	if !ok {
+		return false
+	}
+	current, ok := parseVersion(collectorVersion)
+	if !ok {
+		return false
+	}
+	if g.stage == StageStable || g.stage == StageDeprecated {
+		return current.compare(to) >= 0
+	}
+	return current.compare(to) > 0
+}
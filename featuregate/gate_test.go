@@ -31,3 +31,28 @@ func TestGate(t *testing.T) {
 	assert.Equal(t, "v0.61.0", g.FromVersion())
 	assert.Equal(t, "v0.64.0", g.ToVersion())
 }
+
+func TestGateIsExpired(t *testing.T) {
+	tests := []struct {
+		name      string
+		stage     Stage
+		toVersion string
+		version   string
+		expired   bool
+	}{
+		{name: "no to version", stage: StageAlpha, version: "v1.0.0"},
+		{name: "invalid version", stage: StageAlpha, toVersion: "v0.64.0", version: "latest"},
+		{name: "alpha before", stage: StageAlpha, toVersion: "v0.64.0", version: "v0.63.0"},
+		{name: "beta last version", stage: StageBeta, toVersion: "v0.64.0", version: "0.64.0"},
+		{name: "alpha after", stage: StageAlpha, toVersion: "v0.64.0", version: "0.65.0", expired: true},
+		{name: "stable before", stage: StageStable, toVersion: "v0.64.0", version: "v0.64.0-dev"},
+		{name: "stable removal version", stage: StageStable, toVersion: "v0.64.0", version: "v0.64.0", expired: true},
+		{name: "deprecated after", stage: StageDeprecated, toVersion: "v0.64.0", version: "v1.0.0", expired: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &Gate{id: "test", stage: tt.stage, toVersion: tt.toVersion, enabled: &atomic.Bool{}}
+			assert.Equal(t, tt.expired, g.IsExpired(tt.version))
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"sort"
 	"sync"
 	"sync/atomic"
+
+	"go.uber.org/multierr"
 )
 
 var globalRegistry = NewRegistry()
@@ -112,17 +114,14 @@ func (r *Registry) Set(id string, enabled bool) error {
 		return fmt.Errorf("no such feature gate %q", id)
 	}
 	g := v.(*Gate)
+	if err := g.validateValue(enabled); err != nil {
+		return err
+	}
 
 	switch g.stage {
 	case StageStable:
-		if !enabled {
-			return fmt.Errorf("feature gate %q is stable, can not be disabled", id)
-		}
 		fmt.Printf("Feature gate %q is stable and already enabled. It will be removed in version %v and continued use of the gate after version %v will result in an error.\n", id, g.toVersion, g.toVersion)
 	case StageDeprecated:
-		if enabled {
-			return fmt.Errorf("feature gate %q is deprecated, can not be enabled", id)
-		}
 		fmt.Printf("Feature gate %q is deprecated and already disabled. It will be removed in version %v and continued use of the gate after version %v will result in an error.\n", id, g.toVersion, g.toVersion)
 	default:
 		g.enabled.Store(enabled)
@@ -130,6 +129,47 @@ func (r *Registry) Set(id string, enabled bool) error {
 	return nil
 }
 
+// DefaultView returns a View of the Registry which doesn't override any gate.
+func (r *Registry) DefaultView() *View {
+	return &View{registry: r}
+}
+
+// NewView returns a View of the Registry in which the given gates, identified by id, are overridden.
+// As with Set, the stable gates can not be disabled and the deprecated gates can not be enabled.
+func (r *Registry) NewView(overrides map[string]bool) (*View, error) {
+	ids := make([]string, 0, len(overrides))
+	for id := range overrides {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	v := &View{registry: r, overrides: make(map[string]bool, len(overrides))}
+	var errs error
+	for _, id := range ids {
+		enabled := overrides[id]
+		g, ok := r.gates.Load(id)
+		if !ok {
+			errs = multierr.Append(errs, fmt.Errorf("no such feature gate %q", id))
+			continue
+		}
+		if err := g.(*Gate).validateValue(enabled); err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		v.overrides[id] = enabled
+	}
+	if errs != nil {
+		return nil, errs
+	}
+	return v, nil
+}
+
+// isEnabled returns true if the Gate identified by the given id is registered and enabled.
+func (r *Registry) isEnabled(id string) bool {
+	g, ok := r.gates.Load(id)
+	return ok && g.(*Gate).IsEnabled()
+}
+
 // VisitAll visits all the gates in lexicographical order, calling fn for each.
 func (r *Registry) VisitAll(fn func(*Gate)) {
 	var gates []*Gate
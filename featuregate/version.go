@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package featuregate // import "go.opentelemetry.io/collector/featuregate"
+
+import (
+	"strconv"
+	"strings"
+)
+
+// semVersion is a semantic version, "[v]MAJOR.MINOR.PATCH[-PRERELEASE][+BUILD]".
+type semVersion struct {
+	core       [3]uint64
+	prerelease []string
+}
+
+// parseVersion parses a semantic version, with or without the "v" prefix.
+// It returns false if the version is not valid.
+func parseVersion(v string) (semVersion, bool) {
+	v = strings.TrimPrefix(v, "v")
+	// The build metadata is ignored when comparing versions.
+	v, _, _ = strings.Cut(v, "+")
+	v, prerelease, hasPrerelease := strings.Cut(v, "-")
+
+	var sv semVersion
+	parts := strings.Split(v, ".")
+	if len(parts) != len(sv.core) {
+		return semVersion{}, false
+	}
+	for i, part := range parts {
+		if !isNumeric(part) {
+			return semVersion{}, false
+		}
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return semVersion{}, false
+		}
+		sv.core[i] = n
+	}
+	if hasPrerelease {
+		sv.prerelease = strings.Split(prerelease, ".")
+		for _, id := range sv.prerelease {
+			if id == "" {
+				return semVersion{}, false
+			}
+		}
+	}
+	return sv, true
+}
+
+// compare returns -1, 0 or 1 if v is lower than, equal to or greater than other,
+// following the precedence rules of semantic versioning.
+func (v semVersion) compare(other semVersion) int {
+	for i := range v.core {
+		if c := compareUint(v.core[i], other.core[i]); c != 0 {
+			return c
+		}
+	}
+	// A pre-release version is lower than the release.
+	switch {
+	case len(v.prerelease) == 0 && len(other.prerelease) == 0:
+		return 0
+	case len(v.prerelease) == 0:
+		return 1
+	case len(other.prerelease) == 0:
+		return -1
+	}
+	for i := 0; i < len(v.prerelease) && i < len(other.prerelease); i++ {
+		if c := comparePrerelease(v.prerelease[i], other.prerelease[i]); c != 0 {
+			return c
+		}
+	}
+	return compareUint(uint64(len(v.prerelease)), uint64(len(other.prerelease)))
+}
+
+// comparePrerelease compares two identifiers of pre-release versions: numeric identifiers are
+// compared numerically, and are lower than alphanumeric identifiers, compared in ASCII order.
+func comparePrerelease(a, b string) int {
+	aNumeric, bNumeric := isNumeric(a), isNumeric(b)
+	switch {
+	case aNumeric && bNumeric:
+		if c := compareUint(uint64(len(a)), uint64(len(b))); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	case aNumeric:
+		return -1
+	case bNumeric:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
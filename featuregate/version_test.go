@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package featuregate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVersionInvalid(t *testing.T) {
+	for _, v := range []string{"", "latest", "v1", "1.2", "1.2.3.4", "v1.x.3", "1.2.-3", "1.2.3-", "1.2.3-rc..1"} {
+		_, ok := parseVersion(v)
+		assert.False(t, ok, v)
+	}
+}
+
+func TestVersionCompare(t *testing.T) {
+	// Each version is lower than the following ones.
+	versions := []string{
+		"0.9.9",
+		"v0.64.0-dev",
+		"0.64.0-rc.1",
+		"0.64.0-rc.2",
+		"0.64.0-rc.10",
+		"0.64.0-rc.10.1",
+		"0.64.0-rc.beta",
+		"v0.64.0",
+		"0.64.1",
+		"0.65.0",
+		"1.0.0",
+	}
+	for i, a := range versions {
+		va, ok := parseVersion(a)
+		require.True(t, ok, a)
+		for j, b := range versions {
+			vb, ok := parseVersion(b)
+			require.True(t, ok, b)
+			want := 0
+			switch {
+			case i < j:
+				want = -1
+			case i > j:
+				want = 1
+			}
+			assert.Equal(t, want, va.compare(vb), "%s <=> %s", a, b)
+		}
+	}
+	v1, _ := parseVersion("v1.2.3+build.1")
+	v2, _ := parseVersion("1.2.3")
+	assert.Equal(t, 0, v1.compare(v2))
+}
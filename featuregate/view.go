@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package featuregate // import "go.opentelemetry.io/collector/featuregate"
+
+import (
+	"sort"
+	"sync"
+)
+
+// View resolves the state of the feature gates of a Registry, with some gates overridden. It allows
+// enabling or disabling a feature for a single component, instead of the whole process.
+type View struct {
+	registry  *Registry
+	overrides map[string]bool
+
+	// resolved are the overridden gates which were resolved with IsEnabled.
+	resolved sync.Map
+}
+
+// IsEnabled returns true if the feature gate with the given ID is enabled in the View: the overridden
+// value of the gate if any, its value in the Registry otherwise. It returns false if the gate is not
+// registered.
+func (v *View) IsEnabled(id string) bool {
+	if enabled, ok := v.overrides[id]; ok {
+		v.resolved.Store(id, struct{}{})
+		return enabled
+	}
+	return v.registry.isEnabled(id)
+}
+
+// UnresolvedOverrides returns the IDs of the overridden gates which were never resolved with IsEnabled,
+// in lexicographical order. Overriding these gates had no effect.
+func (v *View) UnresolvedOverrides() []string {
+	var ids []string
+	for id := range v.overrides {
+		if _, ok := v.resolved.Load(id); !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
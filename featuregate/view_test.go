@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package featuregate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestView(t *testing.T) {
+	r := NewRegistry()
+	alpha := r.MustRegister("alpha", StageAlpha)
+	beta := r.MustRegister("beta", StageBeta)
+	r.MustRegister("other", StageAlpha)
+	r.MustRegister("stable", StageStable, WithRegisterToVersion("v0.90.0"))
+
+	v, err := r.NewView(map[string]bool{"alpha": true, "beta": false, "stable": true})
+	require.NoError(t, err)
+	assert.True(t, v.IsEnabled("alpha"))
+	assert.False(t, v.IsEnabled("beta"))
+	assert.True(t, v.IsEnabled("stable"))
+	assert.False(t, v.IsEnabled("other"))
+	assert.False(t, v.IsEnabled("unknown"))
+
+	// The gates which are not overridden follow the registry.
+	require.NoError(t, r.Set("other", true))
+	assert.True(t, v.IsEnabled("other"))
+
+	// The registry is not modified by the view.
+	assert.False(t, alpha.IsEnabled())
+	assert.True(t, beta.IsEnabled())
+}
+
+func TestDefaultView(t *testing.T) {
+	r := NewRegistry()
+	alpha := r.MustRegister("alpha", StageAlpha)
+	r.MustRegister("beta", StageBeta)
+
+	v := r.DefaultView()
+	assert.False(t, v.IsEnabled("alpha"))
+	assert.True(t, v.IsEnabled("beta"))
+	require.NoError(t, r.Set(alpha.ID(), true))
+	assert.True(t, v.IsEnabled("alpha"))
+	assert.Empty(t, v.UnresolvedOverrides())
+}
+
+func TestViewUnresolvedOverrides(t *testing.T) {
+	r := NewRegistry()
+	r.MustRegister("alpha", StageAlpha)
+	r.MustRegister("beta", StageBeta)
+	r.MustRegister("other", StageAlpha)
+
+	v, err := r.NewView(map[string]bool{"alpha": true, "beta": false})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alpha", "beta"}, v.UnresolvedOverrides())
+
+	// Resolving a gate which is not overridden doesn't matter.
+	v.IsEnabled("other")
+	v.IsEnabled("beta")
+	assert.Equal(t, []string{"alpha"}, v.UnresolvedOverrides())
+	v.IsEnabled("alpha")
+	assert.Empty(t, v.UnresolvedOverrides())
+}
+
+func TestNewViewErrors(t *testing.T) {
+	r := NewRegistry()
+	r.MustRegister("stable", StageStable, WithRegisterToVersion("v0.90.0"))
+	r.MustRegister("deprecated", StageDeprecated, WithRegisterToVersion("v0.90.0"))
+
+	_, err := r.NewView(map[string]bool{"unknown": true, "stable": false, "deprecated": true})
+	assert.EqualError(t, err, `feature gate "deprecated" is deprecated, can not be enabled; `+
+		`feature gate "stable" is stable, can not be disabled; no such feature gate "unknown"`)
+}
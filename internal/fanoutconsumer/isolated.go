@@ -0,0 +1,382 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fanoutconsumer // import "go.opentelemetry.io/collector/internal/fanoutconsumer"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var errIsolatedShutdown = errors.New("fanout consumer is shut down")
+
+const (
+	// failedRequestsMetric counts the requests which failed for a consumer of an isolated fanout.
+	failedRequestsMetric = "fanout_failed_requests"
+
+	reasonQueueFull     = "queue_full"
+	reasonConsumerError = "consumer_error"
+)
+
+// IsolatedSettings configures the consumers created by NewIsolatedTraces,
+// NewIsolatedMetrics and NewIsolatedLogs.
+type IsolatedSettings struct {
+	// Logger is used to report the failures of each consumer.
+	Logger *zap.Logger
+
+	// MeterProvider is used to count the failures of each consumer. If nil,
+	// the failures are only logged.
+	MeterProvider metric.MeterProvider
+
+	// Name identifies the fanout consumer, e.g. by its pipeline, in the metrics.
+	Name string
+
+	// QueueSize is the number of requests buffered for each consumer. It must be positive.
+	QueueSize int
+
+	// PropagateErrors is the set of consumers, by name, whose errors are returned
+	// to the caller. These consumers are not queued: the data is sent to them
+	// concurrently, and the request waits for them to return. When one of them
+	// fails, its error is returned and the request is queued for none of the other
+	// consumers, so that the caller can retry it without duplicating the data sent
+	// to them. For the other consumers, the errors are only logged and counted.
+	PropagateErrors map[string]bool
+}
+
+// IsolatedTraces is a consumer.Traces that sends data to each wrapped consumer
+// through a dedicated bounded queue. It must be started before consuming data,
+// and shut down to drain the queues.
+type IsolatedTraces interface {
+	component.Component
+	consumer.Traces
+}
+
+// NewIsolatedTraces wraps multiple trace consumers, identified by name, in a single one.
+// Each consumer gets its own queue and goroutine, so that a slow or blocked
+// consumer does not delay the others. When the queue of a consumer is full,
+// the data is dropped for that consumer only.
+func NewIsolatedTraces(set IsolatedSettings, tcs map[string]consumer.Traces) IsolatedTraces {
+	f := &isolatedTraces{}
+	f.isolatedFanout = newIsolatedFanout(set, func(td ptrace.Traces) ptrace.Traces {
+		clone := ptrace.NewTraces()
+		td.CopyTo(clone)
+		return clone
+	})
+	for name, tc := range tcs {
+		f.addBranch(name, tc.Capabilities().MutatesData, tc.ConsumeTraces)
+	}
+	f.sortBranches()
+	return f
+}
+
+type isolatedTraces struct {
+	*isolatedFanout[ptrace.Traces]
+}
+
+func (f *isolatedTraces) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+// ConsumeTraces queues the ptrace.Traces for all consumers wrapped by the current one.
+func (f *isolatedTraces) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	return f.consume(ctx, td)
+}
+
+// IsolatedMetrics is a consumer.Metrics that sends data to each wrapped consumer
+// through a dedicated bounded queue. It must be started before consuming data,
+// and shut down to drain the queues.
+type IsolatedMetrics interface {
+	component.Component
+	consumer.Metrics
+}
+
+// NewIsolatedMetrics wraps multiple metrics consumers, identified by name, in a single one.
+// Each consumer gets its own queue and goroutine, so that a slow or blocked
+// consumer does not delay the others. When the queue of a consumer is full,
+// the data is dropped for that consumer only.
+func NewIsolatedMetrics(set IsolatedSettings, mcs map[string]consumer.Metrics) IsolatedMetrics {
+	f := &isolatedMetrics{}
+	f.isolatedFanout = newIsolatedFanout(set, func(md pmetric.Metrics) pmetric.Metrics {
+		clone := pmetric.NewMetrics()
+		md.CopyTo(clone)
+		return clone
+	})
+	for name, mc := range mcs {
+		f.addBranch(name, mc.Capabilities().MutatesData, mc.ConsumeMetrics)
+	}
+	f.sortBranches()
+	return f
+}
+
+type isolatedMetrics struct {
+	*isolatedFanout[pmetric.Metrics]
+}
+
+func (f *isolatedMetrics) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+// ConsumeMetrics queues the pmetric.Metrics for all consumers wrapped by the current one.
+func (f *isolatedMetrics) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	return f.consume(ctx, md)
+}
+
+// IsolatedLogs is a consumer.Logs that sends data to each wrapped consumer
+// through a dedicated bounded queue. It must be started before consuming data,
+// and shut down to drain the queues.
+type IsolatedLogs interface {
+	component.Component
+	consumer.Logs
+}
+
+// NewIsolatedLogs wraps multiple log consumers, identified by name, in a single one.
+// Each consumer gets its own queue and goroutine, so that a slow or blocked
+// consumer does not delay the others. When the queue of a consumer is full,
+// the data is dropped for that consumer only.
+func NewIsolatedLogs(set IsolatedSettings, lcs map[string]consumer.Logs) IsolatedLogs {
+	f := &isolatedLogs{}
+	f.isolatedFanout = newIsolatedFanout(set, func(ld plog.Logs) plog.Logs {
+		clone := plog.NewLogs()
+		ld.CopyTo(clone)
+		return clone
+	})
+	for name, lc := range lcs {
+		f.addBranch(name, lc.Capabilities().MutatesData, lc.ConsumeLogs)
+	}
+	f.sortBranches()
+	return f
+}
+
+type isolatedLogs struct {
+	*isolatedFanout[plog.Logs]
+}
+
+func (f *isolatedLogs) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+// ConsumeLogs queues the plog.Logs for all consumers wrapped by the current one.
+func (f *isolatedLogs) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	return f.consume(ctx, ld)
+}
+
+// branch is a consumer wrapped by an isolatedFanout, along with its queue. The
+// consumers propagating their errors are called synchronously, and have no queue.
+type branch[T any] struct {
+	name      string
+	clone     bool
+	propagate bool
+	consume   func(context.Context, T) error
+	queue     chan request[T]
+
+	// failures are the attributes of the failures of the consumer, by reason.
+	failures map[string]metric.MeasurementOption
+}
+
+// request is the data queued for a consumer, along with the context it is sent with.
+type request[T any] struct {
+	ctx  context.Context
+	data T
+}
+
+// isolatedFanout implements the signal independent part of the isolated fanout consumers.
+type isolatedFanout[T any] struct {
+	set            IsolatedSettings
+	clone          func(T) T
+	branches       []*branch[T]
+	failedRequests metric.Int64Counter
+	wg             sync.WaitGroup
+
+	// consuming tracks the requests being consumed, so that the queues are only
+	// closed once no request can be sent to them anymore.
+	consuming sync.WaitGroup
+	closeOnce sync.Once
+
+	// mu guards stopped, so that no request is tracked once shutting down.
+	mu      sync.Mutex
+	stopped bool
+}
+
+func newIsolatedFanout[T any](set IsolatedSettings, clone func(T) T) *isolatedFanout[T] {
+	if set.MeterProvider == nil {
+		set.MeterProvider = noop.NewMeterProvider()
+	}
+	failedRequests, err := set.MeterProvider.Meter("go.opentelemetry.io/collector/internal/fanoutconsumer").Int64Counter(
+		failedRequestsMetric,
+		metric.WithDescription("Number of requests which failed for a consumer of an isolated fanout."),
+		metric.WithUnit("1"))
+	if err != nil {
+		set.Logger.Warn("Failed to create the fanout metrics", zap.Error(err))
+		failedRequests, _ = noop.NewMeterProvider().Meter("").Int64Counter(failedRequestsMetric)
+	}
+	return &isolatedFanout[T]{set: set, clone: clone, failedRequests: failedRequests}
+}
+
+func (f *isolatedFanout[T]) addBranch(name string, mutatesData bool, consume func(context.Context, T) error) {
+	failures := make(map[string]metric.MeasurementOption, 2)
+	for _, reason := range []string{reasonQueueFull, reasonConsumerError} {
+		failures[reason] = metric.WithAttributes(
+			attribute.String("fanout", f.set.Name),
+			attribute.String("consumer", name),
+			attribute.String("reason", reason))
+	}
+	b := &branch[T]{
+		name:      name,
+		clone:     mutatesData,
+		propagate: f.set.PropagateErrors[name],
+		consume:   consume,
+		failures:  failures,
+	}
+	if !b.propagate {
+		b.queue = make(chan request[T], f.set.QueueSize)
+	}
+	f.branches = append(f.branches, b)
+}
+
+// sortBranches orders the branches so that the data is cloned for the mutating
+// consumers before the original data is queued for the others.
+func (f *isolatedFanout[T]) sortBranches() {
+	sort.Slice(f.branches, func(i, j int) bool {
+		if f.branches[i].clone != f.branches[j].clone {
+			return f.branches[i].clone
+		}
+		return f.branches[i].name < f.branches[j].name
+	})
+	// As for the synchronous fanout, give the original data to the last
+	// consumer if all consumers need to mutate the data.
+	if len(f.branches) > 0 && f.branches[len(f.branches)-1].clone {
+		f.branches[len(f.branches)-1].clone = false
+	}
+}
+
+// Start starts one goroutine per queued consumer to send the queued data.
+func (f *isolatedFanout[T]) Start(context.Context, component.Host) error {
+	for _, b := range f.branches {
+		if b.queue == nil {
+			continue
+		}
+		f.wg.Add(1)
+		go f.run(b)
+	}
+	return nil
+}
+
+func (f *isolatedFanout[T]) run(b *branch[T]) {
+	defer f.wg.Done()
+	for req := range b.queue {
+		if err := b.consume(req.ctx, req.data); err != nil {
+			f.failedRequests.Add(req.ctx, 1, b.failures[reasonConsumerError])
+			f.set.Logger.Error("Consumer failed to process data", zap.String("consumer", b.name), zap.Error(err))
+		}
+	}
+}
+
+// Shutdown stops accepting data, and waits for the requests being consumed and
+// the queued data to be sent.
+func (f *isolatedFanout[T]) Shutdown(ctx context.Context) error {
+	f.mu.Lock()
+	f.stopped = true
+	f.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		f.consuming.Wait()
+		f.closeOnce.Do(func() {
+			for _, b := range f.branches {
+				if b.queue != nil {
+					close(b.queue)
+				}
+			}
+		})
+		f.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (f *isolatedFanout[T]) consume(ctx context.Context, data T) error {
+	f.mu.Lock()
+	if f.stopped {
+		f.mu.Unlock()
+		return errIsolatedShutdown
+	}
+	f.consuming.Add(1)
+	f.mu.Unlock()
+	defer f.consuming.Done()
+
+	// Clone the data before any consumer gets it, since the consumers propagating
+	// their errors may mutate it before it is queued for the others.
+	datas := make([]T, len(f.branches))
+	for i, b := range f.branches {
+		datas[i] = data
+		if b.clone {
+			datas[i] = f.clone(data)
+		}
+	}
+
+	// Refuse the request before queueing it for any consumer, so that retrying
+	// it does not duplicate the data queued for the other consumers.
+	if err := f.consumePropagating(ctx, datas); err != nil {
+		return err
+	}
+
+	// The request may be completed and its context canceled before the data is
+	// sent, so the data is sent with a context only keeping the client info,
+	// e.g. the metadata and the authentication data.
+	reqCtx := client.NewContext(context.Background(), client.FromContext(ctx))
+	for i, b := range f.branches {
+		if b.queue == nil {
+			continue
+		}
+		select {
+		case b.queue <- request[T]{ctx: reqCtx, data: datas[i]}:
+		default:
+			f.failedRequests.Add(ctx, 1, b.failures[reasonQueueFull])
+			f.set.Logger.Warn("Dropping data, the queue of the consumer is full", zap.String("consumer", b.name))
+		}
+	}
+	return nil
+}
+
+// consumePropagating sends the data concurrently to the consumers propagating
+// their errors, and returns their errors once they all returned.
+func (f *isolatedFanout[T]) consumePropagating(ctx context.Context, datas []T) error {
+	errs := make([]error, len(f.branches))
+	var wg sync.WaitGroup
+	for i, b := range f.branches {
+		if !b.propagate {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, b *branch[T]) {
+			defer wg.Done()
+			if err := b.consume(ctx, datas[i]); err != nil {
+				f.failedRequests.Add(ctx, 1, b.failures[reasonConsumerError])
+				errs[i] = fmt.Errorf("consumer %q failed: %w", b.name, err)
+			}
+		}(i, b)
+	}
+	wg.Wait()
+	return multierr.Combine(errs...)
+}
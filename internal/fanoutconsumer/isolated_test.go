@@ -0,0 +1,286 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fanoutconsumer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/internal/testdata"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const (
+	fastName = "exporter/fast"
+	slowName = "exporter/slow"
+)
+
+func TestIsolatedTracesSlowConsumer(t *testing.T) {
+	fast := new(consumertest.TracesSink)
+	unblock := make(chan struct{})
+	slow, err := consumer.NewTraces(func(context.Context, ptrace.Traces) error {
+		<-unblock
+		return nil
+	})
+	require.NoError(t, err)
+
+	tfc := NewIsolatedTraces(IsolatedSettings{Logger: zap.NewNop(), QueueSize: 1}, map[string]consumer.Traces{
+		fastName: fast,
+		slowName: slow,
+	})
+	assert.False(t, tfc.Capabilities().MutatesData)
+	require.NoError(t, tfc.Start(context.Background(), componenttest.NewNopHost()))
+
+	// The slow consumer blocks on the first request, queues the second and drops
+	// the others, without delaying the fast consumer.
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, tfc.ConsumeTraces(context.Background(), testdata.GenerateTraces(1)))
+		assert.Eventually(t, func() bool { return len(fast.AllTraces()) == i+1 }, time.Second, time.Millisecond)
+	}
+
+	close(unblock)
+	assert.NoError(t, tfc.Shutdown(context.Background()))
+	assert.Len(t, fast.AllTraces(), 5)
+	assert.ErrorIs(t, tfc.ConsumeTraces(context.Background(), testdata.GenerateTraces(1)), errIsolatedShutdown)
+}
+
+func TestIsolatedTracesPropagateErrors(t *testing.T) {
+	fast := new(consumertest.TracesSink)
+	reader := sdkmetric.NewManualReader()
+	tfc := NewIsolatedTraces(IsolatedSettings{
+		Logger:          zap.NewNop(),
+		MeterProvider:   sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)),
+		Name:            "traces",
+		QueueSize:       10,
+		PropagateErrors: map[string]bool{slowName: true},
+	}, map[string]consumer.Traces{
+		fastName: fast,
+		slowName: consumertest.NewErr(consumererror.NewPermanent(errors.New("my error"))),
+	})
+	require.NoError(t, tfc.Start(context.Background(), componenttest.NewNopHost()))
+
+	// The error of the slow consumer is returned, and the request is not queued for
+	// the fast one, so retrying it does not duplicate it.
+	err := tfc.ConsumeTraces(context.Background(), testdata.GenerateTraces(1))
+	assert.EqualError(t, err, `consumer "exporter/slow" failed: Permanent error: my error`)
+	assert.True(t, consumererror.IsPermanent(err))
+	require.NoError(t, tfc.Shutdown(context.Background()))
+	assert.Empty(t, fast.AllTraces())
+	assert.Equal(t, map[string]int64{"exporter/slow/consumer_error": 1}, failedRequests(t, reader))
+}
+
+func TestIsolatedTracesPropagateErrorsSynchronous(t *testing.T) {
+	fast := new(consumertest.TracesSink)
+	slow := new(consumertest.TracesSink)
+	reader := sdkmetric.NewManualReader()
+	tfc := NewIsolatedTraces(IsolatedSettings{
+		Logger:          zap.NewNop(),
+		MeterProvider:   sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)),
+		QueueSize:       1,
+		PropagateErrors: map[string]bool{fastName: true},
+	}, map[string]consumer.Traces{
+		fastName: fast,
+		slowName: slow,
+	})
+
+	// Not started, the queue of the slow consumer fills up and the second request
+	// is dropped for it only. The fast consumer gets the data before the request
+	// returns.
+	assert.NoError(t, tfc.ConsumeTraces(context.Background(), testdata.GenerateTraces(1)))
+	assert.Len(t, fast.AllTraces(), 1)
+	assert.NoError(t, tfc.ConsumeTraces(context.Background(), testdata.GenerateTraces(1)))
+	assert.Len(t, fast.AllTraces(), 2)
+	require.NoError(t, tfc.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, tfc.Shutdown(context.Background()))
+	assert.Len(t, slow.AllTraces(), 1)
+	assert.Equal(t, map[string]int64{"exporter/slow/queue_full": 1}, failedRequests(t, reader))
+}
+
+func TestIsolatedTracesConsumerError(t *testing.T) {
+	sink := new(consumertest.TracesSink)
+	reader := sdkmetric.NewManualReader()
+	tfc := NewIsolatedTraces(IsolatedSettings{
+		Logger:        zap.NewNop(),
+		MeterProvider: sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)),
+		QueueSize:     10,
+	}, map[string]consumer.Traces{
+		fastName: sink,
+		slowName: consumertest.NewErr(errors.New("my error")),
+	})
+	require.NoError(t, tfc.Start(context.Background(), componenttest.NewNopHost()))
+
+	// Errors of the queued consumers happen after the data is queued, so they are
+	// not returned, only counted.
+	assert.NoError(t, tfc.ConsumeTraces(context.Background(), testdata.GenerateTraces(1)))
+	assert.NoError(t, tfc.ConsumeTraces(context.Background(), testdata.GenerateTraces(1)))
+	require.NoError(t, tfc.Shutdown(context.Background()))
+	assert.Len(t, sink.AllTraces(), 2)
+	assert.Equal(t, map[string]int64{"exporter/slow/consumer_error": 2}, failedRequests(t, reader))
+}
+
+func TestIsolatedTracesShutdownWaitsPropagating(t *testing.T) {
+	fast := new(consumertest.TracesSink)
+	started := make(chan struct{})
+	unblock := make(chan struct{})
+	slow, err := consumer.NewTraces(func(context.Context, ptrace.Traces) error {
+		close(started)
+		<-unblock
+		return nil
+	})
+	require.NoError(t, err)
+	tfc := NewIsolatedTraces(IsolatedSettings{
+		Logger:          zap.NewNop(),
+		QueueSize:       1,
+		PropagateErrors: map[string]bool{slowName: true},
+	}, map[string]consumer.Traces{
+		fastName: fast,
+		slowName: slow,
+	})
+	require.NoError(t, tfc.Start(context.Background(), componenttest.NewNopHost()))
+
+	consumed := make(chan error, 1)
+	go func() { consumed <- tfc.ConsumeTraces(context.Background(), testdata.GenerateTraces(1)) }()
+	<-started
+
+	// The request being consumed is still queued for the fast consumer once the
+	// slow one returns, before the queues are closed.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, tfc.Shutdown(ctx), context.Canceled)
+	close(unblock)
+	assert.NoError(t, <-consumed)
+	require.NoError(t, tfc.Shutdown(context.Background()))
+	assert.Len(t, fast.AllTraces(), 1)
+}
+
+func TestIsolatedTracesClientInfo(t *testing.T) {
+	infos := make(chan client.Info, 1)
+	next, err := consumer.NewTraces(func(ctx context.Context, _ ptrace.Traces) error {
+		// The context of the request is canceled, but not the one the data is sent with.
+		assert.NoError(t, ctx.Err())
+		infos <- client.FromContext(ctx)
+		return nil
+	})
+	require.NoError(t, err)
+	tfc := NewIsolatedTraces(IsolatedSettings{Logger: zap.NewNop(), QueueSize: 1}, map[string]consumer.Traces{
+		fastName: next,
+	})
+
+	info := client.Info{Metadata: client.NewMetadata(map[string][]string{"tenant": {"acme"}})}
+	ctx, cancel := context.WithCancel(client.NewContext(context.Background(), info))
+	assert.NoError(t, tfc.ConsumeTraces(ctx, testdata.GenerateTraces(1)))
+	cancel()
+	require.NoError(t, tfc.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, tfc.Shutdown(context.Background()))
+	assert.Equal(t, info, <-infos)
+}
+
+// failedRequests returns the failed requests counted by the fanout, by consumer and reason.
+func failedRequests(t *testing.T, reader sdkmetric.Reader) map[string]int64 {
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	counts := map[string]int64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			require.Equal(t, failedRequestsMetric, m.Name)
+			for _, dp := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				consumerName, _ := dp.Attributes.Value("consumer")
+				reason, _ := dp.Attributes.Value("reason")
+				counts[consumerName.AsString()+"/"+reason.AsString()] += dp.Value
+			}
+		}
+	}
+	return counts
+}
+
+func TestIsolatedTracesMutating(t *testing.T) {
+	p1 := &mutatingTracesSink{TracesSink: new(consumertest.TracesSink)}
+	p2 := new(consumertest.TracesSink)
+	tfc := NewIsolatedTraces(IsolatedSettings{Logger: zap.NewNop(), QueueSize: 10}, map[string]consumer.Traces{
+		fastName: p1,
+		slowName: p2,
+	})
+	require.NoError(t, tfc.Start(context.Background(), componenttest.NewNopHost()))
+
+	td := testdata.GenerateTraces(1)
+	assert.NoError(t, tfc.ConsumeTraces(context.Background(), td))
+	require.NoError(t, tfc.Shutdown(context.Background()))
+
+	assert.True(t, td != p1.AllTraces()[0])
+	assert.EqualValues(t, td, p1.AllTraces()[0])
+	assert.True(t, td == p2.AllTraces()[0])
+}
+
+func TestIsolatedMetrics(t *testing.T) {
+	p1 := new(consumertest.MetricsSink)
+	p2 := &mutatingMetricsSink{MetricsSink: new(consumertest.MetricsSink)}
+	mfc := NewIsolatedMetrics(IsolatedSettings{Logger: zap.NewNop(), QueueSize: 10}, map[string]consumer.Metrics{
+		fastName: p1,
+		slowName: p2,
+	})
+	assert.False(t, mfc.Capabilities().MutatesData)
+	require.NoError(t, mfc.Start(context.Background(), componenttest.NewNopHost()))
+
+	md := testdata.GenerateMetrics(1)
+	assert.NoError(t, mfc.ConsumeMetrics(context.Background(), md))
+	require.NoError(t, mfc.Shutdown(context.Background()))
+
+	assert.True(t, md == p1.AllMetrics()[0])
+	assert.True(t, md != p2.AllMetrics()[0])
+	assert.EqualValues(t, md, p2.AllMetrics()[0])
+	assert.ErrorIs(t, mfc.ConsumeMetrics(context.Background(), pmetric.NewMetrics()), errIsolatedShutdown)
+}
+
+func TestIsolatedLogs(t *testing.T) {
+	p1 := new(consumertest.LogsSink)
+	p2 := new(consumertest.LogsSink)
+	lfc := NewIsolatedLogs(IsolatedSettings{Logger: zap.NewNop(), QueueSize: 10}, map[string]consumer.Logs{
+		fastName: p1,
+		slowName: p2,
+	})
+	assert.False(t, lfc.Capabilities().MutatesData)
+	require.NoError(t, lfc.Start(context.Background(), componenttest.NewNopHost()))
+
+	ld := testdata.GenerateLogs(1)
+	assert.NoError(t, lfc.ConsumeLogs(context.Background(), ld))
+	require.NoError(t, lfc.Shutdown(context.Background()))
+
+	assert.True(t, ld == p1.AllLogs()[0])
+	assert.True(t, ld == p2.AllLogs()[0])
+	assert.ErrorIs(t, lfc.ConsumeLogs(context.Background(), plog.NewLogs()), errIsolatedShutdown)
+}
+
+func TestIsolatedShutdownTimeout(t *testing.T) {
+	unblock := make(chan struct{})
+	defer close(unblock)
+	blocked, err := consumer.NewLogs(func(context.Context, plog.Logs) error {
+		<-unblock
+		return nil
+	})
+	require.NoError(t, err)
+	lfc := NewIsolatedLogs(IsolatedSettings{Logger: zap.NewNop(), QueueSize: 1}, map[string]consumer.Logs{
+		slowName: blocked,
+	})
+	require.NoError(t, lfc.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, lfc.ConsumeLogs(context.Background(), plog.NewLogs()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, lfc.Shutdown(ctx), context.Canceled)
+}
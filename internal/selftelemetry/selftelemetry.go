@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package selftelemetry gives in-process access to the metrics of the Collector's own telemetry.
+package selftelemetry // import "go.opentelemetry.io/collector/internal/selftelemetry"
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/bridge/opencensus"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+var (
+	mu     sync.Mutex
+	reader sdkmetric.Reader
+)
+
+// NewReader returns a reader to register with the meter provider of the Collector's own telemetry.
+// It also reads the metrics still recorded with OpenCensus.
+func NewReader() sdkmetric.Reader {
+	r := sdkmetric.NewManualReader()
+	r.RegisterProducer(opencensus.NewMetricProducer())
+	return r
+}
+
+// SetReader sets the reader returned by NewReader once it is registered with the meter provider,
+// or nil when the meter provider is shut down.
+func SetReader(r sdkmetric.Reader) {
+	mu.Lock()
+	defer mu.Unlock()
+	reader = r
+}
+
+// Collect returns the metrics of the Collector's own telemetry. Without a reader set, that is
+// when the Collector doesn't use OpenTelemetry for its metrics, only the OpenCensus metrics are
+// returned.
+func Collect(ctx context.Context) ([]metricdata.ScopeMetrics, error) {
+	mu.Lock()
+	r := reader
+	mu.Unlock()
+
+	if r == nil {
+		return opencensus.NewMetricProducer().Produce(ctx)
+	}
+	var rm metricdata.ResourceMetrics
+	err := r.Collect(ctx, &rm)
+	return rm.ScopeMetrics, err
+}
@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package selftelemetry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ocmetric "go.opencensus.io/metric"
+	"go.opencensus.io/metric/metricproducer"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func metricNames(scopeMetrics []metricdata.ScopeMetrics) []string {
+	var names []string
+	for _, sm := range scopeMetrics {
+		for _, m := range sm.Metrics {
+			names = append(names, m.Name)
+		}
+	}
+	return names
+}
+
+func TestCollect(t *testing.T) {
+	ocRegistry := ocmetric.NewRegistry()
+	gauge, err := ocRegistry.AddInt64Gauge("oc_gauge")
+	require.NoError(t, err)
+	entry, err := gauge.GetEntry()
+	require.NoError(t, err)
+	entry.Set(3)
+	metricproducer.GlobalManager().AddProducer(ocRegistry)
+	defer metricproducer.GlobalManager().DeleteProducer(ocRegistry)
+
+	scopeMetrics, err := Collect(context.Background())
+	require.NoError(t, err)
+	assert.Contains(t, metricNames(scopeMetrics), "oc_gauge")
+
+	reader := NewReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	defer func() { assert.NoError(t, mp.Shutdown(context.Background())) }()
+	counter, err := mp.Meter("test").Int64Counter("otel_counter")
+	require.NoError(t, err)
+	counter.Add(context.Background(), 1)
+
+	SetReader(reader)
+	defer SetReader(nil)
+	scopeMetrics, err = Collect(context.Background())
+	require.NoError(t, err)
+	assert.Contains(t, metricNames(scopeMetrics), "otel_counter")
+	assert.Contains(t, metricNames(scopeMetrics), "oc_gauge")
+}
@@ -70,11 +70,20 @@ type CollectorSettings struct {
 	// If the provider watches for configuration change, collector may reload the new configuration upon changes.
 	ConfigProvider ConfigProvider
 
+	// ConfigProviderSettings are used by NewCommand to create the ConfigProvider
+	// when ConfigProvider is not set. The URIs are taken from the command line flags,
+	// and the default providers and converters are used when none are set.
+	ConfigProviderSettings ConfigProviderSettings
+
 	// LoggingOptions provides a way to change behavior of zap logging.
 	LoggingOptions []zap.Option
 
 	// SkipSettingGRPCLogger avoids setting the grpc logger
 	SkipSettingGRPCLogger bool
+
+	// StrictValidation turns the warnings of the configuration into errors, see Config.Warnings.
+	// The warnings are logged otherwise.
+	StrictValidation bool
 }
 
 // (Internal note) Collector Lifecycle:
@@ -161,7 +170,11 @@ func (col *Collector) setupConfigurationComponents(ctx context.Context) error {
 	}
 
 	if err = cfg.Validate(); err != nil {
-		return fmt.Errorf("invalid configuration: %w", err)
+		return fmt.Errorf("invalid configuration: %w", withOrigins(conf, err))
+	}
+	warnings := cfg.Warnings()
+	if col.set.StrictValidation && len(warnings) > 0 {
+		return fmt.Errorf("invalid configuration: %w", warningsError(conf, warnings))
 	}
 
 	col.service, err = service.New(ctx, service.Settings{
@@ -174,11 +187,17 @@ func (col *Collector) setupConfigurationComponents(ctx context.Context) error {
 		Extensions:        extension.NewBuilder(cfg.Extensions, col.set.Factories.Extensions),
 		AsyncErrorChannel: col.asyncErrorChannel,
 		LoggingOptions:    col.set.LoggingOptions,
+
+		ComponentFeatureGates: cfg.ComponentFeatureGates,
 	}, cfg.Service)
 	if err != nil {
 		return err
 	}
 
+	for _, warning := range warnings {
+		col.service.Logger().Warn("Configuration warning", zap.Error(withOrigins(conf, warning)))
+	}
+
 	if !col.set.SkipSettingGRPCLogger {
 		grpclog.SetLogger(col.service.Logger(), cfg.Service.Telemetry.Logs.Level)
 	}
@@ -207,12 +226,48 @@ func (col *Collector) reloadConfiguration(ctx context.Context) error {
 }
 
 func (col *Collector) DryRun(ctx context.Context) error {
+	_, err := col.dryRun(ctx)
+	return err
+}
+
+// dryRun validates the configuration, and returns its warnings. The warnings are
+// returned as an error with StrictValidation.
+func (col *Collector) dryRun(ctx context.Context) ([]error, error) {
 	cfg, err := col.set.ConfigProvider.Get(ctx, col.set.Factories)
 	if err != nil {
-		return fmt.Errorf("failed to get config: %w", err)
+		return nil, fmt.Errorf("failed to get config: %w", err)
 	}
 
-	return cfg.Validate()
+	err = cfg.Validate()
+	warnings := cfg.Warnings()
+	if err == nil && len(warnings) == 0 {
+		return nil, nil
+	}
+
+	// Add where the invalid values come from, if the config provider knows it.
+	var conf *confmap.Conf
+	if cp, ok := col.set.ConfigProvider.(ConfmapProvider); ok {
+		conf, _ = cp.GetConfmap(ctx)
+	}
+	if err != nil {
+		return nil, withOrigins(conf, err)
+	}
+	if col.set.StrictValidation {
+		return nil, warningsError(conf, warnings)
+	}
+	for i, warning := range warnings {
+		warnings[i] = withOrigins(conf, warning)
+	}
+	return warnings, nil
+}
+
+// warningsError returns the warnings of the configuration as an error.
+func warningsError(conf *confmap.Conf, warnings []error) error {
+	var errs error
+	for _, warning := range warnings {
+		errs = multierr.Append(errs, withOrigins(conf, warning))
+	}
+	return errs
 }
 
 // Run starts the collector according to the given configuration, and waits for it to complete.
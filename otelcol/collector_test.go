@@ -231,6 +231,23 @@ func TestCollectorStartInvalidConfig(t *testing.T) {
 	assert.Error(t, col.Run(context.Background()))
 }
 
+func TestCollectorStartStrictValidation(t *testing.T) {
+	factories, err := nopFactories()
+	require.NoError(t, err)
+
+	cfgProvider, err := NewConfigProvider(newDefaultConfigProviderSettings([]string{filepath.Join("testdata", "otelcol-unused.yaml")}))
+	require.NoError(t, err)
+
+	col, err := NewCollector(CollectorSettings{
+		BuildInfo:        component.NewDefaultBuildInfo(),
+		Factories:        factories,
+		ConfigProvider:   cfgProvider,
+		StrictValidation: true,
+	})
+	require.NoError(t, err)
+	assert.ErrorContains(t, col.Run(context.Background()), "receivers::nop/unused: not used by any pipeline")
+}
+
 func TestCollectorStartWithTraceContextPropagation(t *testing.T) {
 	tests := []struct {
 		file        string
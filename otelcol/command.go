@@ -29,22 +29,30 @@ func NewCommand(set CollectorSettings) *cobra.Command {
 	}
 	rootCmd.AddCommand(newComponentsCommand(set))
 	rootCmd.AddCommand(newValidateSubCommand(set, flagSet))
+	rootCmd.AddCommand(newPrintConfigSubCommand(set, flagSet))
+	rootCmd.AddCommand(newGraphSubCommand(set, flagSet))
 	rootCmd.Flags().AddGoFlagSet(flagSet)
 	return rootCmd
 }
 
 func newCollectorWithFlags(set CollectorSettings, flags *flag.FlagSet) (*Collector, error) {
-	if set.ConfigProvider == nil {
-		configFlags := getConfigFlag(flags)
-		if len(configFlags) == 0 {
-			return nil, errors.New("at least one config flag must be provided")
-		}
-
-		var err error
-		set.ConfigProvider, err = NewConfigProvider(newDefaultConfigProviderSettings(configFlags))
-		if err != nil {
-			return nil, err
-		}
+	var err error
+	if set.ConfigProvider, err = configProviderWithFlags(set, flags); err != nil {
+		return nil, err
 	}
+	set.StrictValidation = set.StrictValidation || getStrictFlag(flags)
 	return NewCollector(set)
 }
+
+// configProviderWithFlags returns the ConfigProvider of the settings, or creates
+// one resolving the locations given by the command line flags if it is not set.
+func configProviderWithFlags(set CollectorSettings, flags *flag.FlagSet) (ConfigProvider, error) {
+	if set.ConfigProvider != nil {
+		return set.ConfigProvider, nil
+	}
+	configFlags := getConfigFlag(flags)
+	if len(configFlags) == 0 {
+		return nil, errors.New("at least one config flag must be provided")
+	}
+	return NewConfigProvider(configProviderSettingsWithDefaults(set.ConfigProviderSettings, configFlags))
+}
@@ -4,12 +4,16 @@
 package otelcol // import "go.opentelemetry.io/collector/otelcol"
 
 import (
+	"encoding/json"
 	"fmt"
+	"regexp"
 
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/featuregate"
+	"go.opentelemetry.io/collector/otelcol/internal/configschema"
 )
 
 type componentsOutput struct {
@@ -19,15 +23,34 @@ type componentsOutput struct {
 	Exporters  []component.Type
 	Connectors []component.Type
 	Extensions []component.Type
+	Modules    componentModules `yaml:",omitempty"`
+}
+
+// componentModules lists the Go module providing each component, when known.
+type componentModules struct {
+	Receivers  map[component.Type]string `yaml:",omitempty"`
+	Processors map[component.Type]string `yaml:",omitempty"`
+	Exporters  map[component.Type]string `yaml:",omitempty"`
+	Connectors map[component.Type]string `yaml:",omitempty"`
+	Extensions map[component.Type]string `yaml:",omitempty"`
 }
 
 // newComponentsCommand constructs a new components command using the given CollectorSettings.
 func newComponentsCommand(set CollectorSettings) *cobra.Command {
-	return &cobra.Command{
+	var schema bool
+	componentsCmd := &cobra.Command{
 		Use:   "components",
 		Short: "Outputs available components in this collector distribution",
-		Args:  cobra.ExactArgs(0),
+		Long: `Outputs available components in this collector distribution.
+With --schema, outputs the JSON Schema of the configuration instead, including the configuration of all the
+components and their default values, to validate configuration files in editors or CI.`,
+		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if schema {
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				return enc.Encode(configSchema(set))
+			}
 
 			components := componentsOutput{}
 			for con := range set.Factories.Connectors {
@@ -46,6 +69,13 @@ func newComponentsCommand(set CollectorSettings) *cobra.Command {
 				components.Exporters = append(components.Exporters, exp)
 			}
 			components.BuildInfo = set.BuildInfo
+			components.Modules = componentModules{
+				Receivers:  set.BuildInfo.Modules[component.KindReceiver],
+				Processors: set.BuildInfo.Modules[component.KindProcessor],
+				Exporters:  set.BuildInfo.Modules[component.KindExporter],
+				Connectors: set.BuildInfo.Modules[component.KindConnector],
+				Extensions: set.BuildInfo.Modules[component.KindExtension],
+			}
 			yamlData, err := yaml.Marshal(components)
 			if err != nil {
 				return err
@@ -54,4 +84,38 @@ func newComponentsCommand(set CollectorSettings) *cobra.Command {
 			return nil
 		},
 	}
+	componentsCmd.Flags().BoolVar(&schema, "schema", false, "Output the JSON Schema of the configuration")
+	return componentsCmd
+}
+
+// configSchema returns the JSON Schema of the configuration of the collector,
+// generated from the default configuration of the service and of each component.
+func configSchema(set CollectorSettings) *configschema.Schema {
+	doc := configschema.NewDocument(set.BuildInfo.Description + " configuration")
+	doc.Properties = map[string]*configschema.Schema{
+		"receivers":  componentsSchema(doc, set.Factories.Receivers),
+		"processors": componentsSchema(doc, set.Factories.Processors),
+		"exporters":  componentsSchema(doc, set.Factories.Exporters),
+		"connectors": componentsSchema(doc, set.Factories.Connectors),
+		"extensions": componentsSchema(doc, set.Factories.Extensions),
+		"service":    configschema.FromConfig(defaultServiceConfig()),
+	}
+	doc.AdditionalProperties = false
+	return doc
+}
+
+// componentsSchema returns the schema of a section of the configuration, where
+// the configurations are keyed by the IDs of the components, i.e. "type[/name]".
+func componentsSchema[F component.Factory](doc *configschema.Schema, factories map[component.Type]F) *configschema.Schema {
+	s := &configschema.Schema{
+		Type:                 []string{"object", "null"},
+		PatternProperties:    map[string]*configschema.Schema{},
+		AdditionalProperties: false,
+	}
+	for typ, factory := range factories {
+		cs := configschema.FromConfig(factory.CreateDefaultConfig())
+		configschema.AddFeatureGates(doc, cs, featuregate.GlobalRegistry())
+		s.PatternProperties["^"+regexp.QuoteMeta(string(typ))+"(/.+)?$"] = cs
+	}
+	return s
 }
@@ -5,6 +5,7 @@ package otelcol
 
 import (
 	"bytes"
+	"encoding/json"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -51,3 +52,68 @@ func TestNewBuildSubCommand(t *testing.T) {
 	// line that makes the test fail.
 	assert.Equal(t, strings.Trim(string(ExpectedOutput), "\n"), strings.Trim(b.String(), "\n"))
 }
+
+func TestNewBuildSubCommandWithModules(t *testing.T) {
+	factories, err := nopFactories()
+	require.NoError(t, err)
+
+	buildInfo := component.NewDefaultBuildInfo()
+	buildInfo.Modules = map[component.Kind]map[component.Type]string{
+		component.KindReceiver: {"nop": "example.com/nopreceiver v1.2.3"},
+		component.KindExporter: {"nop": "example.com/nopexporter v1.2.3"},
+	}
+	set := CollectorSettings{
+		BuildInfo: buildInfo,
+		Factories: factories,
+	}
+	cmd := NewCommand(set)
+	cmd.SetArgs([]string{"components"})
+
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	require.NoError(t, cmd.Execute())
+
+	var output componentsOutput
+	require.NoError(t, yaml.Unmarshal(b.Bytes(), &output))
+	assert.Equal(t, componentModules{
+		Receivers: map[component.Type]string{"nop": "example.com/nopreceiver v1.2.3"},
+		Exporters: map[component.Type]string{"nop": "example.com/nopexporter v1.2.3"},
+	}, output.Modules)
+}
+
+func TestNewBuildSubCommandSchema(t *testing.T) {
+	factories, err := nopFactories()
+	require.NoError(t, err)
+
+	set := CollectorSettings{
+		BuildInfo: component.NewDefaultBuildInfo(),
+		Factories: factories,
+	}
+	cmd := NewCommand(set)
+	cmd.SetArgs([]string{"components", "--schema"})
+
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	require.NoError(t, cmd.Execute())
+
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(b.Bytes(), &schema))
+	assert.Equal(t, "https://json-schema.org/draft/2020-12/schema", schema["$schema"])
+	assert.Equal(t, false, schema["additionalProperties"])
+
+	properties, ok := schema["properties"].(map[string]any)
+	require.True(t, ok)
+	assert.Len(t, properties, 6)
+	for _, section := range []string{"receivers", "processors", "exporters", "connectors", "extensions"} {
+		patterns := properties[section].(map[string]any)["patternProperties"].(map[string]any)
+		require.Contains(t, patterns, "^nop(/.+)?$", section)
+		// The feature_gates key is reserved in the configuration of all the components.
+		nop := patterns["^nop(/.+)?$"].(map[string]any)
+		assert.Equal(t, map[string]any{"$ref": "#/$defs/featureGates"}, nop["properties"].(map[string]any)["feature_gates"], section)
+	}
+	assert.Contains(t, schema["$defs"], "featureGates")
+
+	logs := properties["service"].(map[string]any)["properties"].(map[string]any)["telemetry"].(map[string]any)["properties"].(map[string]any)["logs"].(map[string]any)
+	level := logs["properties"].(map[string]any)["level"].(map[string]any)
+	assert.Equal(t, "info", level["default"])
+}
@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcol // import "go.opentelemetry.io/collector/otelcol"
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/multierr"
+
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/service"
+)
+
+// newGraphSubCommand constructs a new graph sub command using the given CollectorSettings.
+func newGraphSubCommand(set CollectorSettings, flagSet *flag.FlagSet) *cobra.Command {
+	var format string
+	graphCmd := &cobra.Command{
+		Use:   "graph",
+		Short: "Prints the graph of the pipelines as Graphviz DOT or Mermaid",
+		Long: `Prints the graph of the pipelines, with a node for each instance of the receivers, processors, connectors
+and exporters, labeled by the component ID and its signal. The output can be rendered with Graphviz, e.g.
+"graph --config=config.yaml | dot -Tsvg > pipelines.svg", or in a Mermaid diagram.`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			graphFormat := service.GraphFormat(format)
+			if graphFormat != service.GraphFormatDOT && graphFormat != service.GraphFormatMermaid {
+				return fmt.Errorf("unsupported format %q, must be either %q or %q", format, service.GraphFormatDOT, service.GraphFormatMermaid)
+			}
+			provider, err := configProviderWithFlags(set, flagSet)
+			if err != nil {
+				return err
+			}
+			cfg, err := provider.Get(cmd.Context(), set.Factories)
+			if err != nil {
+				return multierr.Append(err, provider.Shutdown(cmd.Context()))
+			}
+			if err = provider.Shutdown(cmd.Context()); err != nil {
+				return err
+			}
+			if err = cfg.Validate(); err != nil {
+				return fmt.Errorf("invalid configuration: %w", err)
+			}
+
+			out, err := service.PipelinesGraph(cfg.Service, connector.NewBuilder(cfg.Connectors, set.Factories.Connectors), graphFormat)
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprint(cmd.OutOrStdout(), out)
+			return err
+		},
+	}
+	graphCmd.Flags().AddGoFlagSet(flagSet)
+	graphCmd.Flags().StringVar(&format, "format", string(service.GraphFormatDOT), "Output format, either \"dot\" or \"mermaid\"")
+	return graphCmd
+}
@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcol
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/featuregate"
+)
+
+func TestGraphSubCommandNoConfig(t *testing.T) {
+	factories, err := nopFactories()
+	require.NoError(t, err)
+
+	cmd := newGraphSubCommand(CollectorSettings{Factories: factories}, flags(featuregate.GlobalRegistry()))
+	err = cmd.Execute()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "at least one config flag must be provided")
+}
+
+func TestGraphSubCommandInvalidFormat(t *testing.T) {
+	factories, err := nopFactories()
+	require.NoError(t, err)
+
+	cmd := newGraphSubCommand(CollectorSettings{Factories: factories}, flags(featuregate.GlobalRegistry()))
+	cmd.SetArgs([]string{"--config=" + filepath.Join("testdata", "otelcol-nop.yaml"), "--format=svg"})
+	assert.EqualError(t, cmd.Execute(), `unsupported format "svg", must be either "dot" or "mermaid"`)
+}
+
+func TestGraphSubCommandInvalidConfig(t *testing.T) {
+	factories, err := nopFactories()
+	require.NoError(t, err)
+
+	cmd := newGraphSubCommand(CollectorSettings{Factories: factories}, flags(featuregate.GlobalRegistry()))
+	cmd.SetArgs([]string{"--config=" + filepath.Join("testdata", "otelcol-invalid.yaml")})
+	err = cmd.Execute()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `references processor "invalid" which is not configured`)
+}
+
+func TestGraphSubCommand(t *testing.T) {
+	factories, err := nopFactories()
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		format   string
+		expected []string
+	}{
+		{
+			format: "dot",
+			expected: []string{
+				"digraph pipelines {",
+				`connector__nop_con__traces_to_logs [label="connector\nnop/con\ntraces to logs", shape=diamond];`,
+				"processor__nop__traces -> connector__nop_con__traces_to_logs;",
+				"connector__nop_con__traces_to_logs -> processor__nop__logs;",
+			},
+		},
+		{
+			format: "mermaid",
+			expected: []string{
+				"flowchart LR",
+				`connector__nop_con__traces_to_logs["connector<br>nop/con<br>traces to logs"]`,
+				"processor__nop__traces --> connector__nop_con__traces_to_logs",
+				"connector__nop_con__traces_to_logs --> processor__nop__logs",
+			},
+		},
+	} {
+		t.Run(tt.format, func(t *testing.T) {
+			cmd := newGraphSubCommand(CollectorSettings{Factories: factories}, flags(featuregate.GlobalRegistry()))
+			out := new(bytes.Buffer)
+			cmd.SetOut(out)
+			cmd.SetArgs([]string{"--config=" + filepath.Join("testdata", "otelcol-nop.yaml"), "--format=" + tt.format})
+			require.NoError(t, cmd.Execute())
+			for _, expected := range tt.expected {
+				assert.Contains(t, out.String(), expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcol // import "go.opentelemetry.io/collector/otelcol"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"reflect"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/multierr"
+	"gopkg.in/yaml.v3"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/service"
+)
+
+const (
+	formatYAML = "yaml"
+	formatJSON = "json"
+
+	// redactedValue is the value of the configopaque.String values once marshaled.
+	redactedValue = "[REDACTED]"
+)
+
+// newPrintConfigSubCommand constructs a new print-config sub command using the given CollectorSettings.
+func newPrintConfigSubCommand(set CollectorSettings, flagSet *flag.FlagSet) *cobra.Command {
+	var format string
+	var unredacted bool
+	printConfigCmd := &cobra.Command{
+		Use:   "print-config",
+		Short: "Prints the resolved configuration, including the default values of the components",
+		Long: `Prints the configuration the collector runs with, once all the config locations and --set flags are
+merged, the providers and converters applied, and the default configuration of each component filled in.
+Sensitive values are redacted unless --unredacted is set.`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if format != formatYAML && format != formatJSON {
+				return fmt.Errorf("unsupported format %q, must be either %q or %q", format, formatYAML, formatJSON)
+			}
+			provider, err := configProviderWithFlags(set, flagSet)
+			if err != nil {
+				return err
+			}
+			conf, err := effectiveConfig(cmd.Context(), provider, set.Factories, unredacted)
+			if err != nil {
+				return multierr.Append(err, provider.Shutdown(cmd.Context()))
+			}
+			if err = provider.Shutdown(cmd.Context()); err != nil {
+				return err
+			}
+
+			if format == formatJSON {
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				return enc.Encode(conf.ToStringMap())
+			}
+			enc := yaml.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent(2)
+			if err = enc.Encode(conf.ToStringMap()); err != nil {
+				return err
+			}
+			return enc.Close()
+		},
+	}
+	printConfigCmd.Flags().AddGoFlagSet(flagSet)
+	printConfigCmd.Flags().StringVar(&format, "format", formatYAML, "Output format, either \"yaml\" or \"json\"")
+	printConfigCmd.Flags().BoolVar(&unredacted, "unredacted", false, "Print the sensitive values instead of redacting them")
+	return printConfigCmd
+}
+
+// effectiveConfig resolves the configuration, and marshals it back once the
+// configuration of each component is unmarshaled over its default configuration.
+func effectiveConfig(ctx context.Context, provider ConfigProvider, factories Factories, unredacted bool) (*confmap.Conf, error) {
+	cfg, err := provider.Get(ctx, factories)
+	if err != nil {
+		return nil, err
+	}
+	conf := confmap.New()
+	if err = conf.Marshal(cfg); err != nil {
+		return nil, fmt.Errorf("cannot marshal the configuration: %w", err)
+	}
+	// The feature gates of the components are not part of their configuration.
+	if err = conf.Merge(confmap.NewFromStringMap(componentFeatureGatesMap(cfg.ComponentFeatureGates))); err != nil {
+		return nil, err
+	}
+	if !unredacted {
+		return conf, nil
+	}
+
+	cp, ok := provider.(ConfmapProvider)
+	if !ok {
+		return nil, errors.New("the config provider cannot provide the unredacted configuration")
+	}
+	raw, err := cp.GetConfmap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return confmap.NewFromStringMap(unredact(conf.ToStringMap(), raw, "")), nil
+}
+
+// componentFeatureGatesMap returns the feature gates overridden for individual components, under the
+// feature_gates key of their configuration.
+func componentFeatureGatesMap(gates service.ComponentFeatureGates) map[string]any {
+	m := make(map[string]any)
+	for _, section := range []struct {
+		key  string
+		kind component.Kind
+	}{
+		{key: "receivers", kind: component.KindReceiver},
+		{key: "processors", kind: component.KindProcessor},
+		{key: "exporters", kind: component.KindExporter},
+		{key: "connectors", kind: component.KindConnector},
+		{key: "extensions", kind: component.KindExtension},
+	} {
+		for id, overrides := range gates[section.kind] {
+			m[section.key+confmap.KeyDelimiter+id.String()+confmap.KeyDelimiter+"feature_gates"] = overrides
+		}
+	}
+	return m
+}
+
+// unredact replaces the redacted values of the marshaled configuration
+// by the values set in the resolved configuration.
+func unredact(m map[string]any, raw *confmap.Conf, prefix string) map[string]any {
+	for k, v := range m {
+		key := prefix + k
+		switch val := v.(type) {
+		case map[string]any:
+			m[k] = unredact(val, raw, key+confmap.KeyDelimiter)
+		case string:
+			if val == redactedValue && raw.IsSet(key) {
+				m[k] = plainValue(raw.Get(key))
+			}
+		}
+	}
+	return m
+}
+
+// plainValue returns the values of the string types, e.g. the configopaque.String
+// values retrieved by some providers, as strings which are not redacted when encoded.
+func plainValue(v any) any {
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.String {
+		return rv.String()
+	}
+	return v
+}
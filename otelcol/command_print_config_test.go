@@ -0,0 +1,165 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcol
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/featuregate"
+	"go.opentelemetry.io/collector/receiver"
+)
+
+// secret mimics configopaque.String.
+type secret string
+
+func (secret) MarshalText() ([]byte, error) {
+	return []byte(redactedValue), nil
+}
+
+type secretConfig struct {
+	Endpoint string `mapstructure:"endpoint"`
+	Token    secret `mapstructure:"token"`
+}
+
+func secretFactories(t *testing.T) Factories {
+	factories, err := nopFactories()
+	require.NoError(t, err)
+	factories.Receivers["secret"] = receiver.NewFactory("secret",
+		func() component.Config { return &secretConfig{Endpoint: "localhost:1234"} },
+		receiver.WithTraces(func(context.Context, receiver.CreateSettings, component.Config, consumer.Traces) (receiver.Traces, error) {
+			return nil, nil
+		}, component.StabilityLevelDevelopment))
+	return factories
+}
+
+func TestPrintConfigSubCommandNoConfig(t *testing.T) {
+	factories, err := nopFactories()
+	require.NoError(t, err)
+
+	cmd := newPrintConfigSubCommand(CollectorSettings{Factories: factories}, flags(featuregate.GlobalRegistry()))
+	err = cmd.Execute()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "at least one config flag must be provided")
+}
+
+func TestPrintConfigSubCommandInvalidFormat(t *testing.T) {
+	factories, err := nopFactories()
+	require.NoError(t, err)
+
+	cmd := newPrintConfigSubCommand(CollectorSettings{Factories: factories}, flags(featuregate.GlobalRegistry()))
+	cmd.SetArgs([]string{"--config", filepath.Join("testdata", "otelcol-nop.yaml"), "--format", "toml"})
+	assert.EqualError(t, cmd.Execute(), `unsupported format "toml", must be either "yaml" or "json"`)
+}
+
+func TestPrintConfigSubCommand(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		unmarshal func([]byte, any) error
+		token     string
+	}{
+		{
+			name:      "yaml",
+			args:      nil,
+			unmarshal: yaml.Unmarshal,
+			token:     redactedValue,
+		},
+		{
+			name:      "json",
+			args:      []string{"--format", "json"},
+			unmarshal: json.Unmarshal,
+			token:     redactedValue,
+		},
+		{
+			name:      "unredacted",
+			args:      []string{"--unredacted"},
+			unmarshal: yaml.Unmarshal,
+			token:     "my-token",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := newPrintConfigSubCommand(CollectorSettings{Factories: secretFactories(t)}, flags(featuregate.NewRegistry()))
+			cmd.SetArgs(append([]string{
+				"--config", filepath.Join("testdata", "otelcol-nop.yaml"),
+				"--set", "receivers.secret.token=my-token",
+				"--set", "service.pipelines.traces.receivers=[nop,secret]",
+			}, tt.args...))
+			out := bytes.NewBufferString("")
+			cmd.SetOut(out)
+			require.NoError(t, cmd.Execute())
+
+			var printed map[string]any
+			require.NoError(t, tt.unmarshal(out.Bytes(), &printed))
+			conf := confmap.NewFromStringMap(printed)
+			// Default value filled in from the factory.
+			assert.Equal(t, "localhost:1234", conf.Get("receivers::secret::endpoint"))
+			assert.Equal(t, tt.token, conf.Get("receivers::secret::token"))
+			assert.Equal(t, []any{"nop", "secret"}, conf.Get("service::pipelines::traces::receivers"))
+			assert.Equal(t, "localhost:8888", conf.Get("service::telemetry::metrics::address"))
+		})
+	}
+}
+
+func TestPrintConfigSubCommandFeatureGates(t *testing.T) {
+	gate := featuregate.GlobalRegistry().MustRegister("otelcol.test.printConfigFeatureGate", featuregate.StageAlpha)
+
+	cmd := newPrintConfigSubCommand(CollectorSettings{Factories: secretFactories(t)}, flags(featuregate.NewRegistry()))
+	cmd.SetArgs([]string{
+		"--config", filepath.Join("testdata", "otelcol-nop.yaml"),
+		"--set", "receivers.secret.feature_gates={" + gate.ID() + ": true}",
+		"--set", "service.pipelines.traces.receivers=[nop,secret]",
+	})
+	out := bytes.NewBufferString("")
+	cmd.SetOut(out)
+	require.NoError(t, cmd.Execute())
+
+	var printed map[string]any
+	require.NoError(t, yaml.Unmarshal(out.Bytes(), &printed))
+	conf := confmap.NewFromStringMap(printed)
+	// The feature gates are printed in the configuration of the component, not as a setting of the collector.
+	assert.Equal(t, map[string]any{gate.ID(): true}, conf.Get("receivers::secret::feature_gates"))
+	assert.Equal(t, "localhost:1234", conf.Get("receivers::secret::endpoint"))
+	assert.Nil(t, conf.Get("receivers::nop::feature_gates"))
+	assert.Nil(t, conf.Get("componentfeaturegates"))
+}
+
+func TestUnredact(t *testing.T) {
+	raw := confmap.NewFromStringMap(map[string]any{
+		"exporters": map[string]any{
+			"otlp": map[string]any{
+				"headers": map[string]any{"api-key": "my-key", "token": secret("my-token")},
+			},
+		},
+	})
+	assert.Equal(t, map[string]any{
+		"exporters": map[string]any{
+			"otlp": map[string]any{
+				// The values retrieved with a string type are printed as strings.
+				"headers":  map[string]any{"api-key": "my-key", "token": "my-token"},
+				"endpoint": "localhost:4317",
+				"key_pem":  redactedValue,
+			},
+		},
+	}, unredact(map[string]any{
+		"exporters": map[string]any{
+			"otlp": map[string]any{
+				"headers":  map[string]any{"api-key": redactedValue, "token": redactedValue},
+				"endpoint": "localhost:4317",
+				"key_pem":  redactedValue,
+			},
+		},
+	}, raw, ""))
+}
@@ -4,8 +4,8 @@
 package otelcol // import "go.opentelemetry.io/collector/otelcol"
 
 import (
-	"errors"
 	"flag"
+	"fmt"
 
 	"github.com/spf13/cobra"
 )
@@ -17,24 +17,15 @@ func newValidateSubCommand(set CollectorSettings, flagSet *flag.FlagSet) *cobra.
 		Short: "Validates the config without running the collector",
 		Args:  cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if set.ConfigProvider == nil {
-				var err error
-
-				configFlags := getConfigFlag(flagSet)
-				if len(configFlags) == 0 {
-					return errors.New("at least one config flag must be provided")
-				}
-
-				set.ConfigProvider, err = NewConfigProvider(newDefaultConfigProviderSettings(configFlags))
-				if err != nil {
-					return err
-				}
-			}
-			col, err := NewCollector(set)
+			col, err := newCollectorWithFlags(set, flagSet)
 			if err != nil {
 				return err
 			}
-			return col.DryRun(cmd.Context())
+			warnings, err := col.dryRun(cmd.Context())
+			for _, warning := range warnings {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %v\n", warning)
+			}
+			return err
 		},
 	}
 	validateCmd.Flags().AddGoFlagSet(flagSet)
@@ -4,6 +4,7 @@
 package otelcol
 
 import (
+	"bytes"
 	"path/filepath"
 	"testing"
 
@@ -43,4 +44,46 @@ func TestValidateSubCommandInvalidComponents(t *testing.T) {
 	err = cmd.Execute()
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "unknown type: \"nosuchprocessor\"")
+	require.Contains(t, err.Error(), "(set in file:"+filepath.Join("testdata", "otelcol-invalid-components.yaml")+":6)")
+}
+
+func TestValidateSubCommandOrigins(t *testing.T) {
+	factories, err := nopFactories()
+	require.NoError(t, err)
+
+	cmd := newValidateSubCommand(CollectorSettings{Factories: factories}, flags(featuregate.GlobalRegistry()))
+	cmd.SetArgs([]string{
+		"--config=" + filepath.Join("testdata", "otelcol-invalid.yaml"),
+		"--set=service.pipelines.traces.exporters=[invalid]",
+	})
+	err = cmd.Execute()
+	require.Error(t, err)
+	// The origin is the one of the processors, not of the exporters set in the same pipeline.
+	require.Contains(t, err.Error(), `service::pipelines::traces::processors: references processor "invalid" which is not configured `+
+		"(set in file:"+filepath.Join("testdata", "otelcol-invalid.yaml")+":17)")
+	require.NotContains(t, err.Error(), "yaml:service::pipelines::traces::exporters")
+}
+
+func TestValidateSubCommandWarnings(t *testing.T) {
+	factories, err := nopFactories()
+	require.NoError(t, err)
+
+	cmd := newValidateSubCommand(CollectorSettings{Factories: factories}, flags(featuregate.GlobalRegistry()))
+	stderr := new(bytes.Buffer)
+	cmd.SetErr(stderr)
+	cmd.SetArgs([]string{"--config=" + filepath.Join("testdata", "otelcol-unused.yaml")})
+	require.NoError(t, cmd.Execute())
+	require.Equal(t, "Warning: receivers::nop/unused: not used by any pipeline (set in file:"+
+		filepath.Join("testdata", "otelcol-unused.yaml")+":3)\n", stderr.String())
+}
+
+func TestValidateSubCommandStrict(t *testing.T) {
+	factories, err := nopFactories()
+	require.NoError(t, err)
+
+	cmd := newValidateSubCommand(CollectorSettings{Factories: factories}, flags(featuregate.GlobalRegistry()))
+	cmd.SetArgs([]string{"--config=" + filepath.Join("testdata", "otelcol-unused.yaml"), "--strict"})
+	err = cmd.Execute()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "receivers::nop/unused: not used by any pipeline")
 }
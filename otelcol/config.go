@@ -6,8 +6,12 @@ package otelcol // import "go.opentelemetry.io/collector/otelcol"
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/featuregate"
 	"go.opentelemetry.io/collector/service"
 )
 
@@ -33,6 +37,10 @@ type Config struct {
 	// Extensions is a map of ComponentID to extensions.
 	Extensions map[component.ID]component.Config
 
+	// ComponentFeatureGates are the feature gates overridden for individual components,
+	// with the feature_gates key of their configuration.
+	ComponentFeatureGates service.ComponentFeatureGates `mapstructure:"-"`
+
 	Service service.Config
 }
 
@@ -51,7 +59,7 @@ func (cfg *Config) Validate() error {
 	// Validate the receiver configuration.
 	for recvID, recvCfg := range cfg.Receivers {
 		if err := component.ValidateConfig(recvCfg); err != nil {
-			return fmt.Errorf("receivers::%s: %w", recvID, err)
+			return newKeyError("receivers::"+recvID.String(), err)
 		}
 	}
 
@@ -64,51 +72,70 @@ func (cfg *Config) Validate() error {
 	// Validate the exporter configuration.
 	for expID, expCfg := range cfg.Exporters {
 		if err := component.ValidateConfig(expCfg); err != nil {
-			return fmt.Errorf("exporters::%s: %w", expID, err)
+			return newKeyError("exporters::"+expID.String(), err)
 		}
 	}
 
 	// Validate the processor configuration.
 	for procID, procCfg := range cfg.Processors {
 		if err := component.ValidateConfig(procCfg); err != nil {
-			return fmt.Errorf("processors::%s: %w", procID, err)
+			return newKeyError("processors::"+procID.String(), err)
 		}
 	}
 
 	// Validate the connector configuration.
 	for connID, connCfg := range cfg.Connectors {
 		if err := component.ValidateConfig(connCfg); err != nil {
-			return fmt.Errorf("connectors::%s: %w", connID, err)
+			return newKeyError("connectors::"+connID.String(), err)
 		}
 
 		if _, ok := cfg.Exporters[connID]; ok {
-			return fmt.Errorf("connectors::%s: ambiguous ID: Found both %q exporter and %q connector. "+
+			return newKeyError("connectors::"+connID.String(), fmt.Errorf("ambiguous ID: Found both %q exporter and %q connector. "+
 				"Change one of the components' IDs to eliminate ambiguity (e.g. rename %q connector to %q)",
-				connID, connID, connID, connID, connID.String()+"/connector")
+				connID, connID, connID, connID.String()+"/connector"))
 		}
 		if _, ok := cfg.Receivers[connID]; ok {
-			return fmt.Errorf("connectors::%s: ambiguous ID: Found both %q receiver and %q connector. "+
+			return newKeyError("connectors::"+connID.String(), fmt.Errorf("ambiguous ID: Found both %q receiver and %q connector. "+
 				"Change one of the components' IDs to eliminate ambiguity (e.g. rename %q connector to %q)",
-				connID, connID, connID, connID, connID.String()+"/connector")
+				connID, connID, connID, connID.String()+"/connector"))
 		}
 	}
 
 	// Validate the extension configuration.
 	for extID, extCfg := range cfg.Extensions {
 		if err := component.ValidateConfig(extCfg); err != nil {
-			return fmt.Errorf("extensions::%s: %w", extID, err)
+			return newKeyError("extensions::"+extID.String(), err)
+		}
+	}
+
+	// Validate the feature gates overridden for individual components.
+	for _, section := range []struct {
+		key  string
+		kind component.Kind
+	}{
+		{key: "receivers", kind: component.KindReceiver},
+		{key: "processors", kind: component.KindProcessor},
+		{key: "exporters", kind: component.KindExporter},
+		{key: "connectors", kind: component.KindConnector},
+		{key: "extensions", kind: component.KindExtension},
+	} {
+		gates := cfg.ComponentFeatureGates[section.kind]
+		for _, id := range sortedFeatureGateComponentIDs(gates) {
+			if _, err := featuregate.GlobalRegistry().NewView(gates[id]); err != nil {
+				return newKeyError(section.key+"::"+id.String()+"::feature_gates", err)
+			}
 		}
 	}
 
 	if err := cfg.Service.Validate(); err != nil {
-		return err
+		return &keyError{key: "service", err: err}
 	}
 
 	// Check that all enabled extensions in the service are configured.
 	for _, ref := range cfg.Service.Extensions {
 		// Check that the name referenced in the Service extensions exists in the top-level extensions.
 		if cfg.Extensions[ref] == nil {
-			return fmt.Errorf("service::extensions: references extension %q which is not configured", ref)
+			return newKeyError("service::extensions", fmt.Errorf("references extension %q which is not configured", ref))
 		}
 	}
 
@@ -124,14 +151,14 @@ func (cfg *Config) Validate() error {
 			if _, ok := cfg.Connectors[ref]; ok {
 				continue
 			}
-			return fmt.Errorf("service::pipelines::%s: references receiver %q which is not configured", pipelineID, ref)
+			return newKeyError("service::pipelines::"+pipelineID.String()+"::receivers", fmt.Errorf("references receiver %q which is not configured", ref))
 		}
 
 		// Validate pipeline processor name references.
 		for _, ref := range pipeline.Processors {
 			// Check that the name referenced in the pipeline's processors exists in the top-level processors.
 			if cfg.Processors[ref] == nil {
-				return fmt.Errorf("service::pipelines::%s: references processor %q which is not configured", pipelineID, ref)
+				return newKeyError("service::pipelines::"+pipelineID.String()+"::processors", fmt.Errorf("references processor %q which is not configured", ref))
 			}
 		}
 
@@ -144,8 +171,105 @@ func (cfg *Config) Validate() error {
 			if _, ok := cfg.Connectors[ref]; ok {
 				continue
 			}
-			return fmt.Errorf("service::pipelines::%s: references exporter %q which is not configured", pipelineID, ref)
+			return newKeyError("service::pipelines::"+pipelineID.String()+"::exporters", fmt.Errorf("references exporter %q which is not configured", ref))
 		}
 	}
 	return nil
 }
+
+// Warnings returns the issues of the config which do not make it invalid, but are likely mistakes:
+// the components not used by any pipeline, and the pipelines whose data never comes from a
+// receiver or never reaches an exporter through the connectors.
+func (cfg *Config) Warnings() []error {
+	var warnings []error
+	used := make(map[component.ID]struct{})
+	for _, pipeline := range cfg.Service.Pipelines {
+		for _, ids := range [][]component.ID{pipeline.Receivers, pipeline.Processors, pipeline.Exporters} {
+			for _, id := range ids {
+				used[id] = struct{}{}
+			}
+		}
+	}
+	for _, section := range []struct {
+		key  string
+		cfgs map[component.ID]component.Config
+	}{
+		{key: "receivers", cfgs: cfg.Receivers},
+		{key: "processors", cfgs: cfg.Processors},
+		{key: "exporters", cfgs: cfg.Exporters},
+		{key: "connectors", cfgs: cfg.Connectors},
+	} {
+		for _, id := range sortedIDs(section.cfgs) {
+			if _, ok := used[id]; !ok {
+				warnings = append(warnings, newKeyError(section.key+"::"+id.String(), errors.New("not used by any pipeline")))
+			}
+		}
+	}
+
+	noReceiver, noExporter := cfg.Service.DeadPipelines(cfg.Connectors)
+	for _, pipelineID := range noReceiver {
+		warnings = append(warnings, newKeyError("service::pipelines::"+pipelineID.String(),
+			errors.New("no data comes from a receiver, directly or through connectors")))
+	}
+	for _, pipelineID := range noExporter {
+		warnings = append(warnings, newKeyError("service::pipelines::"+pipelineID.String(),
+			errors.New("the data never reaches an exporter, directly or through connectors")))
+	}
+	return warnings
+}
+
+func sortedIDs(cfgs map[component.ID]component.Config) []component.ID {
+	ids := make([]component.ID, 0, len(cfgs))
+	for id := range cfgs {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+	return ids
+}
+
+func sortedFeatureGateComponentIDs(gates map[component.ID]map[string]bool) []component.ID {
+	ids := make([]component.ID, 0, len(gates))
+	for id := range gates {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+	return ids
+}
+
+// keyError is an error about the value of a key of the configuration. The key
+// is used to find where the value comes from, see withOrigins.
+type keyError struct {
+	key string
+	err error
+}
+
+// newKeyError returns a keyError with the message of err prefixed by the key.
+func newKeyError(key string, err error) error {
+	return &keyError{key: key, err: fmt.Errorf("%s: %w", key, err)}
+}
+
+func (e *keyError) Error() string {
+	return e.err.Error()
+}
+
+func (e *keyError) Unwrap() error {
+	return e.err
+}
+
+// withOrigins adds to a keyError where the value of its key comes from, as recorded
+// by the confmap.Resolver, e.g. the file and line or the "--set" flag.
+func withOrigins(conf *confmap.Conf, err error) error {
+	var kErr *keyError
+	if conf == nil || !errors.As(err, &kErr) {
+		return err
+	}
+	origins := conf.Origins(kErr.key)
+	if len(origins) == 0 {
+		return err
+	}
+	locations := make([]string, 0, len(origins))
+	for _, o := range origins {
+		locations = append(locations, o.String())
+	}
+	return fmt.Errorf("%w (set in %s)", err, strings.Join(locations, ", "))
+}
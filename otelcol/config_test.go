@@ -89,7 +89,7 @@ func TestConfigValidate(t *testing.T) {
 				pipe.Receivers = append(pipe.Receivers, component.NewIDWithName("nop", "2"))
 				return cfg
 			},
-			expected: errors.New(`service::pipelines::traces: references receiver "nop/2" which is not configured`),
+			expected: errors.New(`service::pipelines::traces::receivers: references receiver "nop/2" which is not configured`),
 		},
 		{
 			name: "invalid-processor-reference",
@@ -99,7 +99,7 @@ func TestConfigValidate(t *testing.T) {
 				pipe.Processors = append(pipe.Processors, component.NewIDWithName("nop", "2"))
 				return cfg
 			},
-			expected: errors.New(`service::pipelines::traces: references processor "nop/2" which is not configured`),
+			expected: errors.New(`service::pipelines::traces::processors: references processor "nop/2" which is not configured`),
 		},
 		{
 			name: "invalid-exporter-reference",
@@ -109,7 +109,7 @@ func TestConfigValidate(t *testing.T) {
 				pipe.Exporters = append(pipe.Exporters, component.NewIDWithName("nop", "2"))
 				return cfg
 			},
-			expected: errors.New(`service::pipelines::traces: references exporter "nop/2" which is not configured`),
+			expected: errors.New(`service::pipelines::traces::exporters: references exporter "nop/2" which is not configured`),
 		},
 		{
 			name: "invalid-receiver-config",
@@ -200,7 +200,7 @@ func TestConfigValidate(t *testing.T) {
 				pipe.Receivers = append(pipe.Receivers, component.NewIDWithName("nop", "conn2"))
 				return cfg
 			},
-			expected: errors.New(`service::pipelines::traces: references receiver "nop/conn2" which is not configured`),
+			expected: errors.New(`service::pipelines::traces::receivers: references receiver "nop/conn2" which is not configured`),
 		},
 		{
 			name: "invalid-connector-reference-as-receiver",
@@ -210,7 +210,18 @@ func TestConfigValidate(t *testing.T) {
 				pipe.Exporters = append(pipe.Exporters, component.NewIDWithName("nop", "conn2"))
 				return cfg
 			},
-			expected: errors.New(`service::pipelines::traces: references exporter "nop/conn2" which is not configured`),
+			expected: errors.New(`service::pipelines::traces::exporters: references exporter "nop/conn2" which is not configured`),
+		},
+		{
+			name: "invalid-component-feature-gates",
+			cfgFn: func() *Config {
+				cfg := generateConfig()
+				cfg.ComponentFeatureGates = service.ComponentFeatureGates{
+					component.KindProcessor: {component.NewID("nop"): {"unknown": true}},
+				}
+				return cfg
+			},
+			expected: errors.New(`processors::nop::feature_gates: no such feature gate "unknown"`),
 		},
 		{
 			name: "invalid-service-config",
@@ -226,11 +237,39 @@ func TestConfigValidate(t *testing.T) {
 	for _, test := range testCases {
 		t.Run(test.name, func(t *testing.T) {
 			cfg := test.cfgFn()
-			assert.Equal(t, test.expected, cfg.Validate())
+			err := cfg.Validate()
+			if test.expected == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, test.expected.Error())
 		})
 	}
 }
 
+func TestConfigWarnings(t *testing.T) {
+	cfg := generateConfig()
+	assert.Equal(t, []error{
+		newKeyError("connectors::nop/conn", errors.New("not used by any pipeline")),
+	}, cfg.Warnings())
+
+	cfg.Receivers[component.NewIDWithName("nop", "2")] = &errConfig{}
+	cfg.Exporters[component.NewIDWithName("nop", "2")] = &errConfig{}
+	cfg.Service.Pipelines[component.NewIDWithName("traces", "dead")] = &pipelines.PipelineConfig{
+		Receivers: []component.ID{component.NewID("nop")},
+		Exporters: []component.ID{component.NewIDWithName("nop", "conn")},
+	}
+	var warnings []string
+	for _, warning := range cfg.Warnings() {
+		warnings = append(warnings, warning.Error())
+	}
+	assert.Equal(t, []string{
+		"receivers::nop/2: not used by any pipeline",
+		"exporters::nop/2: not used by any pipeline",
+		"service::pipelines::traces/dead: the data never reaches an exporter, directly or through connectors",
+	}, warnings)
+}
+
 func generateConfig() *Config {
 	return &Config{
 		Receivers: map[component.ID]component.Config{
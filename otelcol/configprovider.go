@@ -7,13 +7,16 @@ import (
 	"context"
 	"fmt"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/confmap/converter/expandconverter"
 	"go.opentelemetry.io/collector/confmap/provider/envprovider"
 	"go.opentelemetry.io/collector/confmap/provider/fileprovider"
+	"go.opentelemetry.io/collector/confmap/provider/globprovider"
 	"go.opentelemetry.io/collector/confmap/provider/httpprovider"
 	"go.opentelemetry.io/collector/confmap/provider/httpsprovider"
 	"go.opentelemetry.io/collector/confmap/provider/yamlprovider"
+	"go.opentelemetry.io/collector/service"
 )
 
 // ConfigProvider provides the service configuration.
@@ -101,16 +104,34 @@ func (cm *configProvider) Get(ctx context.Context, factories Factories) (*Config
 
 	var cfg *configSettings
 	if cfg, err = unmarshal(conf, factories); err != nil {
-		return nil, fmt.Errorf("cannot unmarshal the configuration: %w", err)
+		return nil, fmt.Errorf("cannot unmarshal the configuration: %w", withOrigins(conf, err))
+	}
+
+	var componentFeatureGates service.ComponentFeatureGates
+	for kind, gates := range map[component.Kind]map[component.ID]map[string]bool{
+		component.KindReceiver:  cfg.Receivers.FeatureGates(),
+		component.KindProcessor: cfg.Processors.FeatureGates(),
+		component.KindExporter:  cfg.Exporters.FeatureGates(),
+		component.KindConnector: cfg.Connectors.FeatureGates(),
+		component.KindExtension: cfg.Extensions.FeatureGates(),
+	} {
+		if len(gates) == 0 {
+			continue
+		}
+		if componentFeatureGates == nil {
+			componentFeatureGates = service.ComponentFeatureGates{}
+		}
+		componentFeatureGates[kind] = gates
 	}
 
 	return &Config{
-		Receivers:  cfg.Receivers.Configs(),
-		Processors: cfg.Processors.Configs(),
-		Exporters:  cfg.Exporters.Configs(),
-		Connectors: cfg.Connectors.Configs(),
-		Extensions: cfg.Extensions.Configs(),
-		Service:    cfg.Service,
+		Receivers:             cfg.Receivers.Configs(),
+		Processors:            cfg.Processors.Configs(),
+		Exporters:             cfg.Exporters.Configs(),
+		Connectors:            cfg.Connectors.Configs(),
+		Extensions:            cfg.Extensions.Configs(),
+		ComponentFeatureGates: componentFeatureGates,
+		Service:               cfg.Service,
 	}, nil
 }
 
@@ -135,12 +156,26 @@ func newDefaultConfigProviderSettings(uris []string) ConfigProviderSettings {
 	return ConfigProviderSettings{
 		ResolverSettings: confmap.ResolverSettings{
 			URIs:       uris,
-			Providers:  makeMapProvidersMap(fileprovider.New(), envprovider.New(), yamlprovider.New(), httpprovider.New(), httpsprovider.New()),
+			Providers:  makeMapProvidersMap(fileprovider.New(), globprovider.New(), envprovider.New(), yamlprovider.New(), httpprovider.New(), httpsprovider.New()),
 			Converters: []confmap.Converter{expandconverter.New()},
 		},
 	}
 }
 
+// configProviderSettingsWithDefaults returns a copy of set resolving the given URIs,
+// using the default providers and converters when none are configured.
+func configProviderSettingsWithDefaults(set ConfigProviderSettings, uris []string) ConfigProviderSettings {
+	def := newDefaultConfigProviderSettings(uris)
+	set.ResolverSettings.URIs = uris
+	if len(set.ResolverSettings.Providers) == 0 {
+		set.ResolverSettings.Providers = def.ResolverSettings.Providers
+	}
+	if len(set.ResolverSettings.Converters) == 0 {
+		set.ResolverSettings.Converters = def.ResolverSettings.Converters
+	}
+	return set
+}
+
 func makeMapProvidersMap(providers ...confmap.Provider) map[string]confmap.Provider {
 	ret := make(map[string]confmap.Provider, len(providers))
 	for _, provider := range providers {
@@ -13,9 +13,11 @@ import (
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/confmap/provider/fileprovider"
 	"go.opentelemetry.io/collector/confmap/provider/yamlprovider"
+	"go.opentelemetry.io/collector/service"
 )
 
 func newConfig(yamlBytes []byte, factories Factories) (*Config, error) {
@@ -71,6 +73,35 @@ func TestConfigProviderYaml(t *testing.T) {
 	assert.EqualValues(t, configNop, cfg)
 }
 
+func TestConfigProviderComponentFeatureGates(t *testing.T) {
+	yamlBytes, err := os.ReadFile(filepath.Join("testdata", "otelcol-nop.yaml"))
+	require.NoError(t, err)
+
+	provider := yamlprovider.New()
+	set := ConfigProviderSettings{
+		ResolverSettings: confmap.ResolverSettings{
+			URIs: []string{
+				"yaml:" + string(yamlBytes),
+				"yaml:exporters::nop::feature_gates: {exporter.gate: true}",
+			},
+			Providers: map[string]confmap.Provider{provider.Scheme(): provider},
+		},
+	}
+
+	cp, err := NewConfigProvider(set)
+	require.NoError(t, err)
+
+	factories, err := nopFactories()
+	require.NoError(t, err)
+
+	cfg, err := cp.Get(context.Background(), factories)
+	require.NoError(t, err)
+	assert.Equal(t, service.ComponentFeatureGates{
+		component.KindExporter: {component.NewID("nop"): {"exporter.gate": true}},
+	}, cfg.ComponentFeatureGates)
+	assert.EqualError(t, cfg.Validate(), `exporters::nop::feature_gates: no such feature gate "exporter.gate"`)
+}
+
 func TestConfigProviderFile(t *testing.T) {
 	uriLocation := "file:" + filepath.Join("testdata", "otelcol-nop.yaml")
 	provider := fileprovider.New()
@@ -127,3 +158,24 @@ func TestGetConfmap(t *testing.T) {
 
 	assert.EqualValues(t, yamlMap, cmap.ToStringMap())
 }
+
+func TestConfigProviderSettingsWithDefaults(t *testing.T) {
+	uris := []string{"file:" + filepath.Join("testdata", "otelcol-nop.yaml")}
+	def := newDefaultConfigProviderSettings(uris)
+
+	set := configProviderSettingsWithDefaults(ConfigProviderSettings{}, uris)
+	assert.Equal(t, uris, set.ResolverSettings.URIs)
+	assert.Len(t, set.ResolverSettings.Providers, len(def.ResolverSettings.Providers))
+	assert.Len(t, set.ResolverSettings.Converters, len(def.ResolverSettings.Converters))
+
+	provider := yamlprovider.New()
+	set = configProviderSettingsWithDefaults(ConfigProviderSettings{
+		ResolverSettings: confmap.ResolverSettings{
+			URIs:      []string{"ignored"},
+			Providers: map[string]confmap.Provider{provider.Scheme(): provider},
+		},
+	}, uris)
+	assert.Equal(t, uris, set.ResolverSettings.URIs)
+	assert.Equal(t, map[string]confmap.Provider{provider.Scheme(): provider}, set.ResolverSettings.Providers)
+	assert.Len(t, set.ResolverSettings.Converters, len(def.ResolverSettings.Converters))
+}
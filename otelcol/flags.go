@@ -14,6 +14,7 @@ import (
 const (
 	configFlag       = "config"
 	featureGatesFlag = "feature-gates"
+	strictFlag       = "strict"
 )
 
 type configFlagValue struct {
@@ -50,6 +51,9 @@ func flags(reg *featuregate.Registry) *flag.FlagSet {
 			return nil
 		})
 
+	flagSet.Bool(strictFlag, false,
+		"Fail when the configuration has warnings, e.g. components not used by any pipeline, instead of logging them.")
+
 	flagSet.Var(featuregate.NewFlag(reg), featureGatesFlag,
 		"Comma-delimited list of feature gate identifiers. Prefix with '-' to disable the feature. '+' or no prefix will enable the feature.")
 
@@ -60,3 +64,7 @@ func getConfigFlag(flagSet *flag.FlagSet) []string {
 	cfv := flagSet.Lookup(configFlag).Value.(*configFlagValue)
 	return append(cfv.values, cfv.sets...)
 }
+
+func getStrictFlag(flagSet *flag.FlagSet) bool {
+	return flagSet.Lookup(strictFlag).Value.(flag.Getter).Get().(bool)
+}
@@ -0,0 +1,405 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package configschema generates the JSON Schema of component configurations,
+// following the mapstructure tags used to unmarshal them.
+package configschema // import "go.opentelemetry.io/collector/otelcol/internal/configschema"
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/featuregate"
+)
+
+// Version is the JSON Schema dialect of the generated schemas.
+const Version = "https://json-schema.org/draft/2020-12/schema"
+
+// expansionRef references the definition of the "${...}" values, which are
+// accepted for all the scalar values since they are expanded when the
+// configuration is resolved.
+const expansionRef = "#/$defs/expansion"
+
+// featureGatesDef is the definition of the feature gates which can be
+// overridden for a single component, see AddFeatureGates.
+const (
+	featureGatesDef = "featureGates"
+	featureGatesRef = "#/$defs/" + featureGatesDef
+)
+
+// featureGatesKey is the key reserved in the configuration of all the
+// components for the feature gates they override.
+const featureGatesKey = "feature_gates"
+
+const (
+	typeObject  = "object"
+	typeArray   = "array"
+	typeString  = "string"
+	typeBoolean = "boolean"
+	typeInteger = "integer"
+	typeNumber  = "number"
+	typeNull    = "null"
+)
+
+var (
+	durationType         = reflect.TypeOf(time.Duration(0))
+	textUnmarshalerType  = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	textMarshalerType    = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	confmapUnmarshalType = reflect.TypeOf((*confmap.Unmarshaler)(nil)).Elem()
+)
+
+// Schema is a JSON Schema, or one of its subschemas.
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Type                 any                `json:"type,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	PatternProperties    map[string]*Schema `json:"patternProperties,omitempty"`
+	AdditionalProperties any                `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	Default              any                `json:"default,omitempty"`
+	Defs                 map[string]*Schema `json:"$defs,omitempty"`
+}
+
+// NewDocument returns a root schema, with the definitions the schemas
+// returned by FromConfig refer to.
+func NewDocument(title string) *Schema {
+	return &Schema{
+		Schema: Version,
+		Title:  title,
+		Type:   typeObject,
+		Defs: map[string]*Schema{
+			"expansion": {
+				Description: "A value expanded when the configuration is resolved, e.g. ${env:NAME}.",
+				Type:        typeString,
+				Pattern:     `^\$\{.+\}$`,
+			},
+		},
+	}
+}
+
+// AddFeatureGates adds the feature_gates key, reserved in the configuration of
+// all the components, to the schema s of a component configuration. The gates
+// of the registry are defined once in the document doc.
+func AddFeatureGates(doc *Schema, s *Schema, reg *featuregate.Registry) {
+	if _, ok := doc.Defs[featureGatesDef]; !ok {
+		gates := &Schema{
+			Description:          "The feature gates overridden for the component.",
+			Type:                 []string{typeObject, typeNull},
+			Properties:           map[string]*Schema{},
+			AdditionalProperties: false,
+		}
+		reg.VisitAll(func(g *featuregate.Gate) {
+			gate := scalar(typeBoolean)
+			gate.Description = g.Description()
+			gates.Properties[g.ID()] = gate
+		})
+		if len(gates.Properties) == 0 {
+			gates.Properties = nil
+		}
+		if doc.Defs == nil {
+			doc.Defs = map[string]*Schema{}
+		}
+		doc.Defs[featureGatesDef] = gates
+	}
+
+	// Only the configurations decoded from a map can have the key.
+	types, _ := s.Type.([]string)
+	if !containsString(types, typeObject) && s.Type != typeObject {
+		return
+	}
+	if s.Properties == nil {
+		s.Properties = map[string]*Schema{}
+	}
+	s.Properties[featureGatesKey] = &Schema{Ref: featureGatesRef}
+}
+
+// FromConfig returns the schema of the given configuration, usually the
+// default configuration of a component. The non-zero values of cfg are
+// used as the defaults of the schema.
+//
+// The schema can only be used in a document returned by NewDocument.
+func FromConfig(cfg any) *Schema {
+	g := generator{visiting: map[reflect.Type]bool{}}
+	v := reflect.ValueOf(cfg)
+	if !v.IsValid() {
+		return &Schema{Type: []string{typeObject, typeNull}}
+	}
+	return g.schema(v, true)
+}
+
+type generator struct {
+	// visiting holds the struct types being walked, to stop on recursive types.
+	visiting map[reflect.Type]bool
+}
+
+// schema returns the schema of v. The value of v is used for defaults only if withDefault is true.
+func (g generator) schema(v reflect.Value, withDefault bool) *Schema {
+	t := v.Type()
+	if t.Kind() == reflect.Pointer {
+		elem := reflect.New(t.Elem()).Elem()
+		if !v.IsNil() {
+			elem = v.Elem()
+		}
+		s := g.schema(elem, withDefault && !v.IsNil())
+		return nullable(s)
+	}
+
+	switch {
+	case t == durationType:
+		// Durations are either a string parsed by time.ParseDuration, or a number of nanoseconds.
+		s := &Schema{Type: []string{typeString, typeInteger}}
+		if withDefault && !v.IsZero() {
+			s.Default = v.Interface().(time.Duration).String()
+		}
+		return s
+	case t.Kind() != reflect.String && reflect.PointerTo(t).Implements(textUnmarshalerType):
+		s := &Schema{Type: typeString}
+		// The zero value may be meaningful, e.g. the "info" zapcore.Level.
+		if text, ok := defaultValue(v).(string); withDefault && ok && text != "" {
+			s.Default = text
+		}
+		return s
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		return g.structSchema(v, withDefault)
+	case reflect.Map:
+		s := &Schema{Type: []string{typeObject, typeNull}}
+		s.AdditionalProperties = g.schema(reflect.New(t.Elem()).Elem(), false)
+		if withDefault && v.Len() > 0 {
+			s.Default = defaultValue(v)
+		}
+		return s
+	case reflect.Slice, reflect.Array:
+		s := &Schema{Type: []string{typeArray, typeNull}}
+		s.Items = g.schema(reflect.New(t.Elem()).Elem(), false)
+		if t.Elem().Kind() == reflect.String {
+			// Strings are split on commas when decoded as a list of strings.
+			s.Type = []string{typeArray, typeString, typeNull}
+		}
+		if withDefault && v.Len() > 0 {
+			s.Default = defaultValue(v)
+		}
+		return s
+	case reflect.Interface:
+		return &Schema{}
+	}
+
+	var s *Schema
+	switch t.Kind() {
+	case reflect.String:
+		s = &Schema{Type: typeString}
+	case reflect.Bool:
+		s = scalar(typeBoolean)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		s = scalar(typeInteger)
+	case reflect.Float32, reflect.Float64:
+		s = scalar(typeNumber)
+	default:
+		// Channels, functions, etc. cannot be configured.
+		return &Schema{}
+	}
+	if withDefault && !v.IsZero() {
+		s.Default = defaultValue(v)
+	}
+	return s
+}
+
+func (g generator) structSchema(v reflect.Value, withDefault bool) *Schema {
+	t := v.Type()
+	// A null value leaves the struct unchanged, e.g. with its default values.
+	s := &Schema{Type: []string{typeObject, typeNull}, Properties: map[string]*Schema{}}
+	if g.visiting[t] {
+		return &Schema{Type: s.Type}
+	}
+	g.visiting[t] = true
+	defer delete(g.visiting, t)
+
+	// The configuration is decoded with unused keys being errors, unless the
+	// struct decodes itself or some of its fields gather the remaining keys.
+	strict := !reflect.PointerTo(t).Implements(confmapUnmarshalType)
+	g.addFields(s, v, withDefault, &strict)
+	if strict {
+		s.AdditionalProperties = false
+	}
+	if len(s.Properties) == 0 {
+		s.Properties = nil
+	}
+	return s
+}
+
+// addFields adds the fields of the struct v to the properties of s, including
+// the fields of the squashed structs.
+func (g generator) addFields(s *Schema, v reflect.Value, withDefault bool, strict *bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, opts, _ := strings.Cut(field.Tag.Get("mapstructure"), ",")
+		if name == "-" || (!field.IsExported() && !field.Anonymous) {
+			continue
+		}
+		fv := v.Field(i)
+		switch {
+		case hasOption(opts, "squash"):
+			if fv.Kind() == reflect.Pointer {
+				if fv.IsNil() {
+					fv = reflect.New(fv.Type().Elem())
+				}
+				fv = fv.Elem()
+			}
+			if fv.Kind() == reflect.Struct {
+				g.addFields(s, fv, withDefault, strict)
+			}
+			continue
+		case hasOption(opts, "remain"):
+			*strict = false
+			continue
+		case !field.IsExported():
+			continue
+		case !configurable(field.Type):
+			continue
+		case name == "":
+			// As when the configuration is marshaled, see getTagInfo in the mapstructure encoder of confmap.
+			name = strings.ToLower(field.Name)
+		}
+		s.Properties[name] = g.schema(fv, withDefault)
+	}
+}
+
+// configurable reports whether a field of type t can be set in the configuration,
+// unlike functions and channels, e.g. hooks set by the components themselves,
+// or structs with only unexported fields, e.g. sync.Mutex.
+func configurable(t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if reflect.PointerTo(t).Implements(textUnmarshalerType) || reflect.PointerTo(t).Implements(confmapUnmarshalType) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return false
+	case reflect.Struct:
+		if t.NumField() == 0 {
+			return true
+		}
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).IsExported() || t.Field(i).Anonymous {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func hasOption(opts string, opt string) bool {
+	for _, o := range strings.Split(opts, ",") {
+		if o == opt {
+			return true
+		}
+	}
+	return false
+}
+
+// scalar returns the schema of a scalar value of the given type, which may
+// also be set with a value expanded when resolving the configuration.
+func scalar(typ string) *Schema {
+	return &Schema{AnyOf: []*Schema{{Type: typ}, {Ref: expansionRef}}}
+}
+
+// nullable allows s to be null, as the pointers are set to their
+// zero value when their key has no value.
+func nullable(s *Schema) *Schema {
+	switch typ := s.Type.(type) {
+	case string:
+		s.Type = []string{typ, typeNull}
+	case []string:
+		if !containsString(typ, typeNull) {
+			s.Type = append(typ, typeNull)
+		}
+	case nil:
+		if len(s.AnyOf) > 0 {
+			s.AnyOf = append(s.AnyOf, &Schema{Type: typeNull})
+		}
+	}
+	return s
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultValue returns v as it would be written in the configuration.
+func defaultValue(v reflect.Value) any {
+	if v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		return defaultValue(v.Elem())
+	}
+
+	t := v.Type()
+	switch {
+	case t == durationType:
+		return v.Interface().(time.Duration).String()
+	case t.Kind() == reflect.String:
+		// Do not use the text marshaler of the strings, which redacts the
+		// sensitive values of configopaque.String.
+		return v.String()
+	case t.Implements(textMarshalerType):
+		if text, err := v.Interface().(encoding.TextMarshaler).MarshalText(); err == nil {
+			return string(text)
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint()
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.Slice, reflect.Array:
+		values := make([]any, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			values = append(values, defaultValue(v.Index(i)))
+		}
+		return values
+	case reflect.Map:
+		values := make(map[string]any, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			values[fmt.Sprint(defaultValue(iter.Key()))] = defaultValue(iter.Value())
+		}
+		return values
+	case reflect.Struct:
+		values := map[string]any{}
+		s := generator{visiting: map[reflect.Type]bool{}}.schema(v, true)
+		for name, prop := range s.Properties {
+			if prop.Default != nil {
+				values[name] = prop.Default
+			}
+		}
+		return values
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package configschema
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/featuregate"
+)
+
+// opaque is a sensitive string, redacted when marshaled like opaque.
+type opaque string
+
+func (o opaque) MarshalText() ([]byte, error) {
+	return []byte("[REDACTED]"), nil
+}
+
+type squashed struct {
+	Endpoint string `mapstructure:"endpoint"`
+}
+
+type nested struct {
+	Enabled bool          `mapstructure:"enabled"`
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+type testConfig struct {
+	squashed   `mapstructure:",squash"`
+	Nested     nested            `mapstructure:"nested"`
+	Optional   *nested           `mapstructure:"optional"`
+	Headers    map[string]string `mapstructure:"headers"`
+	Tags       []string          `mapstructure:"tags"`
+	Ratio      float64           `mapstructure:"ratio"`
+	Size       int               `mapstructure:"size"`
+	Level      zapcore.Level     `mapstructure:"level"`
+	ID         component.ID      `mapstructure:"id"`
+	Secret     opaque            `mapstructure:"secret"`
+	Any        any               `mapstructure:"any"`
+	Recursive  *recursive        `mapstructure:"recursive"`
+	Skipped    string            `mapstructure:"-"`
+	NoTag      string            // marshaled with the lowercase name of the field
+	Callback   func()            `mapstructure:"callback"`
+	Events     chan struct{}     `mapstructure:"events"`
+	Lock       sync.Mutex        `mapstructure:"lock"`
+	unexported string
+}
+
+type recursive struct {
+	Next *recursive `mapstructure:"next"`
+}
+
+type customConfig struct {
+	Value string `mapstructure:"value"`
+}
+
+func (c *customConfig) Unmarshal(conf *confmap.Conf) error {
+	return conf.Unmarshal(c)
+}
+
+type remainConfig struct {
+	Value string         `mapstructure:"value"`
+	Rest  map[string]any `mapstructure:",remain"`
+}
+
+func TestFromConfig(t *testing.T) {
+	cfg := &testConfig{
+		squashed: squashed{Endpoint: "localhost:4317"},
+		Nested:   nested{Enabled: true, Timeout: 5 * time.Second},
+		Headers:  map[string]string{"key": "value"},
+		Tags:     []string{"a", "b"},
+		Ratio:    0.5,
+		Size:     10,
+		Level:    zapcore.InfoLevel,
+		ID:       component.NewIDWithName("otlp", "name"),
+		Secret:   "secret",
+	}
+	s := FromConfig(cfg)
+
+	assert.Equal(t, []string{"object", "null"}, s.Type)
+	assert.Equal(t, false, s.AdditionalProperties)
+	assert.ElementsMatch(t, []string{
+		"endpoint", "nested", "optional", "headers", "tags", "ratio", "size", "level", "id", "secret", "any", "recursive", "notag",
+	}, keys(s.Properties))
+
+	assert.Equal(t, &Schema{Type: "string", Default: "localhost:4317"}, s.Properties["endpoint"])
+	assert.Equal(t, &Schema{
+		Type: []string{"object", "null"},
+		Properties: map[string]*Schema{
+			"enabled": {AnyOf: []*Schema{{Type: "boolean"}, {Ref: expansionRef}}, Default: true},
+			"timeout": {Type: []string{"string", "integer"}, Default: "5s"},
+		},
+		AdditionalProperties: false,
+	}, s.Properties["nested"])
+	assert.Equal(t, &Schema{
+		Type: []string{"object", "null"},
+		Properties: map[string]*Schema{
+			"enabled": {AnyOf: []*Schema{{Type: "boolean"}, {Ref: expansionRef}}},
+			"timeout": {Type: []string{"string", "integer"}},
+		},
+		AdditionalProperties: false,
+	}, s.Properties["optional"])
+	assert.Equal(t, &Schema{
+		Type:                 []string{"object", "null"},
+		AdditionalProperties: &Schema{Type: "string"},
+		Default:              map[string]any{"key": "value"},
+	}, s.Properties["headers"])
+	assert.Equal(t, &Schema{
+		Type:    []string{"array", "string", "null"},
+		Items:   &Schema{Type: "string"},
+		Default: []any{"a", "b"},
+	}, s.Properties["tags"])
+	assert.Equal(t, &Schema{AnyOf: []*Schema{{Type: "number"}, {Ref: expansionRef}}, Default: 0.5}, s.Properties["ratio"])
+	assert.Equal(t, &Schema{AnyOf: []*Schema{{Type: "integer"}, {Ref: expansionRef}}, Default: int64(10)}, s.Properties["size"])
+	assert.Equal(t, &Schema{Type: "string", Default: "info"}, s.Properties["level"])
+	assert.Equal(t, &Schema{Type: "string", Default: "otlp/name"}, s.Properties["id"])
+	assert.Equal(t, &Schema{Type: "string", Default: "secret"}, s.Properties["secret"])
+	assert.Equal(t, &Schema{}, s.Properties["any"])
+	assert.Equal(t, &Schema{Type: "string"}, s.Properties["notag"])
+
+	// Recursive types are not walked more than once.
+	assert.Equal(t, &Schema{Type: []string{"object", "null"}}, s.Properties["recursive"].Properties["next"])
+
+	// The schema can be encoded as JSON.
+	_, err := json.Marshal(s)
+	require.NoError(t, err)
+}
+
+func TestFromConfigNotStrict(t *testing.T) {
+	assert.Nil(t, FromConfig(&customConfig{}).AdditionalProperties)
+	assert.Nil(t, FromConfig(&remainConfig{}).AdditionalProperties)
+	assert.Equal(t, false, FromConfig(&squashed{}).AdditionalProperties)
+}
+
+func TestFromConfigNil(t *testing.T) {
+	assert.Equal(t, &Schema{Type: []string{"object", "null"}}, FromConfig(nil))
+}
+
+func TestNewDocument(t *testing.T) {
+	doc := NewDocument("title")
+	assert.Equal(t, Version, doc.Schema)
+	assert.Equal(t, "title", doc.Title)
+	require.Contains(t, doc.Defs, "expansion")
+	assert.Equal(t, "#/$defs/expansion", expansionRef)
+}
+
+func TestAddFeatureGates(t *testing.T) {
+	reg := featuregate.NewRegistry()
+	reg.MustRegister("alpha", featuregate.StageAlpha, featuregate.WithRegisterDescription("An alpha gate"))
+	doc := NewDocument("title")
+
+	s := FromConfig(&testConfig{})
+	AddFeatureGates(doc, s, reg)
+	assert.Equal(t, &Schema{Ref: featureGatesRef}, s.Properties["feature_gates"])
+	assert.Equal(t, false, s.AdditionalProperties)
+	assert.Equal(t, &Schema{
+		Description: "The feature gates overridden for the component.",
+		Type:        []string{"object", "null"},
+		Properties: map[string]*Schema{
+			"alpha": {Description: "An alpha gate", AnyOf: []*Schema{{Type: "boolean"}, {Ref: expansionRef}}},
+		},
+		AdditionalProperties: false,
+	}, doc.Defs["featureGates"])
+
+	// The configurations which are not decoded from a map can not have the key.
+	s = FromConfig(time.Duration(0))
+	AddFeatureGates(doc, s, reg)
+	assert.Nil(t, s.Properties)
+
+	// A struct without settings gets the key.
+	s = FromConfig(&struct{}{})
+	AddFeatureGates(doc, s, reg)
+	assert.Equal(t, []string{"feature_gates"}, keys(s.Properties))
+}
+
+func keys(m map[string]*Schema) []string {
+	ret := make([]string, 0, len(m))
+	for k := range m {
+		ret = append(ret, k)
+	}
+	return ret
+}
@@ -6,13 +6,19 @@ package configunmarshaler // import "go.opentelemetry.io/collector/otelcol/inter
 import (
 	"fmt"
 	"reflect"
+	"strings"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap"
 )
 
+// featureGatesKey is the key of the feature gates overridden for a single component, in its configuration.
+// The key is reserved: it is not passed to the component, and can not be one of its own settings.
+const featureGatesKey = "feature_gates"
+
 type Configs[F component.Factory] struct {
-	cfgs map[component.ID]component.Config
+	cfgs         map[component.ID]component.Config
+	featureGates map[component.ID]map[string]bool
 
 	factories map[component.Type]F
 }
@@ -29,6 +35,7 @@ func (c *Configs[F]) Unmarshal(conf *confmap.Conf) error {
 
 	// Prepare resulting map.
 	c.cfgs = make(map[component.ID]component.Config)
+	c.featureGates = make(map[component.ID]map[string]bool)
 	// Iterate over raw configs and create a config for each.
 	for id, value := range rawCfgs {
 		// Find factory based on component kind and type that we read from config source.
@@ -40,6 +47,24 @@ func (c *Configs[F]) Unmarshal(conf *confmap.Conf) error {
 		// Create the default config for this component.
 		cfg := factory.CreateDefaultConfig()
 
+		// The feature gates are not part of the component's own configuration.
+		if gates, ok := value[featureGatesKey]; ok {
+			if hasSetting(reflect.TypeOf(cfg), featureGatesKey) {
+				return errorUnmarshalError(id, fmt.Errorf("the %q key is reserved for the feature gates of the component, "+
+					"and conflicts with the %q setting of its configuration", featureGatesKey, featureGatesKey))
+			}
+			delete(value, featureGatesKey)
+			overrides := struct {
+				FeatureGates map[string]bool `mapstructure:"feature_gates"`
+			}{}
+			if err := confmap.NewFromStringMap(map[string]any{featureGatesKey: gates}).Unmarshal(&overrides); err != nil {
+				return errorUnmarshalError(id, err)
+			}
+			if len(overrides.FeatureGates) > 0 {
+				c.featureGates[id] = overrides.FeatureGates
+			}
+		}
+
 		// Now that the default config struct is created we can Unmarshal into it,
 		// and it will apply user-defined config on top of the default.
 		if err := component.UnmarshalConfig(confmap.NewFromStringMap(value), cfg); err != nil {
@@ -56,10 +81,52 @@ func (c *Configs[F]) Configs() map[component.ID]component.Config {
 	return c.cfgs
 }
 
+// FeatureGates returns the feature gates overridden by the components, with the feature_gates key
+// of their configuration.
+func (c *Configs[F]) FeatureGates() map[component.ID]map[string]bool {
+	return c.featureGates
+}
+
+// hasSetting returns true if the configuration struct has a setting with the given key, including
+// in its squashed embedded structs.
+func hasSetting(t reflect.Type, key string) bool {
+	if t == nil {
+		return false
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, opts, _ := strings.Cut(field.Tag.Get("mapstructure"), ",")
+		if name == key || (strings.Contains(opts, "squash") && hasSetting(field.Type, key)) {
+			return true
+		}
+	}
+	return false
+}
+
+// ComponentError is an error in the configuration of a component.
+type ComponentError struct {
+	ID  component.ID
+	err error
+}
+
+func (e *ComponentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *ComponentError) Unwrap() error {
+	return e.err
+}
+
 func errorUnknownType(id component.ID, factories []reflect.Value) error {
-	return fmt.Errorf("unknown type: %q for id: %q (valid values: %v)", id.Type(), id, factories)
+	return &ComponentError{ID: id, err: fmt.Errorf("unknown type: %q for id: %q (valid values: %v)", id.Type(), id, factories)}
 }
 
 func errorUnmarshalError(id component.ID, err error) error {
-	return fmt.Errorf("error reading configuration for %q: %w", id, err)
+	return &ComponentError{ID: id, err: fmt.Errorf("error reading configuration for %q: %w", id, err)}
 }
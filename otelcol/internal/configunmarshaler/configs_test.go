@@ -15,6 +15,7 @@ import (
 	"go.opentelemetry.io/collector/exporter/exportertest"
 	"go.opentelemetry.io/collector/extension/extensiontest"
 	"go.opentelemetry.io/collector/processor/processortest"
+	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 )
 
@@ -141,3 +142,70 @@ func TestUnmarshalError(t *testing.T) {
 		})
 	}
 }
+
+func TestUnmarshalComponentError(t *testing.T) {
+	cfgs := NewConfigs(testKinds[0].factories)
+	err := cfgs.Unmarshal(confmap.NewFromStringMap(map[string]any{
+		"nop/my": map[string]any{"unknown_section": "receiver"},
+	}))
+	var cErr *ComponentError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, component.NewIDWithName("nop", "my"), cErr.ID)
+
+	err = cfgs.Unmarshal(confmap.NewFromStringMap(map[string]any{"nosuch": nil}))
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, component.NewID("nosuch"), cErr.ID)
+}
+
+func TestUnmarshalFeatureGates(t *testing.T) {
+	for _, tk := range testKinds {
+		t.Run(tk.kind, func(t *testing.T) {
+			cfgs := NewConfigs(tk.factories)
+			conf := confmap.NewFromStringMap(map[string]any{
+				"nop": nil,
+				"nop/canary": map[string]any{
+					"feature_gates": map[string]any{"component.gate": true, "other.gate": "false"},
+				},
+			})
+			require.NoError(t, cfgs.Unmarshal(conf))
+
+			assert.Equal(t, tk.factories["nop"].CreateDefaultConfig(), cfgs.Configs()[component.NewIDWithName("nop", "canary")])
+			assert.Equal(t, map[component.ID]map[string]bool{
+				component.NewIDWithName("nop", "canary"): {"component.gate": true, "other.gate": false},
+			}, cfgs.FeatureGates())
+
+			err := cfgs.Unmarshal(confmap.NewFromStringMap(map[string]any{
+				"nop": map[string]any{"feature_gates": []any{"component.gate"}},
+			}))
+			var cErr *ComponentError
+			require.ErrorAs(t, err, &cErr)
+			assert.Equal(t, component.NewID("nop"), cErr.ID)
+		})
+	}
+}
+
+type featureGatesConfig struct {
+	FeatureGatesSettings `mapstructure:",squash"`
+}
+
+type FeatureGatesSettings struct {
+	FeatureGates []string `mapstructure:"feature_gates"`
+}
+
+func TestUnmarshalFeatureGatesReservedKey(t *testing.T) {
+	factory := receiver.NewFactory("gated", func() component.Config { return &featureGatesConfig{} })
+	cfgs := NewConfigs(map[component.Type]receiver.Factory{"gated": factory})
+
+	// The component's own setting can not be configured, since the key is reserved.
+	err := cfgs.Unmarshal(confmap.NewFromStringMap(map[string]any{
+		"gated": map[string]any{"feature_gates": map[string]any{"component.gate": true}},
+	}))
+	var cErr *ComponentError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, component.NewID("gated"), cErr.ID)
+	assert.EqualError(t, err, `error reading configuration for "gated": the "feature_gates" key is reserved for the feature gates `+
+		`of the component, and conflicts with the "feature_gates" setting of its configuration`)
+
+	// The component can still be configured without the key.
+	require.NoError(t, cfgs.Unmarshal(confmap.NewFromStringMap(map[string]any{"gated": nil})))
+}
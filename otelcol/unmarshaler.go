@@ -4,6 +4,9 @@
 package otelcol // import "go.opentelemetry.io/collector/otelcol"
 
 import (
+	"errors"
+	"fmt"
+
 	"go.uber.org/zap/zapcore"
 
 	"go.opentelemetry.io/collector/config/configtelemetry"
@@ -37,30 +40,78 @@ func unmarshal(v *confmap.Conf, factories Factories) (*configSettings, error) {
 		Exporters:  configunmarshaler.NewConfigs(factories.Exporters),
 		Connectors: configunmarshaler.NewConfigs(factories.Connectors),
 		Extensions: configunmarshaler.NewConfigs(factories.Extensions),
-		// TODO: Add a component.ServiceFactory to allow this to be defined by the Service.
-		Service: service.Config{
-			Telemetry: telemetry.Config{
-				Logs: telemetry.LogsConfig{
-					Level:       zapcore.InfoLevel,
-					Development: false,
-					Encoding:    "console",
-					Sampling: &telemetry.LogsSamplingConfig{
-						Initial:    100,
-						Thereafter: 100,
-					},
-					OutputPaths:       []string{"stderr"},
-					ErrorOutputPaths:  []string{"stderr"},
-					DisableCaller:     false,
-					DisableStacktrace: false,
-					InitialFields:     map[string]any(nil),
-				},
-				Metrics: telemetry.MetricsConfig{
-					Level:   configtelemetry.LevelBasic,
-					Address: ":8888",
+		Service:    defaultServiceConfig(),
+	}
+
+	// Check that there are no unknown sections, the sections are unmarshaled one by one
+	// below, so that the errors return the key of the invalid value.
+	var sections struct {
+		Receivers  any `mapstructure:"receivers"`
+		Processors any `mapstructure:"processors"`
+		Exporters  any `mapstructure:"exporters"`
+		Connectors any `mapstructure:"connectors"`
+		Extensions any `mapstructure:"extensions"`
+		Service    any `mapstructure:"service"`
+	}
+	if err := v.Unmarshal(&sections, confmap.WithErrorUnused()); err != nil {
+		return cfg, err
+	}
+
+	for _, section := range []struct {
+		key       string
+		unmarshal func(*confmap.Conf) error
+	}{
+		{key: "receivers", unmarshal: cfg.Receivers.Unmarshal},
+		{key: "processors", unmarshal: cfg.Processors.Unmarshal},
+		{key: "exporters", unmarshal: cfg.Exporters.Unmarshal},
+		{key: "connectors", unmarshal: cfg.Connectors.Unmarshal},
+		{key: "extensions", unmarshal: cfg.Extensions.Unmarshal},
+		{key: "service", unmarshal: func(conf *confmap.Conf) error {
+			return conf.Unmarshal(&cfg.Service, confmap.WithErrorUnused())
+		}},
+	} {
+		if v.Get(section.key) == nil {
+			continue
+		}
+		sub, err := v.Sub(section.key)
+		if err == nil {
+			err = section.unmarshal(sub)
+		}
+		if err != nil {
+			key := section.key
+			var cErr *configunmarshaler.ComponentError
+			if errors.As(err, &cErr) {
+				key += confmap.KeyDelimiter + cErr.ID.String()
+			}
+			return cfg, &keyError{key: key, err: fmt.Errorf("error decoding '%s': %w", section.key, err)}
+		}
+	}
+	return cfg, nil
+}
+
+// defaultServiceConfig returns the default configuration of the service.
+func defaultServiceConfig() service.Config {
+	// TODO: Add a component.ServiceFactory to allow this to be defined by the Service.
+	return service.Config{
+		Telemetry: telemetry.Config{
+			Logs: telemetry.LogsConfig{
+				Level:       zapcore.InfoLevel,
+				Development: false,
+				Encoding:    "console",
+				Sampling: &telemetry.LogsSamplingConfig{
+					Initial:    100,
+					Thereafter: 100,
 				},
+				OutputPaths:       []string{"stderr"},
+				ErrorOutputPaths:  []string{"stderr"},
+				DisableCaller:     false,
+				DisableStacktrace: false,
+				InitialFields:     map[string]any(nil),
+			},
+			Metrics: telemetry.MetricsConfig{
+				Level:   configtelemetry.LevelBasic,
+				Address: ":8888",
 			},
 		},
 	}
-
-	return cfg, v.Unmarshal(&cfg, confmap.WithErrorUnused())
 }
@@ -68,6 +68,40 @@ func TestUnmarshalUnknownTopLevel(t *testing.T) {
 	assert.Contains(t, err.Error(), "'' has invalid keys: unknown_section")
 }
 
+func TestUnmarshalKeyError(t *testing.T) {
+	factories, err := nopFactories()
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name string
+		conf map[string]any
+		key  string
+	}{
+		{
+			name: "invalid-component",
+			conf: map[string]any{"receivers": map[string]any{"nop/1": map[string]any{"unknown": "value"}}},
+			key:  "receivers::nop/1",
+		},
+		{
+			name: "invalid-section",
+			conf: map[string]any{"exporters": "string"},
+			key:  "exporters",
+		},
+		{
+			name: "invalid-service",
+			conf: map[string]any{"service": map[string]any{"telemetry": map[string]any{"logs": map[string]any{"level": "UNKNOWN"}}}},
+			key:  "service",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := unmarshal(confmap.NewFromStringMap(tt.conf), factories)
+			var kErr *keyError
+			require.ErrorAs(t, err, &kErr)
+			assert.Equal(t, tt.key, kErr.key)
+		})
+	}
+}
+
 func TestPipelineConfigUnmarshalError(t *testing.T) {
 	var testCases = []struct {
 		// test case name (also file name containing config yaml)
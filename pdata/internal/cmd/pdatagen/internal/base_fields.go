@@ -231,6 +231,8 @@ type baseField interface {
 	GenerateSetWithTestValue(ms baseStruct) string
 
 	GenerateCopyToValue(ms baseStruct) string
+
+	GenerateMarshalJSON(ms baseStruct) string
 }
 
 type sliceField struct {
@@ -269,6 +271,21 @@ func (sf *sliceField) GenerateCopyToValue(_ baseStruct) string {
 	return "\tms." + sf.fieldName + "().CopyTo(dest." + sf.fieldName + "())"
 }
 
+func (sf *sliceField) GenerateMarshalJSON(ms baseStruct) string {
+	origField := "ms." + origAccessor(ms) + "." + sf.fieldName
+	if sf.returnSlice == mapStruct {
+		return "\tjson.WriteAttributes(dest, \"" + jsonFieldName(sf.fieldName) + "\", " + origField + ")"
+	}
+	var newElement string
+	switch rs := sf.returnSlice.(type) {
+	case *sliceOfPtrs:
+		newElement = "new" + rs.element.structName + "(" + origField + "[i])"
+	case *sliceOfValues:
+		newElement = "new" + rs.element.structName + "(&" + origField + "[i])"
+	}
+	return jsonWriteArray(sf.fieldName, origField, newElement+".marshalJsoniter(dest)")
+}
+
 func (sf *sliceField) templateFields(ms baseStruct) map[string]any {
 	return map[string]any{
 		"structName": ms.getName(),
@@ -324,6 +341,22 @@ func (mf *messageValueField) GenerateCopyToValue(_ baseStruct) string {
 	return "\tms." + mf.fieldName + "().CopyTo(dest." + mf.fieldName + "())"
 }
 
+func (mf *messageValueField) GenerateMarshalJSON(ms baseStruct) string {
+	origField := "ms." + origAccessor(ms) + "." + mf.fieldName
+	if mf.returnMessage == traceState {
+		// The trace state is stored as a string in the origin struct.
+		return jsonWriteField(mf.fieldName, "string", origField)
+	}
+	sb := &strings.Builder{}
+	sb.WriteString("\tjson.WriteObjectField(dest, \"" + jsonFieldName(mf.fieldName) + "\")\n")
+	if writer, ok := commonJSONWriters[mf.returnMessage.getName()]; ok {
+		sb.WriteString("\t" + writer + "(dest, &" + origField + ")")
+	} else {
+		sb.WriteString("\tnew" + mf.returnMessage.getName() + "(&" + origField + ").marshalJsoniter(dest)")
+	}
+	return sb.String()
+}
+
 func (mf *messageValueField) templateFields(ms baseStruct) map[string]any {
 	return map[string]any{
 		"isCommon":       usedByOtherDataTypes(mf.returnMessage.getPackageName()),
@@ -376,6 +409,10 @@ func (pf *primitiveField) GenerateCopyToValue(_ baseStruct) string {
 	return "\tdest.Set" + pf.fieldName + "(ms." + pf.fieldName + "())"
 }
 
+func (pf *primitiveField) GenerateMarshalJSON(ms baseStruct) string {
+	return jsonWriteField(pf.fieldName, pf.returnType, "ms."+origAccessor(ms)+"."+pf.fieldName)
+}
+
 func (pf *primitiveField) templateFields(ms baseStruct) map[string]any {
 	return map[string]any{
 		"structName":     ms.getName(),
@@ -436,6 +473,14 @@ func (ptf *primitiveTypedField) GenerateCopyToValue(_ baseStruct) string {
 	return "\tdest.Set" + ptf.fieldName + "(ms." + ptf.fieldName + "())"
 }
 
+func (ptf *primitiveTypedField) GenerateMarshalJSON(_ baseStruct) string {
+	originFieldName := ptf.fieldName
+	if ptf.originFieldName != "" {
+		originFieldName = ptf.originFieldName
+	}
+	return jsonWriteField(originFieldName, ptf.returnType.rawType, "ms.orig."+originFieldName)
+}
+
 func (ptf *primitiveTypedField) templateFields(ms baseStruct) map[string]any {
 	return map[string]any{
 		"structName": ms.getName(),
@@ -498,6 +543,11 @@ func (psf *primitiveSliceField) GenerateCopyToValue(_ baseStruct) string {
 	return "\tms." + psf.fieldName + "().CopyTo(dest." + psf.fieldName + "())"
 }
 
+func (psf *primitiveSliceField) GenerateMarshalJSON(ms baseStruct) string {
+	origField := "ms." + origAccessor(ms) + "." + psf.fieldName
+	return jsonWriteArray(psf.fieldName, origField, jsonWriteValue(strings.TrimPrefix(psf.rawType, "[]"), origField+"[i]"))
+}
+
 func (psf *primitiveSliceField) templateFields(ms baseStruct) map[string]any {
 	return map[string]any{
 		"structName": ms.getName(),
@@ -567,6 +617,16 @@ func (of *oneOfField) GenerateCopyToValue(ms baseStruct) string {
 	return sb.String()
 }
 
+func (of *oneOfField) GenerateMarshalJSON(ms baseStruct) string {
+	sb := &strings.Builder{}
+	sb.WriteString("\tswitch ov := ms." + origAccessor(ms) + "." + of.originFieldName + ".(type) {\n")
+	for _, v := range of.values {
+		sb.WriteString(v.GenerateMarshalJSON(of) + "\n")
+	}
+	sb.WriteString("\t}")
+	return sb.String()
+}
+
 func (of *oneOfField) templateFields(ms baseStruct) map[string]any {
 	return map[string]any{
 		"baseStruct":           ms,
@@ -590,6 +650,7 @@ type oneOfValue interface {
 	GenerateSetWithTestValue(of *oneOfField) string
 	GenerateCopyToValue(ms baseStruct, of *oneOfField, sb *bytes.Buffer)
 	GenerateTypeSwitchCase(of *oneOfField) string
+	GenerateMarshalJSON(of *oneOfField) string
 }
 
 type oneOfPrimitiveValue struct {
@@ -641,6 +702,12 @@ func (opv *oneOfPrimitiveValue) GenerateTypeSwitchCase(of *oneOfField) string {
 		"\t\treturn " + of.typeName + opv.fieldName
 }
 
+func (opv *oneOfPrimitiveValue) GenerateMarshalJSON(of *oneOfField) string {
+	return "\tcase *" + of.originTypePrefix + opv.originFieldName + ":\n" +
+		"\t\tjson.WriteObjectField(dest, \"" + jsonFieldName(opv.originFieldName) + "\")\n" +
+		"\t\t" + jsonWriteValue(opv.returnType, "ov."+opv.originFieldName)
+}
+
 func (opv *oneOfPrimitiveValue) templateFields(ms baseStruct, of *oneOfField) map[string]any {
 	return map[string]any{
 		"structName":              ms.getName(),
@@ -704,6 +771,16 @@ func (omv *oneOfMessageValue) GenerateTypeSwitchCase(of *oneOfField) string {
 		"\t\treturn " + of.typeName + omv.fieldName
 }
 
+func (omv *oneOfMessageValue) GenerateMarshalJSON(of *oneOfField) string {
+	return "\tcase *" + of.originTypePrefix + omv.fieldName + ":\n" +
+		"\t\tjson.WriteObjectField(dest, \"" + jsonFieldName(omv.fieldName) + "\")\n" +
+		"\t\tif ov." + omv.fieldName + " == nil {\n" +
+		"\t\t\tdest.WriteNil()\n" +
+		"\t\t\tbreak\n" +
+		"\t\t}\n" +
+		"\t\tnew" + omv.returnMessage.structName + "(ov." + omv.fieldName + ").marshalJsoniter(dest)"
+}
+
 func (omv *oneOfMessageValue) templateFields(ms baseStruct, of *oneOfField) map[string]any {
 	return map[string]any{
 		"fieldName":               omv.fieldName,
@@ -757,6 +834,13 @@ func (opv *optionalPrimitiveValue) GenerateCopyToValue(_ baseStruct) string {
 		"}\n"
 }
 
+func (opv *optionalPrimitiveValue) GenerateMarshalJSON(_ baseStruct) string {
+	return "\tif ov, ok := ms.orig." + opv.fieldName + "_.(*" + opv.originTypePrefix + opv.fieldName + "); ok {\n" +
+		"\t\tjson.WriteObjectField(dest, \"" + jsonFieldName(opv.fieldName) + "\")\n" +
+		"\t\t" + jsonWriteValue(opv.returnType, "ov."+opv.fieldName) + "\n" +
+		"\t}"
+}
+
 func (opv *optionalPrimitiveValue) templateFields(ms baseStruct) map[string]any {
 	return map[string]any{
 		"structName":       ms.getName(),
@@ -778,3 +862,71 @@ func origAccessor(bs baseStruct) string {
 	}
 	return "orig"
 }
+
+// commonJSONWriters are the functions of the internal json package writing the messages of the pcommon package.
+var commonJSONWriters = map[string]string{
+	"Resource":             "json.WriteResource",
+	"InstrumentationScope": "json.WriteScope",
+	"Value":                "json.WriteValue",
+}
+
+// jsonFieldName returns the name of an origin field in the OTLP JSON encoding.
+func jsonFieldName(originFieldName string) string {
+	return strings.ToLower(originFieldName[:1]) + originFieldName[1:]
+}
+
+// jsonWriteField returns the code writing an origin field of a primitive type, omitted if it has the zero value
+// like the proto JSON encoding does. The trace and span IDs are always written.
+func jsonWriteField(originFieldName, rawType, origField string) string {
+	write := "json.WriteObjectField(dest, \"" + jsonFieldName(originFieldName) + "\")\n" +
+		"\t\t" + jsonWriteValue(rawType, origField)
+	switch rawType {
+	case "data.TraceID", "data.SpanID":
+		return "\t" + write
+	case "string":
+		return "\tif " + origField + " != \"\" {\n\t\t" + write + "\n\t}"
+	case "bool":
+		return "\tif " + origField + " {\n\t\t" + write + "\n\t}"
+	}
+	return "\tif " + origField + " != 0 {\n\t\t" + write + "\n\t}"
+}
+
+// jsonWriteArray returns the code writing a slice field as a JSON array, omitted if it is nil.
+func jsonWriteArray(originFieldName, origField, writeElement string) string {
+	return "\tif " + origField + " != nil {\n" +
+		"\t\tjson.WriteObjectField(dest, \"" + jsonFieldName(originFieldName) + "\")\n" +
+		"\t\tdest.WriteArrayStart()\n" +
+		"\t\tfor i := range " + origField + " {\n" +
+		"\t\t\tjson.WriteArrayElement(dest, i)\n" +
+		"\t\t\t" + writeElement + "\n" +
+		"\t\t}\n" +
+		"\t\tdest.WriteArrayEnd()\n" +
+		"\t}"
+}
+
+// jsonWriteValue returns the code writing a value of a primitive type in the OTLP JSON encoding:
+// the 64-bit integers are written as strings and the enums as integers.
+func jsonWriteValue(rawType, value string) string {
+	switch rawType {
+	case "string":
+		return "json.WriteString(dest, " + value + ")"
+	case "bool":
+		return "dest.WriteBool(" + value + ")"
+	case "int32":
+		return "dest.WriteInt32(" + value + ")"
+	case "uint32":
+		return "dest.WriteUint32(" + value + ")"
+	case "int64":
+		return "json.WriteInt64(dest, " + value + ")"
+	case "uint64":
+		return "json.WriteUint64(dest, " + value + ")"
+	case "float64":
+		return "json.WriteFloat64(dest, " + value + ")"
+	case "data.TraceID":
+		return "json.WriteTraceID(dest, " + value + ")"
+	case "data.SpanID":
+		return "json.WriteSpanID(dest, " + value + ")"
+	}
+	// The other types are the enums of the proto messages.
+	return "dest.WriteInt32(int32(" + value + "))"
+}
@@ -115,6 +115,14 @@ func New{{ .structName }}(orig *{{ .originName }}) {{ .structName }} {
 	return {{ .structName }}{orig: orig}
 }`
 
+const messageValueJSONTemplate = `func (ms {{ .structName }}) marshalJsoniter(dest *jsoniter.Stream) {
+	dest.WriteObjectStart()
+	{{- range .fields }}
+	{{ .GenerateMarshalJSON $.messageStruct }}
+	{{- end }}
+	dest.WriteObjectEnd()
+}`
+
 type baseStruct interface {
 	getName() string
 	getPackageName() string
@@ -126,6 +134,7 @@ type baseStruct interface {
 
 // messageValueStruct generates a struct for a proto message. The struct can be generated both as a common struct
 // that can be used as a field in struct from other packages and as an isolated struct with depending on a package name.
+// The fields are listed in the order of the origin struct, which is the order they are written in JSON.
 type messageValueStruct struct {
 	structName     string
 	packageName    string
@@ -178,6 +187,14 @@ func (ms *messageValueStruct) generateInternal(sb *bytes.Buffer) {
 	}
 }
 
+// generateJSON generates the OTLP JSON marshaler of the struct.
+func (ms *messageValueStruct) generateJSON(sb *bytes.Buffer) {
+	t := template.Must(template.New("messageValueJSONTemplate").Parse(messageValueJSONTemplate))
+	if err := t.Execute(sb, ms.templateFields()); err != nil {
+		panic(err)
+	}
+}
+
 func (ms *messageValueStruct) templateFields() map[string]any {
 	return map[string]any{
 		"messageStruct": ms,
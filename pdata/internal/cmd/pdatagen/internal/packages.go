@@ -33,6 +33,8 @@ type Package struct {
 	path        string
 	imports     []string
 	testImports []string
+	// jsonImports are the imports of the generated JSON marshalers, which are only generated if set.
+	jsonImports []string
 	// Can be any of sliceOfPtrs, sliceOfValues, messageValueStruct, or messagePtrStruct
 	structs []baseStruct
 }
@@ -106,6 +108,41 @@ func (p *Package) GenerateTestFiles() error {
 	return nil
 }
 
+// GenerateJSONFile generates the file with the OTLP JSON marshalers of the message structs of this Package.
+func (p *Package) GenerateJSONFile() error {
+	if len(p.jsonImports) == 0 {
+		return nil
+	}
+
+	var sb bytes.Buffer
+	generateHeader(&sb, p.name)
+
+	// Add imports
+	sb.WriteString("import (" + newLine)
+	for _, imp := range p.jsonImports {
+		if imp != "" {
+			sb.WriteString("\t" + imp + newLine)
+		} else {
+			sb.WriteString(newLine)
+		}
+	}
+	sb.WriteString(")")
+
+	// Write all marshalers
+	for _, s := range p.structs {
+		if ms, ok := s.(*messageValueStruct); ok {
+			sb.WriteString(newLine + newLine)
+			ms.generateJSON(&sb)
+		}
+	}
+	sb.WriteString(newLine)
+
+	path := filepath.Join("pdata", p.path, "generated_json.go")
+	// ignore gosec complain about permissions being `0644`.
+	//nolint:gosec
+	return os.WriteFile(path, sb.Bytes(), 0644)
+}
+
 // GenerateInternalFiles generates files with internal pdata structures for this Package.
 func (p *Package) GenerateInternalFiles() error {
 	if !usedByOtherDataTypes(p.name) {
@@ -25,6 +25,11 @@ var plog = &Package{
 		`otlplogs "go.opentelemetry.io/collector/pdata/internal/data/protogen/logs/v1"`,
 		`"go.opentelemetry.io/collector/pdata/pcommon"`,
 	},
+	jsonImports: []string{
+		`jsoniter "github.com/json-iterator/go"`,
+		``,
+		`"go.opentelemetry.io/collector/pdata/internal/json"`,
+	},
 	structs: []baseStruct{
 		resourceLogsSlice,
 		resourceLogs,
@@ -46,11 +51,11 @@ var resourceLogs = &messageValueStruct{
 	originFullName: "otlplogs.ResourceLogs",
 	fields: []baseField{
 		resourceField,
-		schemaURLField,
 		&sliceField{
 			fieldName:   "ScopeLogs",
 			returnSlice: scopeLogsSlice,
 		},
+		schemaURLField,
 	},
 }
 
@@ -65,11 +70,11 @@ var scopeLogs = &messageValueStruct{
 	originFullName: "otlplogs.ScopeLogs",
 	fields: []baseField{
 		scopeField,
-		schemaURLField,
 		&sliceField{
 			fieldName:   "LogRecords",
 			returnSlice: logSlice,
 		},
+		schemaURLField,
 	},
 }
 
@@ -84,24 +89,22 @@ var logRecord = &messageValueStruct{
 	originFullName: "otlplogs.LogRecord",
 	fields: []baseField{
 		&primitiveTypedField{
-			fieldName:       "ObservedTimestamp",
-			originFieldName: "ObservedTimeUnixNano",
+			fieldName:       "Timestamp",
+			originFieldName: "TimeUnixNano",
 			returnType:      timestampType,
 		},
 		&primitiveTypedField{
-			fieldName:       "Timestamp",
-			originFieldName: "TimeUnixNano",
+			fieldName:       "ObservedTimestamp",
+			originFieldName: "ObservedTimeUnixNano",
 			returnType:      timestampType,
 		},
-		traceIDField,
-		spanIDField,
 		&primitiveTypedField{
-			fieldName: "Flags",
+			fieldName: "SeverityNumber",
 			returnType: &primitiveType{
-				structName: "LogRecordFlags",
-				rawType:    "uint32",
-				defaultVal: "0",
-				testVal:    "1",
+				structName: "SeverityNumber",
+				rawType:    "otlplogs.SeverityNumber",
+				defaultVal: `otlplogs.SeverityNumber(0)`,
+				testVal:    `otlplogs.SeverityNumber(5)`,
 			},
 		},
 		&primitiveField{
@@ -110,18 +113,20 @@ var logRecord = &messageValueStruct{
 			defaultVal: `""`,
 			testVal:    `"INFO"`,
 		},
+		bodyField,
+		attributes,
+		droppedAttributesCount,
 		&primitiveTypedField{
-			fieldName: "SeverityNumber",
+			fieldName: "Flags",
 			returnType: &primitiveType{
-				structName: "SeverityNumber",
-				rawType:    "otlplogs.SeverityNumber",
-				defaultVal: `otlplogs.SeverityNumber(0)`,
-				testVal:    `otlplogs.SeverityNumber(5)`,
+				structName: "LogRecordFlags",
+				rawType:    "uint32",
+				defaultVal: "0",
+				testVal:    "1",
 			},
 		},
-		bodyField,
-		attributes,
-		droppedAttributesCount,
+		traceIDField,
+		spanIDField,
 	},
 }
 
@@ -25,6 +25,12 @@ var pmetric = &Package{
 		`otlpmetrics "go.opentelemetry.io/collector/pdata/internal/data/protogen/metrics/v1"`,
 		`"go.opentelemetry.io/collector/pdata/pcommon"`,
 	},
+	jsonImports: []string{
+		`jsoniter "github.com/json-iterator/go"`,
+		``,
+		`otlpmetrics "go.opentelemetry.io/collector/pdata/internal/data/protogen/metrics/v1"`,
+		`"go.opentelemetry.io/collector/pdata/internal/json"`,
+	},
 	structs: []baseStruct{
 		resourceMetricsSlice,
 		resourceMetrics,
@@ -64,11 +70,11 @@ var resourceMetrics = &messageValueStruct{
 	originFullName: "otlpmetrics.ResourceMetrics",
 	fields: []baseField{
 		resourceField,
-		schemaURLField,
 		&sliceField{
 			fieldName:   "ScopeMetrics",
 			returnSlice: scopeMetricsSlice,
 		},
+		schemaURLField,
 	},
 }
 
@@ -83,11 +89,11 @@ var scopeMetrics = &messageValueStruct{
 	originFullName: "otlpmetrics.ScopeMetrics",
 	fields: []baseField{
 		scopeField,
-		schemaURLField,
 		&sliceField{
 			fieldName:   "Metrics",
 			returnSlice: metricSlice,
 		},
+		schemaURLField,
 	},
 }
 
@@ -169,12 +175,12 @@ var sum = &messageValueStruct{
 	description:    "// Sum represents the type of a numeric metric that is calculated as a sum of all reported measurements over a time interval.",
 	originFullName: "otlpmetrics.Sum",
 	fields: []baseField{
-		aggregationTemporalityField,
-		isMonotonicField,
 		&sliceField{
 			fieldName:   "DataPoints",
 			returnSlice: numberDataPointSlice,
 		},
+		aggregationTemporalityField,
+		isMonotonicField,
 	},
 }
 
@@ -183,11 +189,11 @@ var histogram = &messageValueStruct{
 	description:    "// Histogram represents the type of a metric that is calculated by aggregating as a Histogram of all reported measurements over a time interval.",
 	originFullName: "otlpmetrics.Histogram",
 	fields: []baseField{
-		aggregationTemporalityField,
 		&sliceField{
 			fieldName:   "DataPoints",
 			returnSlice: histogramDataPointSlice,
 		},
+		aggregationTemporalityField,
 	},
 }
 
@@ -197,11 +203,11 @@ var exponentialHistogram = &messageValueStruct{
 	// as a ExponentialHistogram of all reported double measurements over a time interval.`,
 	originFullName: "otlpmetrics.ExponentialHistogram",
 	fields: []baseField{
-		aggregationTemporalityField,
 		&sliceField{
 			fieldName:   "DataPoints",
 			returnSlice: exponentialHistogramDataPointSlice,
 		},
+		aggregationTemporalityField,
 	},
 }
 
@@ -337,8 +343,8 @@ var exponentialHistogramDataPoint = &messageValueStruct{
 			fieldName:     "Negative",
 			returnMessage: bucketsValues,
 		},
-		exemplarsField,
 		dataPointFlagsField,
+		exemplarsField,
 		&optionalPrimitiveValue{
 			fieldName:        "Min",
 			originTypePrefix: "otlpmetrics.ExponentialHistogramDataPoint_",
@@ -353,6 +359,12 @@ var exponentialHistogramDataPoint = &messageValueStruct{
 			defaultVal:       "float64(0.0)",
 			testVal:          "float64(182.55)",
 		},
+		&primitiveField{
+			fieldName:  "ZeroThreshold",
+			returnType: "float64",
+			defaultVal: "float64(0.0)",
+			testVal:    "float64(0.5)",
+		},
 	},
 }
 
@@ -422,6 +434,10 @@ var exemplar = &messageValueStruct{
 
 	originFullName: "otlpmetrics.Exemplar",
 	fields: []baseField{
+		&sliceField{
+			fieldName:   "FilteredAttributes",
+			returnSlice: mapStruct,
+		},
 		timeField,
 		&oneOfField{
 			typeName:         "ExemplarValueType",
@@ -445,12 +461,8 @@ var exemplar = &messageValueStruct{
 				},
 			},
 		},
-		&sliceField{
-			fieldName:   "FilteredAttributes",
-			returnSlice: mapStruct,
-		},
-		traceIDField,
 		spanIDField,
+		traceIDField,
 	},
 }
 
@@ -25,6 +25,11 @@ var ptrace = &Package{
 		`otlptrace "go.opentelemetry.io/collector/pdata/internal/data/protogen/trace/v1"`,
 		`"go.opentelemetry.io/collector/pdata/pcommon"`,
 	},
+	jsonImports: []string{
+		`jsoniter "github.com/json-iterator/go"`,
+		``,
+		`"go.opentelemetry.io/collector/pdata/internal/json"`,
+	},
 	structs: []baseStruct{
 		resourceSpansSlice,
 		resourceSpans,
@@ -51,11 +56,11 @@ var resourceSpans = &messageValueStruct{
 	originFullName: "otlptrace.ResourceSpans",
 	fields: []baseField{
 		resourceField,
-		schemaURLField,
 		&sliceField{
 			fieldName:   "ScopeSpans",
 			returnSlice: scopeSpansSlice,
 		},
+		schemaURLField,
 	},
 }
 
@@ -70,11 +75,11 @@ var scopeSpans = &messageValueStruct{
 	originFullName: "otlptrace.ScopeSpans",
 	fields: []baseField{
 		scopeField,
-		schemaURLField,
 		&sliceField{
 			fieldName:   "Spans",
 			returnSlice: spanSlice,
 		},
+		schemaURLField,
 	},
 }
 
@@ -178,6 +183,12 @@ var spanStatus = &messageValueStruct{
 		"// set, that means the span ended without errors and to assume Status.Ok (code = 0).",
 	originFullName: "otlptrace.Status",
 	fields: []baseField{
+		&primitiveField{
+			fieldName:  "Message",
+			returnType: "string",
+			defaultVal: `""`,
+			testVal:    `"cancelled"`,
+		},
 		&primitiveTypedField{
 			fieldName: "Code",
 			returnType: &primitiveType{
@@ -187,12 +198,6 @@ var spanStatus = &messageValueStruct{
 				testVal:    "1",
 			},
 		},
-		&primitiveField{
-			fieldName:  "Message",
-			returnType: "string",
-			defaultVal: `""`,
-			testVal:    `"cancelled"`,
-		},
 	},
 }
 
@@ -108,3 +108,76 @@ func readKvlistValue(iter *jsoniter.Iterator) *otlpcommon.KeyValueList {
 	})
 	return v
 }
+
+// WriteAttributes writes the attributes as the named field, unless they are nil.
+func WriteAttributes(dest *jsoniter.Stream, name string, attrs []otlpcommon.KeyValue) {
+	if attrs == nil {
+		return
+	}
+	WriteObjectField(dest, name)
+	writeKeyValues(dest, attrs)
+}
+
+func writeKeyValues(dest *jsoniter.Stream, kvs []otlpcommon.KeyValue) {
+	dest.WriteArrayStart()
+	for i := range kvs {
+		WriteArrayElement(dest, i)
+		dest.WriteObjectStart()
+		if kvs[i].Key != "" {
+			WriteObjectField(dest, "key")
+			WriteString(dest, kvs[i].Key)
+		}
+		WriteObjectField(dest, "value")
+		WriteValue(dest, &kvs[i].Value)
+		dest.WriteObjectEnd()
+	}
+	dest.WriteArrayEnd()
+}
+
+// WriteValue writes the otlpcommon.AnyValue as a JSON object.
+func WriteValue(dest *jsoniter.Stream, val *otlpcommon.AnyValue) {
+	dest.WriteObjectStart()
+	switch v := val.Value.(type) {
+	case *otlpcommon.AnyValue_StringValue:
+		WriteObjectField(dest, "stringValue")
+		WriteString(dest, v.StringValue)
+	case *otlpcommon.AnyValue_BoolValue:
+		WriteObjectField(dest, "boolValue")
+		dest.WriteBool(v.BoolValue)
+	case *otlpcommon.AnyValue_IntValue:
+		WriteObjectField(dest, "intValue")
+		WriteInt64(dest, v.IntValue)
+	case *otlpcommon.AnyValue_DoubleValue:
+		WriteObjectField(dest, "doubleValue")
+		WriteFloat64(dest, v.DoubleValue)
+	case *otlpcommon.AnyValue_BytesValue:
+		WriteObjectField(dest, "bytesValue")
+		if v.BytesValue == nil {
+			dest.WriteNil()
+		} else {
+			WriteBytes(dest, v.BytesValue)
+		}
+	case *otlpcommon.AnyValue_ArrayValue:
+		WriteObjectField(dest, "arrayValue")
+		dest.WriteObjectStart()
+		if v.ArrayValue != nil && v.ArrayValue.Values != nil {
+			WriteObjectField(dest, "values")
+			dest.WriteArrayStart()
+			for i := range v.ArrayValue.Values {
+				WriteArrayElement(dest, i)
+				WriteValue(dest, &v.ArrayValue.Values[i])
+			}
+			dest.WriteArrayEnd()
+		}
+		dest.WriteObjectEnd()
+	case *otlpcommon.AnyValue_KvlistValue:
+		WriteObjectField(dest, "kvlistValue")
+		dest.WriteObjectStart()
+		if v.KvlistValue != nil && v.KvlistValue.Values != nil {
+			WriteObjectField(dest, "values")
+			writeKeyValues(dest, v.KvlistValue.Values)
+		}
+		dest.WriteObjectEnd()
+	}
+	dest.WriteObjectEnd()
+}
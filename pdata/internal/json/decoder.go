@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package json // import "go.opentelemetry.io/collector/pdata/internal/json"
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// decoderBufferSize is the size of the buffer the ArrayDecoder reads into, the input is never
+// held in memory beyond this buffer and the element being read.
+const decoderBufferSize = 64 * 1024
+
+// ArrayDecoder reads from a reader the elements of an array field of a JSON object, one at a time.
+// The other fields of the object are skipped.
+type ArrayDecoder struct {
+	reader  *eofReader
+	iter    *jsoniter.Iterator
+	fields  []string
+	inArray bool
+	done    bool
+}
+
+// NewArrayDecoder returns a decoder of the elements of the array fields with one of the given
+// names, in the JSON object read from r.
+func NewArrayDecoder(r io.Reader, fields ...string) *ArrayDecoder {
+	reader := &eofReader{Reader: r}
+	return &ArrayDecoder{
+		reader: reader,
+		iter:   jsoniter.Parse(jsoniter.ConfigFastest, reader, decoderBufferSize),
+		fields: fields,
+	}
+}
+
+// Next returns the iterator positioned on the next element of the array, which must be entirely
+// read before calling Next again. It returns io.EOF once the object has been read.
+func (d *ArrayDecoder) Next() (*jsoniter.Iterator, error) {
+	for !d.done {
+		if d.inArray {
+			if d.iter.ReadArray() {
+				return d.iter, d.Err()
+			}
+			d.inArray = false
+		}
+		if err := d.Err(); err != nil {
+			return nil, err
+		}
+		field := d.iter.ReadObject()
+		if err := d.Err(); err != nil {
+			return nil, err
+		}
+		switch {
+		case field == "":
+			d.done = true
+		case d.isArrayField(field):
+			d.inArray = true
+		default:
+			d.iter.Skip()
+		}
+	}
+	return nil, io.EOF
+}
+
+// Err returns the error that occurred while reading, if any. The end of the input before the end
+// of the object is reported as io.ErrUnexpectedEOF.
+func (d *ArrayDecoder) Err() error {
+	if d.iter.Error == nil || !d.reader.eof {
+		return d.iter.Error
+	}
+	if errors.Is(d.iter.Error, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	// The iterator reports the end of the input as a syntax error.
+	return fmt.Errorf("%w: %v", io.ErrUnexpectedEOF, d.iter.Error)
+}
+
+func (d *ArrayDecoder) isArrayField(field string) bool {
+	for _, f := range d.fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// eofReader records whether the end of the input has been reached.
+type eofReader struct {
+	io.Reader
+	eof bool
+}
+
+func (r *eofReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if n == 0 && errors.Is(err, io.EOF) {
+		r.eof = true
+	}
+	return n, err
+}
@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package json
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readAllElements(decoder *ArrayDecoder) ([]string, error) {
+	var elements []string
+	for {
+		iter, err := decoder.Next()
+		if err == io.EOF {
+			return elements, nil
+		}
+		if err != nil {
+			return elements, err
+		}
+		elements = append(elements, iter.ReadString())
+	}
+}
+
+func TestArrayDecoder(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:  "empty object",
+			input: `{}`,
+		},
+		{
+			name:  "null",
+			input: `null`,
+		},
+		{
+			name:  "null array",
+			input: `{"values":null}`,
+		},
+		{
+			name:     "skipped fields",
+			input:    `{"other":{"values":["x"]},"values":["a","b"],"last":1}`,
+			expected: []string{"a", "b"},
+		},
+		{
+			name:     "all fields names",
+			input:    ` { "values" : [ "a" ] , "alias" : [ "b" , "c" ] } `,
+			expected: []string{"a", "b", "c"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			elements, err := readAllElements(NewArrayDecoder(strings.NewReader(tt.input), "values", "alias"))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, elements)
+		})
+	}
+}
+
+func TestArrayDecoderErrors(t *testing.T) {
+	for _, input := range []string{``, `{"values":["a"`, `{"values":["a"]`} {
+		_, err := readAllElements(NewArrayDecoder(strings.NewReader(input), "values"))
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF, "input %q", input)
+	}
+
+	_, err := readAllElements(NewArrayDecoder(strings.NewReader(`{"values":["a"] "other":1}`), "values"))
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, io.ErrUnexpectedEOF)
+}
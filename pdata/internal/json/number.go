@@ -4,6 +4,7 @@
 package json // import "go.opentelemetry.io/collector/pdata/internal/json"
 
 import (
+	"math"
 	"strconv"
 
 	jsoniter "github.com/json-iterator/go"
@@ -101,3 +102,46 @@ func ReadFloat64(iter *jsoniter.Iterator) float64 {
 		return 0
 	}
 }
+
+// WriteUint64 writes the uint64 as a decimal string, see https://developers.google.com/protocol-buffers/docs/proto3#json.
+func WriteUint64(dest *jsoniter.Stream, v uint64) {
+	dest.WriteRaw(`"`)
+	dest.WriteUint64(v)
+	dest.WriteRaw(`"`)
+}
+
+// WriteInt64 writes the int64 as a decimal string, see https://developers.google.com/protocol-buffers/docs/proto3#json.
+func WriteInt64(dest *jsoniter.Stream, v int64) {
+	dest.WriteRaw(`"`)
+	dest.WriteInt64(v)
+	dest.WriteRaw(`"`)
+}
+
+// WriteFloat64 writes the float64 as a number formatted like encoding/json does, or as the "NaN",
+// "Infinity" and "-Infinity" strings for the non-finite values.
+func WriteFloat64(dest *jsoniter.Stream, v float64) {
+	switch {
+	case math.IsNaN(v):
+		dest.WriteRaw(`"NaN"`)
+		return
+	case math.IsInf(v, 1):
+		dest.WriteRaw(`"Infinity"`)
+		return
+	case math.IsInf(v, -1):
+		dest.WriteRaw(`"-Infinity"`)
+		return
+	}
+	format := byte('f')
+	if abs := math.Abs(v); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	buf := strconv.AppendFloat(dest.Buffer(), v, format, -1, 64)
+	if format == 'e' {
+		// Clean up e-09 to e-9, like encoding/json.
+		if n := len(buf); n >= 4 && buf[n-4] == 'e' && buf[n-3] == '-' && buf[n-2] == '0' {
+			buf[n-2] = buf[n-1]
+			buf = buf[:n-1]
+		}
+	}
+	dest.SetBuffer(buf)
+}
@@ -25,3 +25,14 @@ func ReadResource(iter *jsoniter.Iterator, resource *otlpresource.Resource) {
 		return true
 	})
 }
+
+// WriteResource writes the otlpresource.Resource as a JSON object.
+func WriteResource(dest *jsoniter.Stream, resource *otlpresource.Resource) {
+	dest.WriteObjectStart()
+	WriteAttributes(dest, "attributes", resource.Attributes)
+	if resource.DroppedAttributesCount != 0 {
+		WriteObjectField(dest, "droppedAttributesCount")
+		dest.WriteUint32(resource.DroppedAttributesCount)
+	}
+	dest.WriteObjectEnd()
+}
@@ -29,3 +29,22 @@ func ReadScope(iter *jsoniter.Iterator, scope *otlpcommon.InstrumentationScope)
 		return true
 	})
 }
+
+// WriteScope writes the otlpcommon.InstrumentationScope as a JSON object.
+func WriteScope(dest *jsoniter.Stream, scope *otlpcommon.InstrumentationScope) {
+	dest.WriteObjectStart()
+	if scope.Name != "" {
+		WriteObjectField(dest, "name")
+		WriteString(dest, scope.Name)
+	}
+	if scope.Version != "" {
+		WriteObjectField(dest, "version")
+		WriteString(dest, scope.Version)
+	}
+	WriteAttributes(dest, "attributes", scope.Attributes)
+	if scope.DroppedAttributesCount != 0 {
+		WriteObjectField(dest, "droppedAttributesCount")
+		dest.WriteUint32(scope.DroppedAttributesCount)
+	}
+	dest.WriteObjectEnd()
+}
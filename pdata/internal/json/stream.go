@@ -0,0 +1,146 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package json // import "go.opentelemetry.io/collector/pdata/internal/json"
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"unicode/utf8"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"go.opentelemetry.io/collector/pdata/internal/data"
+)
+
+// The writers below produce the same output as the gogo/protobuf jsonpb marshaler configured in json.go,
+// which the OTLP JSON encoding was historically produced with: fields are written in the order of the
+// generated structs, the scalar fields with their default value are omitted, the 64-bit integers are
+// written as strings, the enums as integers, and the trace and span ids as hex strings.
+
+// BorrowStream returns a stream writing into an in-memory buffer. It must be returned with ReturnStream.
+func BorrowStream() *jsoniter.Stream {
+	return jsoniter.ConfigFastest.BorrowStream(nil)
+}
+
+// ReturnStream returns a stream borrowed with BorrowStream, and returns a copy of the bytes written to it.
+func ReturnStream(dest *jsoniter.Stream) ([]byte, error) {
+	defer jsoniter.ConfigFastest.ReturnStream(dest)
+	if dest.Error != nil {
+		return nil, dest.Error
+	}
+	buf := make([]byte, len(dest.Buffer()))
+	copy(buf, dest.Buffer())
+	return buf, nil
+}
+
+// WriteObjectField writes the name of an object field, preceded by a comma unless it is the first
+// field of the object.
+func WriteObjectField(dest *jsoniter.Stream, name string) {
+	if buf := dest.Buffer(); len(buf) > 0 && buf[len(buf)-1] != '{' {
+		dest.WriteRaw(",")
+	}
+	dest.WriteRaw(`"`)
+	dest.WriteRaw(name)
+	dest.WriteRaw(`":`)
+}
+
+// WriteArrayElement writes the comma separating the array elements, unless it is the first element.
+func WriteArrayElement(dest *jsoniter.Stream, i int) {
+	if i > 0 {
+		dest.WriteRaw(",")
+	}
+}
+
+// WriteTraceID writes the trace id as a hex string, the empty string if the id is empty.
+func WriteTraceID(dest *jsoniter.Stream, id data.TraceID) {
+	if id.IsEmpty() {
+		dest.WriteRaw(`""`)
+		return
+	}
+	var buf [2*16 + 2]byte
+	buf[0], buf[len(buf)-1] = '"', '"'
+	hex.Encode(buf[1:len(buf)-1], id[:])
+	dest.SetBuffer(append(dest.Buffer(), buf[:]...))
+}
+
+// WriteSpanID writes the span id as a hex string, the empty string if the id is empty.
+func WriteSpanID(dest *jsoniter.Stream, id data.SpanID) {
+	if id.IsEmpty() {
+		dest.WriteRaw(`""`)
+		return
+	}
+	var buf [2*8 + 2]byte
+	buf[0], buf[len(buf)-1] = '"', '"'
+	hex.Encode(buf[1:len(buf)-1], id[:])
+	dest.SetBuffer(append(dest.Buffer(), buf[:]...))
+}
+
+// WriteBytes writes the bytes as a base64 string.
+func WriteBytes(dest *jsoniter.Stream, v []byte) {
+	buf := append(dest.Buffer(), '"')
+	n, size := len(buf), base64.StdEncoding.EncodedLen(len(v))
+	if cap(buf)-n < size+1 {
+		grown := make([]byte, n, 2*cap(buf)+size+1)
+		copy(grown, buf)
+		buf = grown
+	}
+	buf = buf[:n+size]
+	base64.StdEncoding.Encode(buf[n:], v)
+	dest.SetBuffer(append(buf, '"'))
+}
+
+const hexDigits = "0123456789abcdef"
+
+// WriteString writes the string escaped like encoding/json does: the HTML characters, the control
+// characters, U+2028 and U+2029 are escaped, and the invalid UTF-8 is replaced by U+FFFD.
+func WriteString(dest *jsoniter.Stream, s string) {
+	buf := append(dest.Buffer(), '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if b := s[i]; b < utf8.RuneSelf {
+			if b >= 0x20 && b != '"' && b != '\\' && b != '<' && b != '>' && b != '&' {
+				i++
+				continue
+			}
+			buf = append(buf, s[start:i]...)
+			switch b {
+			case '"', '\\':
+				buf = append(buf, '\\', b)
+			case '\b':
+				buf = append(buf, '\\', 'b')
+			case '\f':
+				buf = append(buf, '\\', 'f')
+			case '\n':
+				buf = append(buf, '\\', 'n')
+			case '\r':
+				buf = append(buf, '\\', 'r')
+			case '\t':
+				buf = append(buf, '\\', 't')
+			default:
+				buf = append(buf, '\\', 'u', '0', '0', hexDigits[b>>4], hexDigits[b&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			buf = append(buf, s[start:i]...)
+			buf = append(buf, "\uFFFD"...)
+			i += size
+			start = i
+			continue
+		}
+		if r == '\u2028' || r == '\u2029' {
+			buf = append(buf, s[start:i]...)
+			buf = append(buf, '\\', 'u', '2', '0', '2', hexDigits[r&0xF])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	buf = append(buf, s[start:]...)
+	dest.SetBuffer(append(buf, '"'))
+}
@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package json
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/pdata/internal/data"
+	otlpcommon "go.opentelemetry.io/collector/pdata/internal/data/protogen/common/v1"
+)
+
+func writeToString(t *testing.T, write func(dest *jsoniter.Stream)) string {
+	dest := BorrowStream()
+	write(dest)
+	buf, err := ReturnStream(dest)
+	require.NoError(t, err)
+	return string(buf)
+}
+
+func TestWriteString(t *testing.T) {
+	values := []string{
+		"",
+		"simple",
+		`quote " and backslash \`,
+		"<html> & </html>",
+		"line\nfeed\r\ttab\b\f",
+		"\x00\x01\x1f\x7f",
+		"unicode: é 世界 🙂",
+		"separators: \u2028 \u2029",
+		"invalid: \xff\xfe end",
+	}
+	for i := 0; i < 0x80; i++ {
+		values = append(values, string(rune(i)))
+	}
+	for _, v := range values {
+		expected, err := json.Marshal(v)
+		require.NoError(t, err)
+		assert.Equal(t, string(expected), writeToString(t, func(dest *jsoniter.Stream) { WriteString(dest, v) }), "value %q", v)
+	}
+}
+
+func TestWriteFloat64(t *testing.T) {
+	for _, v := range []float64{0, 1, -1, 1.1, 0.1, 1e-6, 1e-7, 123456789, 1e20, 1e21, 1.5e300, -2.5e-10, math.MaxFloat64, math.SmallestNonzeroFloat64} {
+		expected, err := json.Marshal(v)
+		require.NoError(t, err)
+		assert.Equal(t, string(expected), writeToString(t, func(dest *jsoniter.Stream) { WriteFloat64(dest, v) }), "value %v", v)
+	}
+	assert.Equal(t, `"NaN"`, writeToString(t, func(dest *jsoniter.Stream) { WriteFloat64(dest, math.NaN()) }))
+	assert.Equal(t, `"Infinity"`, writeToString(t, func(dest *jsoniter.Stream) { WriteFloat64(dest, math.Inf(1)) }))
+	assert.Equal(t, `"-Infinity"`, writeToString(t, func(dest *jsoniter.Stream) { WriteFloat64(dest, math.Inf(-1)) }))
+}
+
+func TestWriteIntegers(t *testing.T) {
+	assert.Equal(t, `"18446744073709551615"`, writeToString(t, func(dest *jsoniter.Stream) { WriteUint64(dest, math.MaxUint64) }))
+	assert.Equal(t, `"-9223372036854775808"`, writeToString(t, func(dest *jsoniter.Stream) { WriteInt64(dest, math.MinInt64) }))
+}
+
+func TestWriteIDs(t *testing.T) {
+	assert.Equal(t, `""`, writeToString(t, func(dest *jsoniter.Stream) { WriteTraceID(dest, data.TraceID{}) }))
+	assert.Equal(t, `""`, writeToString(t, func(dest *jsoniter.Stream) { WriteSpanID(dest, data.SpanID{}) }))
+	assert.Equal(t, `"0102030405060708090a0b0c0d0e0f10"`, writeToString(t, func(dest *jsoniter.Stream) {
+		WriteTraceID(dest, data.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	}))
+	assert.Equal(t, `"1112131415161718"`, writeToString(t, func(dest *jsoniter.Stream) {
+		WriteSpanID(dest, data.SpanID{0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18})
+	}))
+}
+
+func TestWriteBytes(t *testing.T) {
+	for _, v := range [][]byte{{}, []byte("f"), []byte("foo"), make([]byte, 1000)} {
+		expected, err := json.Marshal(v)
+		require.NoError(t, err)
+		assert.Equal(t, string(expected), writeToString(t, func(dest *jsoniter.Stream) { WriteBytes(dest, v) }))
+	}
+}
+
+func TestWriteValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    otlpcommon.AnyValue
+		expected string
+	}{
+		{
+			name:     "empty",
+			expected: `{}`,
+		},
+		{
+			name:     "zero int",
+			value:    otlpcommon.AnyValue{Value: &otlpcommon.AnyValue_IntValue{IntValue: 0}},
+			expected: `{"intValue":"0"}`,
+		},
+		{
+			name:     "false",
+			value:    otlpcommon.AnyValue{Value: &otlpcommon.AnyValue_BoolValue{BoolValue: false}},
+			expected: `{"boolValue":false}`,
+		},
+		{
+			name:     "nil bytes",
+			value:    otlpcommon.AnyValue{Value: &otlpcommon.AnyValue_BytesValue{}},
+			expected: `{"bytesValue":null}`,
+		},
+		{
+			name:     "empty array",
+			value:    otlpcommon.AnyValue{Value: &otlpcommon.AnyValue_ArrayValue{ArrayValue: &otlpcommon.ArrayValue{}}},
+			expected: `{"arrayValue":{}}`,
+		},
+		{
+			name: "kvlist",
+			value: otlpcommon.AnyValue{Value: &otlpcommon.AnyValue_KvlistValue{KvlistValue: &otlpcommon.KeyValueList{
+				Values: []otlpcommon.KeyValue{{Key: "k"}, {Value: otlpcommon.AnyValue{Value: &otlpcommon.AnyValue_DoubleValue{DoubleValue: 1.5}}}},
+			}}},
+			expected: `{"kvlistValue":{"values":[{"key":"k","value":{}},{"value":{"doubleValue":1.5}}]}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, writeToString(t, func(dest *jsoniter.Stream) { WriteValue(dest, &tt.value) }))
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlp // import "go.opentelemetry.io/collector/pdata/internal/otlp"
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// ResourceField is the number of the resource_spans, resource_metrics and resource_logs fields,
+// in both the OTLP data messages and the OTLP export requests.
+const ResourceField = 1
+
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// FieldReader reads from a reader the occurrences of a repeated message field of a protobuf
+// message, one at a time. The other fields of the message are skipped.
+type FieldReader struct {
+	r     *bufio.Reader
+	field uint64
+	buf   bytes.Buffer
+}
+
+// NewFieldReader returns a reader of the occurrences of the field with the given number, in the
+// protobuf message read from r.
+func NewFieldReader(r io.Reader, field uint64) *FieldReader {
+	return &FieldReader{r: bufio.NewReader(r), field: field}
+}
+
+// Next returns the encoded message of the next occurrence of the field. The returned bytes are only
+// valid until the next call. It returns io.EOF once the message has been read.
+func (fr *FieldReader) Next() ([]byte, error) {
+	for {
+		tag, err := binary.ReadUvarint(fr.r)
+		if err != nil {
+			// The end of the input is only expected between two fields.
+			return nil, err
+		}
+		field, wireType := tag>>3, tag&0x7
+		switch wireType {
+		case wireVarint:
+			_, err = binary.ReadUvarint(fr.r)
+		case wireFixed64:
+			_, err = fr.r.Discard(8)
+		case wireFixed32:
+			_, err = fr.r.Discard(4)
+		case wireBytes:
+			var length uint64
+			if length, err = binary.ReadUvarint(fr.r); err != nil {
+				break
+			}
+			if length > math.MaxInt64 {
+				return nil, fmt.Errorf("proto: invalid length %d for field %d", length, field)
+			}
+			if field == fr.field {
+				return fr.readBytes(length)
+			}
+			_, err = io.CopyN(io.Discard, fr.r, int64(length))
+		default:
+			return nil, fmt.Errorf("proto: unsupported wire type %d for field %d", wireType, field)
+		}
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+	}
+}
+
+// readBytes reads a length-delimited field. The buffer only grows as the bytes are actually read,
+// so that an invalid length cannot cause a large allocation.
+func (fr *FieldReader) readBytes(length uint64) ([]byte, error) {
+	fr.buf.Reset()
+	n, err := fr.buf.ReadFrom(io.LimitReader(fr.r, int64(length)))
+	if err != nil {
+		return nil, err
+	}
+	if uint64(n) != length {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return fr.buf.Bytes(), nil
+}
+
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
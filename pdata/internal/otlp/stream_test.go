@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlp
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldReader(t *testing.T) {
+	buf := []byte{
+		// Field 1, bytes.
+		0x0a, 0x02, 'a', 'b',
+		// Field 2, varint.
+		0x10, 0x96, 0x01,
+		// Field 3, fixed64.
+		0x19, 1, 2, 3, 4, 5, 6, 7, 8,
+		// Field 4, fixed32.
+		0x25, 1, 2, 3, 4,
+		// Field 5, bytes.
+		0x2a, 0x01, 'x',
+		// Field 1, empty bytes.
+		0x0a, 0x00,
+		// Field 1, bytes.
+		0x0a, 0x01, 'c',
+	}
+	reader := NewFieldReader(bytes.NewReader(buf), 1)
+	for _, expected := range []string{"ab", "", "c"} {
+		field, err := reader.Next()
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(field))
+	}
+	_, err := reader.Next()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestFieldReaderErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		buf      []byte
+		expected string
+	}{
+		{
+			name:     "truncated field",
+			buf:      []byte{0x0a, 0x03, 'a'},
+			expected: io.ErrUnexpectedEOF.Error(),
+		},
+		{
+			name:     "truncated skipped field",
+			buf:      []byte{0x19, 1, 2},
+			expected: io.ErrUnexpectedEOF.Error(),
+		},
+		{
+			name:     "truncated tag",
+			buf:      []byte{0x80},
+			expected: io.ErrUnexpectedEOF.Error(),
+		},
+		{
+			name:     "group",
+			buf:      []byte{0x0b},
+			expected: "proto: unsupported wire type 3 for field 1",
+		},
+		{
+			name:     "invalid length",
+			buf:      []byte{0x0a, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01},
+			expected: "proto: invalid length 18446744073709551615 for field 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewFieldReader(bytes.NewReader(tt.buf), 1).Next()
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package internal contains the helpers shared by the ptracetest, pmetrictest and plogtest packages.
+package internal // import "go.opentelemetry.io/collector/pdata/pdatatest/internal"
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.uber.org/multierr"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// Match pairs the expected elements with the actual elements having the same key. Among the elements
+// sharing a key, an expected element is paired with an actual element equal to it if there is one and
+// equal is not nil, and the remaining ones are paired in order. It returns the index of the actual element matching each
+// expected element, -1 if there is none, and the indexes of the actual elements not matching any
+// expected element.
+func Match(expectedLen, actualLen int, expectedKey, actualKey func(int) string, equal func(expectedIndex, actualIndex int) bool) (matches []int, unexpected []int) {
+	candidates := map[string][]int{}
+	for j := 0; j < actualLen; j++ {
+		k := actualKey(j)
+		candidates[k] = append(candidates[k], j)
+	}
+	matched := make([]bool, actualLen)
+	matches = make([]int, expectedLen)
+	for i := range matches {
+		matches[i] = -1
+		if equal == nil {
+			continue
+		}
+		for _, j := range candidates[expectedKey(i)] {
+			if !matched[j] && equal(i, j) {
+				matches[i] = j
+				matched[j] = true
+				break
+			}
+		}
+	}
+	for i := range matches {
+		if matches[i] != -1 {
+			continue
+		}
+		for _, j := range candidates[expectedKey(i)] {
+			if !matched[j] {
+				matches[i] = j
+				matched[j] = true
+				break
+			}
+		}
+	}
+	for j, ok := range matched {
+		if !ok {
+			unexpected = append(unexpected, j)
+		}
+	}
+	return matches, unexpected
+}
+
+// CompareElements matches the expected elements with the actual elements by key, and compares the
+// matching elements with the compare function. The errors are prefixed with the description of
+// the element. If ignoreOrder is false, the matching elements are also expected at the same index.
+// Otherwise, the elements sharing a key are matched with the elements equal to them first.
+func CompareElements(
+	kind string,
+	expectedLen, actualLen int,
+	expectedKey, actualKey func(int) string,
+	describe func(key string, index int) string,
+	ignoreOrder bool,
+	compare func(expectedIndex, actualIndex int) error,
+) error {
+	var errs error
+	var equal func(i, j int) bool
+	if ignoreOrder {
+		equal = func(i, j int) bool { return compare(i, j) == nil }
+	}
+	matches, unexpected := Match(expectedLen, actualLen, expectedKey, actualKey, equal)
+	for i, j := range matches {
+		if j == -1 {
+			errs = multierr.Append(errs, fmt.Errorf("missing expected %s %s", kind, describe(expectedKey(i), i)))
+		}
+	}
+	for _, j := range unexpected {
+		errs = multierr.Append(errs, fmt.Errorf("unexpected %s %s", kind, describe(actualKey(j), j)))
+	}
+	for i, j := range matches {
+		if j == -1 {
+			continue
+		}
+		prefix := kind + " " + describe(expectedKey(i), i)
+		if !ignoreOrder && i != j {
+			errs = multierr.Append(errs, fmt.Errorf("%s: expected at index %d, found at index %d", prefix, i, j))
+		}
+		errs = multierr.Append(errs, WithPrefix(prefix, compare(i, j)))
+	}
+	return errs
+}
+
+// WithPrefix prefixes each of the errors combined in err with the given prefix.
+func WithPrefix(prefix string, err error) error {
+	var errs error
+	for _, e := range multierr.Errors(err) {
+		errs = multierr.Append(errs, fmt.Errorf("%s: %w", prefix, e))
+	}
+	return errs
+}
+
+// CompareField returns an error if the expected and actual values of the field differ.
+func CompareField(name string, expected, actual any) error {
+	if reflect.DeepEqual(expected, actual) {
+		return nil
+	}
+	return fmt.Errorf("%s: expected %s, got %s", name, formatAny(expected), formatAny(actual))
+}
+
+func formatAny(v any) string {
+	switch val := v.(type) {
+	case string:
+		return strconv.Quote(val)
+	case fmt.Stringer:
+		return val.String()
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// CompareAttributes returns an error for each attribute that differs between the expected and
+// actual maps, prefixed with the given name.
+func CompareAttributes(name string, expected, actual pcommon.Map) error {
+	var errs error
+	expected.Range(func(k string, ev pcommon.Value) bool {
+		av, ok := actual.Get(k)
+		switch {
+		case !ok:
+			errs = multierr.Append(errs, fmt.Errorf("%s: missing expected attribute %q", name, k))
+		case !EqualValues(ev, av):
+			errs = multierr.Append(errs, fmt.Errorf("%s: attribute %q: expected %s, got %s", name, k, FormatValue(ev), FormatValue(av)))
+		}
+		return true
+	})
+	actual.Range(func(k string, _ pcommon.Value) bool {
+		if _, ok := expected.Get(k); !ok {
+			errs = multierr.Append(errs, fmt.Errorf("%s: unexpected attribute %q", name, k))
+		}
+		return true
+	})
+	return errs
+}
+
+// CompareValue returns an error if the expected and actual values differ.
+func CompareValue(name string, expected, actual pcommon.Value) error {
+	if EqualValues(expected, actual) {
+		return nil
+	}
+	return fmt.Errorf("%s: expected %s, got %s", name, FormatValue(expected), FormatValue(actual))
+}
+
+// EqualValues returns whether the values have the same type and the same content.
+func EqualValues(expected, actual pcommon.Value) bool {
+	return expected.Type() == actual.Type() && reflect.DeepEqual(expected.AsRaw(), actual.AsRaw())
+}
+
+// FormatValue returns a human-readable representation of the value, quoted if it is a string.
+func FormatValue(v pcommon.Value) string {
+	if v.Type() == pcommon.ValueTypeStr {
+		return strconv.Quote(v.Str())
+	}
+	return v.AsString()
+}
+
+// AttributesKey returns a canonical representation of the attributes, used to match the elements
+// identified by their attributes.
+func AttributesKey(m pcommon.Map) string {
+	keys := make([]string, 0, m.Len())
+	m.Range(func(k string, _ pcommon.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		v, _ := m.Get(k)
+		parts = append(parts, k+"="+FormatValue(v))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ScopeKey identifies a scope by name and version.
+func ScopeKey(scope pcommon.InstrumentationScope) string {
+	key := strconv.Quote(scope.Name())
+	if scope.Version() != "" {
+		key += " version " + strconv.Quote(scope.Version())
+	}
+	return key
+}
+
+// DescribeKey returns the description of an element from its key, or its index when the key is empty.
+func DescribeKey(key string, index int) string {
+	if key == "" {
+		return "#" + strconv.Itoa(index)
+	}
+	return "[" + key + "]"
+}
+
+// RemoveAttributes removes the attributes with the given names from the map.
+func RemoveAttributes(m pcommon.Map, names []string) {
+	m.RemoveIf(func(k string, _ pcommon.Value) bool {
+		for _, name := range names {
+			if k == name {
+				return true
+			}
+		}
+		return false
+	})
+}
@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestMatch(t *testing.T) {
+	expected := []string{"a", "b", "a", "c"}
+	actual := []string{"b", "a", "d", "a", "a"}
+	matches, unexpected := Match(len(expected), len(actual),
+		func(i int) string { return expected[i] },
+		func(i int) string { return actual[i] },
+		nil,
+	)
+	assert.Equal(t, []int{1, 0, 3, -1}, matches)
+	assert.Equal(t, []int{2, 4}, unexpected)
+}
+
+func TestMatchEqual(t *testing.T) {
+	// The elements share the same key, the equal ones are paired first.
+	expected := []string{"a1", "a2", "a3"}
+	actual := []string{"a3", "a4", "a1"}
+	matches, unexpected := Match(len(expected), len(actual),
+		func(int) string { return "a" },
+		func(int) string { return "a" },
+		func(i, j int) bool { return expected[i] == actual[j] },
+	)
+	assert.Equal(t, []int{2, 1, 0}, matches)
+	assert.Empty(t, unexpected)
+}
+
+func TestCompareField(t *testing.T) {
+	assert.NoError(t, CompareField("name", "a", "a"))
+	assert.EqualError(t, CompareField("name", "a", "b"), `name: expected "a", got "b"`)
+	assert.EqualError(t, CompareField("count", 1, 2), `count: expected 1, got 2`)
+	assert.EqualError(t, CompareField("timestamp", pcommon.Timestamp(0), pcommon.Timestamp(1)),
+		`timestamp: expected 1970-01-01 00:00:00 +0000 UTC, got 1970-01-01 00:00:00.000000001 +0000 UTC`)
+}
+
+func TestCompareValue(t *testing.T) {
+	assert.NoError(t, CompareValue("body", pcommon.NewValueStr("a"), pcommon.NewValueStr("a")))
+	assert.EqualError(t, CompareValue("body", pcommon.NewValueInt(1), pcommon.NewValueStr("1")), `body: expected 1, got "1"`)
+}
+
+func TestAttributesKey(t *testing.T) {
+	m := pcommon.NewMap()
+	assert.Equal(t, "", AttributesKey(m))
+	m.PutInt("b", 1)
+	m.PutStr("a", "x")
+	assert.Equal(t, `a="x", b=1`, AttributesKey(m))
+	assert.Equal(t, `[a="x", b=1]`, DescribeKey(AttributesKey(m), 0))
+	assert.Equal(t, "#3", DescribeKey("", 3))
+}
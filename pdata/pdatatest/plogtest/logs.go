@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package plogtest compares plog.Logs in tests, and describes their differences.
+package plogtest // import "go.opentelemetry.io/collector/pdata/pdatatest/plogtest"
+
+import (
+	"go.uber.org/multierr"
+
+	"go.opentelemetry.io/collector/pdata/pdatatest/internal"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+// CompareLogs compares the expected and actual logs, and returns an error listing all their
+// differences, nil if they are equal. Each difference is prefixed with the resource, scope and log
+// record it is found in.
+//
+// The resources are matched by attributes and the scopes by name and version. They are expected in
+// the same order, unless the options ignore it. The log records are matched by index, or by body
+// if their order is ignored.
+func CompareLogs(expected, actual plog.Logs, options ...CompareLogsOption) error {
+	opts := compareOptions{}
+	for _, option := range options {
+		option.apply(&opts)
+	}
+
+	exp, act := plog.NewLogs(), plog.NewLogs()
+	expected.CopyTo(exp)
+	actual.CopyTo(act)
+	for _, fn := range opts.transforms {
+		fn(exp)
+		fn(act)
+	}
+
+	expRLS, actRLS := exp.ResourceLogs(), act.ResourceLogs()
+	return internal.CompareElements("resource", expRLS.Len(), actRLS.Len(),
+		func(i int) string { return internal.AttributesKey(expRLS.At(i).Resource().Attributes()) },
+		func(i int) string { return internal.AttributesKey(actRLS.At(i).Resource().Attributes()) },
+		internal.DescribeKey,
+		opts.ignoreResourceLogsOrder,
+		func(i, j int) error { return compareResourceLogs(expRLS.At(i), actRLS.At(j), opts) },
+	)
+}
+
+func compareResourceLogs(expected, actual plog.ResourceLogs, opts compareOptions) error {
+	errs := multierr.Combine(
+		internal.CompareField("schema url", expected.SchemaUrl(), actual.SchemaUrl()),
+		internal.CompareAttributes("attributes", expected.Resource().Attributes(), actual.Resource().Attributes()),
+		internal.CompareField("dropped attributes count", expected.Resource().DroppedAttributesCount(), actual.Resource().DroppedAttributesCount()),
+	)
+
+	expSLS, actSLS := expected.ScopeLogs(), actual.ScopeLogs()
+	return multierr.Append(errs, internal.CompareElements("scope", expSLS.Len(), actSLS.Len(),
+		func(i int) string { return internal.ScopeKey(expSLS.At(i).Scope()) },
+		func(i int) string { return internal.ScopeKey(actSLS.At(i).Scope()) },
+		func(key string, _ int) string { return key },
+		opts.ignoreScopeLogsOrder,
+		func(i, j int) error { return compareScopeLogs(expSLS.At(i), actSLS.At(j), opts) },
+	))
+}
+
+func compareScopeLogs(expected, actual plog.ScopeLogs, opts compareOptions) error {
+	errs := multierr.Combine(
+		internal.CompareField("schema url", expected.SchemaUrl(), actual.SchemaUrl()),
+		internal.CompareAttributes("attributes", expected.Scope().Attributes(), actual.Scope().Attributes()),
+		internal.CompareField("dropped attributes count", expected.Scope().DroppedAttributesCount(), actual.Scope().DroppedAttributesCount()),
+	)
+
+	// Log records have no identity, they are matched by index unless their order is ignored.
+	expLRS, actLRS := expected.LogRecords(), actual.LogRecords()
+	expectedKey, actualKey := func(int) string { return "" }, func(int) string { return "" }
+	if opts.ignoreLogRecordsOrder {
+		expectedKey = func(i int) string { return internal.FormatValue(expLRS.At(i).Body()) }
+		actualKey = func(i int) string { return internal.FormatValue(actLRS.At(i).Body()) }
+	}
+	return multierr.Append(errs, internal.CompareElements("log record", expLRS.Len(), actLRS.Len(),
+		expectedKey,
+		actualKey,
+		internal.DescribeKey,
+		opts.ignoreLogRecordsOrder,
+		func(i, j int) error { return compareLogRecord(expLRS.At(i), actLRS.At(j)) },
+	))
+}
+
+func compareLogRecord(expected, actual plog.LogRecord) error {
+	return multierr.Combine(
+		internal.CompareField("timestamp", expected.Timestamp(), actual.Timestamp()),
+		internal.CompareField("observed timestamp", expected.ObservedTimestamp(), actual.ObservedTimestamp()),
+		internal.CompareField("severity number", expected.SeverityNumber(), actual.SeverityNumber()),
+		internal.CompareField("severity text", expected.SeverityText(), actual.SeverityText()),
+		internal.CompareAttributes("attributes", expected.Attributes(), actual.Attributes()),
+		internal.CompareField("dropped attributes count", expected.DroppedAttributesCount(), actual.DroppedAttributesCount()),
+		internal.CompareField("flags", expected.Flags(), actual.Flags()),
+		internal.CompareField("trace id", expected.TraceID(), actual.TraceID()),
+		internal.CompareField("span id", expected.SpanID(), actual.SpanID()),
+		internal.CompareValue("body", expected.Body(), actual.Body()),
+	)
+}
@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package plogtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/multierr"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func newLogs() plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "frontend")
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("instrumentation")
+	for _, body := range []string{"started", "stopped"} {
+		lr := sl.LogRecords().AppendEmpty()
+		lr.Body().SetStr(body)
+		lr.SetTimestamp(1000)
+		lr.SetObservedTimestamp(2000)
+		lr.SetSeverityNumber(plog.SeverityNumberInfo)
+		lr.Attributes().PutStr("component", "server")
+	}
+	return ld
+}
+
+func TestCompareLogs(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(plog.Logs)
+		options []CompareLogsOption
+		errs    []string
+	}{
+		{
+			name:   "equal",
+			mutate: func(plog.Logs) {},
+		},
+		{
+			name: "log record fields",
+			mutate: func(ld plog.Logs) {
+				lr := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(1)
+				lr.Body().SetInt(1)
+				lr.SetSeverityNumber(plog.SeverityNumberError)
+				lr.Attributes().PutStr("component", "client")
+			},
+			errs: []string{
+				`resource [service.name="frontend"]: scope "instrumentation": log record #1: severity number: expected Info, got Error`,
+				`resource [service.name="frontend"]: scope "instrumentation": log record #1: attributes: attribute "component": expected "server", got "client"`,
+				`resource [service.name="frontend"]: scope "instrumentation": log record #1: body: expected "stopped", got 1`,
+			},
+		},
+		{
+			name: "missing and unexpected elements",
+			mutate: func(ld plog.Logs) {
+				ld.ResourceLogs().At(0).ScopeLogs().At(0).Scope().SetVersion("1.0")
+				ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+			},
+			errs: []string{
+				`resource [service.name="frontend"]: missing expected scope "instrumentation"`,
+				`resource [service.name="frontend"]: unexpected scope "instrumentation" version "1.0"`,
+				`unexpected resource #1`,
+			},
+		},
+		{
+			name: "order",
+			mutate: func(ld plog.Logs) {
+				lrs := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+				lrs.At(0).Body().SetStr("stopped")
+				lrs.At(1).Body().SetStr("started")
+			},
+			errs: []string{
+				`resource [service.name="frontend"]: scope "instrumentation": log record #0: body: expected "started", got "stopped"`,
+				`resource [service.name="frontend"]: scope "instrumentation": log record #1: body: expected "stopped", got "started"`,
+			},
+		},
+		{
+			name: "ignored order",
+			mutate: func(ld plog.Logs) {
+				lrs := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+				lrs.At(0).Body().SetStr("stopped")
+				lrs.At(1).Body().SetStr("started")
+			},
+			options: []CompareLogsOption{IgnoreLogRecordsOrder()},
+		},
+		{
+			name: "ignored order with differences",
+			mutate: func(ld plog.Logs) {
+				lrs := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+				lrs.At(0).Body().SetStr("stopped")
+				lrs.At(1).Body().SetStr("failed")
+			},
+			options: []CompareLogsOption{IgnoreLogRecordsOrder()},
+			errs: []string{
+				`resource [service.name="frontend"]: scope "instrumentation": missing expected log record ["started"]`,
+				`resource [service.name="frontend"]: scope "instrumentation": unexpected log record ["failed"]`,
+			},
+		},
+		{
+			name: "ignored timestamps and attributes",
+			mutate: func(ld plog.Logs) {
+				rangeLogRecords(ld, func(lr plog.LogRecord) {
+					lr.SetTimestamp(3000)
+					lr.SetObservedTimestamp(4000)
+					lr.Attributes().PutStr("component", "client")
+				})
+				ld.ResourceLogs().At(0).Resource().Attributes().PutStr("host.name", "host")
+			},
+			options: []CompareLogsOption{
+				IgnoreTimestamp(), IgnoreObservedTimestamp(),
+				IgnoreLogRecordAttributes("component"), IgnoreResourceAttributes("host.name"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := newLogs()
+			actual := newLogs()
+			tt.mutate(actual)
+			err := CompareLogs(expected, actual, tt.options...)
+			var msgs []string
+			for _, e := range multierr.Errors(err) {
+				msgs = append(msgs, e.Error())
+			}
+			assert.ElementsMatch(t, tt.errs, msgs)
+		})
+	}
+}
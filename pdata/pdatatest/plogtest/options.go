@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package plogtest // import "go.opentelemetry.io/collector/pdata/pdatatest/plogtest"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pdatatest/internal"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+// CompareLogsOption changes how CompareLogs compares the logs.
+type CompareLogsOption interface {
+	apply(*compareOptions)
+}
+
+type compareOptions struct {
+	ignoreResourceLogsOrder bool
+	ignoreScopeLogsOrder    bool
+	ignoreLogRecordsOrder   bool
+	// transforms are applied to copies of both the expected and actual logs before comparing them.
+	transforms []func(plog.Logs)
+}
+
+type compareOptionFunc func(*compareOptions)
+
+func (fn compareOptionFunc) apply(opts *compareOptions) {
+	fn(opts)
+}
+
+func transform(fn func(plog.Logs)) CompareLogsOption {
+	return compareOptionFunc(func(opts *compareOptions) {
+		opts.transforms = append(opts.transforms, fn)
+	})
+}
+
+// IgnoreResourceLogsOrder ignores the order of the resource logs.
+func IgnoreResourceLogsOrder() CompareLogsOption {
+	return compareOptionFunc(func(opts *compareOptions) {
+		opts.ignoreResourceLogsOrder = true
+	})
+}
+
+// IgnoreScopeLogsOrder ignores the order of the scope logs within each resource logs.
+func IgnoreScopeLogsOrder() CompareLogsOption {
+	return compareOptionFunc(func(opts *compareOptions) {
+		opts.ignoreScopeLogsOrder = true
+	})
+}
+
+// IgnoreLogRecordsOrder ignores the order of the log records within each scope logs. The log records
+// are then matched by body instead of by index.
+func IgnoreLogRecordsOrder() CompareLogsOption {
+	return compareOptionFunc(func(opts *compareOptions) {
+		opts.ignoreLogRecordsOrder = true
+	})
+}
+
+// IgnoreTimestamp ignores the timestamp of the log records.
+func IgnoreTimestamp() CompareLogsOption {
+	return transform(func(ld plog.Logs) {
+		rangeLogRecords(ld, func(lr plog.LogRecord) {
+			lr.SetTimestamp(0)
+		})
+	})
+}
+
+// IgnoreObservedTimestamp ignores the observed timestamp of the log records.
+func IgnoreObservedTimestamp() CompareLogsOption {
+	return transform(func(ld plog.Logs) {
+		rangeLogRecords(ld, func(lr plog.LogRecord) {
+			lr.SetObservedTimestamp(0)
+		})
+	})
+}
+
+// IgnoreResourceAttributes ignores the resource attributes with the given names.
+func IgnoreResourceAttributes(names ...string) CompareLogsOption {
+	return transform(func(ld plog.Logs) {
+		for i := 0; i < ld.ResourceLogs().Len(); i++ {
+			internal.RemoveAttributes(ld.ResourceLogs().At(i).Resource().Attributes(), names)
+		}
+	})
+}
+
+// IgnoreLogRecordAttributes ignores the log record attributes with the given names.
+func IgnoreLogRecordAttributes(names ...string) CompareLogsOption {
+	return transform(func(ld plog.Logs) {
+		rangeLogRecords(ld, func(lr plog.LogRecord) {
+			internal.RemoveAttributes(lr.Attributes(), names)
+		})
+	})
+}
+
+func rangeLogRecords(ld plog.Logs, fn func(plog.LogRecord)) {
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		sls := ld.ResourceLogs().At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			lrs := sls.At(j).LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				fn(lrs.At(k))
+			}
+		}
+	}
+}
@@ -0,0 +1,264 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package pmetrictest compares pmetric.Metrics in tests, and describes their differences.
+package pmetrictest // import "go.opentelemetry.io/collector/pdata/pdatatest/pmetrictest"
+
+import (
+	"strconv"
+
+	"go.uber.org/multierr"
+
+	"go.opentelemetry.io/collector/pdata/pdatatest/internal"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// CompareMetrics compares the expected and actual metrics, and returns an error listing all their
+// differences, nil if they are equal. Each difference is prefixed with the resource, scope, metric
+// and data point it is found in.
+//
+// The resources are matched by attributes, the scopes by name and version, the metrics by name,
+// and the data points by attributes. They are expected in the same order, unless the options
+// ignore it.
+func CompareMetrics(expected, actual pmetric.Metrics, options ...CompareMetricsOption) error {
+	opts := compareOptions{}
+	for _, option := range options {
+		option.apply(&opts)
+	}
+
+	exp, act := pmetric.NewMetrics(), pmetric.NewMetrics()
+	expected.CopyTo(exp)
+	actual.CopyTo(act)
+	for _, fn := range opts.transforms {
+		fn(exp)
+		fn(act)
+	}
+
+	expRMS, actRMS := exp.ResourceMetrics(), act.ResourceMetrics()
+	return internal.CompareElements("resource", expRMS.Len(), actRMS.Len(),
+		func(i int) string { return internal.AttributesKey(expRMS.At(i).Resource().Attributes()) },
+		func(i int) string { return internal.AttributesKey(actRMS.At(i).Resource().Attributes()) },
+		internal.DescribeKey,
+		opts.ignoreResourceMetricsOrder,
+		func(i, j int) error { return compareResourceMetrics(expRMS.At(i), actRMS.At(j), opts) },
+	)
+}
+
+func compareResourceMetrics(expected, actual pmetric.ResourceMetrics, opts compareOptions) error {
+	errs := multierr.Combine(
+		internal.CompareField("schema url", expected.SchemaUrl(), actual.SchemaUrl()),
+		internal.CompareAttributes("attributes", expected.Resource().Attributes(), actual.Resource().Attributes()),
+		internal.CompareField("dropped attributes count", expected.Resource().DroppedAttributesCount(), actual.Resource().DroppedAttributesCount()),
+	)
+
+	expSMS, actSMS := expected.ScopeMetrics(), actual.ScopeMetrics()
+	return multierr.Append(errs, internal.CompareElements("scope", expSMS.Len(), actSMS.Len(),
+		func(i int) string { return internal.ScopeKey(expSMS.At(i).Scope()) },
+		func(i int) string { return internal.ScopeKey(actSMS.At(i).Scope()) },
+		func(key string, _ int) string { return key },
+		opts.ignoreScopeMetricsOrder,
+		func(i, j int) error { return compareScopeMetrics(expSMS.At(i), actSMS.At(j), opts) },
+	))
+}
+
+func compareScopeMetrics(expected, actual pmetric.ScopeMetrics, opts compareOptions) error {
+	errs := multierr.Combine(
+		internal.CompareField("schema url", expected.SchemaUrl(), actual.SchemaUrl()),
+		internal.CompareAttributes("attributes", expected.Scope().Attributes(), actual.Scope().Attributes()),
+		internal.CompareField("dropped attributes count", expected.Scope().DroppedAttributesCount(), actual.Scope().DroppedAttributesCount()),
+	)
+
+	expMetrics, actMetrics := expected.Metrics(), actual.Metrics()
+	return multierr.Append(errs, internal.CompareElements("metric", expMetrics.Len(), actMetrics.Len(),
+		func(i int) string { return strconv.Quote(expMetrics.At(i).Name()) },
+		func(i int) string { return strconv.Quote(actMetrics.At(i).Name()) },
+		func(key string, _ int) string { return key },
+		opts.ignoreMetricsOrder,
+		func(i, j int) error { return compareMetric(expMetrics.At(i), actMetrics.At(j), opts) },
+	))
+}
+
+func compareMetric(expected, actual pmetric.Metric, opts compareOptions) error {
+	errs := multierr.Combine(
+		internal.CompareField("description", expected.Description(), actual.Description()),
+		internal.CompareField("unit", expected.Unit(), actual.Unit()),
+	)
+	if err := internal.CompareField("type", expected.Type(), actual.Type()); err != nil {
+		// The data points of different types cannot be compared.
+		return multierr.Append(errs, err)
+	}
+
+	switch expected.Type() {
+	case pmetric.MetricTypeGauge:
+		expDPS, actDPS := expected.Gauge().DataPoints(), actual.Gauge().DataPoints()
+		errs = multierr.Append(errs, compareDataPoints(expDPS.Len(), actDPS.Len(),
+			func(i int) dataPoint { return expDPS.At(i) },
+			func(i int) dataPoint { return actDPS.At(i) },
+			opts,
+			func(i, j int) error { return compareNumberDataPoint(expDPS.At(i), actDPS.At(j)) },
+		))
+	case pmetric.MetricTypeSum:
+		errs = multierr.Combine(errs,
+			internal.CompareField("aggregation temporality", expected.Sum().AggregationTemporality(), actual.Sum().AggregationTemporality()),
+			internal.CompareField("is monotonic", expected.Sum().IsMonotonic(), actual.Sum().IsMonotonic()),
+		)
+		expDPS, actDPS := expected.Sum().DataPoints(), actual.Sum().DataPoints()
+		errs = multierr.Append(errs, compareDataPoints(expDPS.Len(), actDPS.Len(),
+			func(i int) dataPoint { return expDPS.At(i) },
+			func(i int) dataPoint { return actDPS.At(i) },
+			opts,
+			func(i, j int) error { return compareNumberDataPoint(expDPS.At(i), actDPS.At(j)) },
+		))
+	case pmetric.MetricTypeHistogram:
+		errs = multierr.Append(errs,
+			internal.CompareField("aggregation temporality", expected.Histogram().AggregationTemporality(), actual.Histogram().AggregationTemporality()))
+		expDPS, actDPS := expected.Histogram().DataPoints(), actual.Histogram().DataPoints()
+		errs = multierr.Append(errs, compareDataPoints(expDPS.Len(), actDPS.Len(),
+			func(i int) dataPoint { return expDPS.At(i) },
+			func(i int) dataPoint { return actDPS.At(i) },
+			opts,
+			func(i, j int) error { return compareHistogramDataPoint(expDPS.At(i), actDPS.At(j)) },
+		))
+	case pmetric.MetricTypeExponentialHistogram:
+		errs = multierr.Append(errs,
+			internal.CompareField("aggregation temporality", expected.ExponentialHistogram().AggregationTemporality(), actual.ExponentialHistogram().AggregationTemporality()))
+		expDPS, actDPS := expected.ExponentialHistogram().DataPoints(), actual.ExponentialHistogram().DataPoints()
+		errs = multierr.Append(errs, compareDataPoints(expDPS.Len(), actDPS.Len(),
+			func(i int) dataPoint { return expDPS.At(i) },
+			func(i int) dataPoint { return actDPS.At(i) },
+			opts,
+			func(i, j int) error { return compareExponentialHistogramDataPoint(expDPS.At(i), actDPS.At(j)) },
+		))
+	case pmetric.MetricTypeSummary:
+		expDPS, actDPS := expected.Summary().DataPoints(), actual.Summary().DataPoints()
+		errs = multierr.Append(errs, compareDataPoints(expDPS.Len(), actDPS.Len(),
+			func(i int) dataPoint { return expDPS.At(i) },
+			func(i int) dataPoint { return actDPS.At(i) },
+			opts,
+			func(i, j int) error { return compareSummaryDataPoint(expDPS.At(i), actDPS.At(j)) },
+		))
+	}
+	return errs
+}
+
+// compareDataPoints matches the data points by attributes, and compares the matching data points with
+// compare. The attributes are part of the key, so they are not compared again.
+func compareDataPoints(expectedLen, actualLen int, expectedAt, actualAt func(int) dataPoint, opts compareOptions, compare func(i, j int) error) error {
+	return internal.CompareElements("data point", expectedLen, actualLen,
+		func(i int) string { return internal.AttributesKey(expectedAt(i).Attributes()) },
+		func(i int) string { return internal.AttributesKey(actualAt(i).Attributes()) },
+		internal.DescribeKey,
+		opts.ignoreDataPointsOrder,
+		compare,
+	)
+}
+
+func compareNumberDataPoint(expected, actual pmetric.NumberDataPoint) error {
+	errs := multierr.Combine(
+		internal.CompareField("start timestamp", expected.StartTimestamp(), actual.StartTimestamp()),
+		internal.CompareField("timestamp", expected.Timestamp(), actual.Timestamp()),
+		internal.CompareField("flags", expected.Flags(), actual.Flags()),
+		compareExemplars(expected.Exemplars(), actual.Exemplars()),
+	)
+	if err := internal.CompareField("value type", expected.ValueType(), actual.ValueType()); err != nil {
+		return multierr.Append(errs, err)
+	}
+	if expected.ValueType() == pmetric.NumberDataPointValueTypeInt {
+		return multierr.Append(errs, internal.CompareField("value", expected.IntValue(), actual.IntValue()))
+	}
+	return multierr.Append(errs, internal.CompareField("value", expected.DoubleValue(), actual.DoubleValue()))
+}
+
+func compareHistogramDataPoint(expected, actual pmetric.HistogramDataPoint) error {
+	return multierr.Combine(
+		internal.CompareField("start timestamp", expected.StartTimestamp(), actual.StartTimestamp()),
+		internal.CompareField("timestamp", expected.Timestamp(), actual.Timestamp()),
+		internal.CompareField("flags", expected.Flags(), actual.Flags()),
+		internal.CompareField("count", expected.Count(), actual.Count()),
+		internal.CompareField("sum", optional(expected.HasSum(), expected.Sum()), optional(actual.HasSum(), actual.Sum())),
+		internal.CompareField("min", optional(expected.HasMin(), expected.Min()), optional(actual.HasMin(), actual.Min())),
+		internal.CompareField("max", optional(expected.HasMax(), expected.Max()), optional(actual.HasMax(), actual.Max())),
+		internal.CompareField("bucket counts", expected.BucketCounts().AsRaw(), actual.BucketCounts().AsRaw()),
+		internal.CompareField("explicit bounds", expected.ExplicitBounds().AsRaw(), actual.ExplicitBounds().AsRaw()),
+		compareExemplars(expected.Exemplars(), actual.Exemplars()),
+	)
+}
+
+func compareExponentialHistogramDataPoint(expected, actual pmetric.ExponentialHistogramDataPoint) error {
+	return multierr.Combine(
+		internal.CompareField("start timestamp", expected.StartTimestamp(), actual.StartTimestamp()),
+		internal.CompareField("timestamp", expected.Timestamp(), actual.Timestamp()),
+		internal.CompareField("flags", expected.Flags(), actual.Flags()),
+		internal.CompareField("count", expected.Count(), actual.Count()),
+		internal.CompareField("sum", optional(expected.HasSum(), expected.Sum()), optional(actual.HasSum(), actual.Sum())),
+		internal.CompareField("min", optional(expected.HasMin(), expected.Min()), optional(actual.HasMin(), actual.Min())),
+		internal.CompareField("max", optional(expected.HasMax(), expected.Max()), optional(actual.HasMax(), actual.Max())),
+		internal.CompareField("scale", expected.Scale(), actual.Scale()),
+		internal.CompareField("zero count", expected.ZeroCount(), actual.ZeroCount()),
+		internal.CompareField("positive offset", expected.Positive().Offset(), actual.Positive().Offset()),
+		internal.CompareField("positive bucket counts", expected.Positive().BucketCounts().AsRaw(), actual.Positive().BucketCounts().AsRaw()),
+		internal.CompareField("negative offset", expected.Negative().Offset(), actual.Negative().Offset()),
+		internal.CompareField("negative bucket counts", expected.Negative().BucketCounts().AsRaw(), actual.Negative().BucketCounts().AsRaw()),
+		compareExemplars(expected.Exemplars(), actual.Exemplars()),
+	)
+}
+
+func compareSummaryDataPoint(expected, actual pmetric.SummaryDataPoint) error {
+	errs := multierr.Combine(
+		internal.CompareField("start timestamp", expected.StartTimestamp(), actual.StartTimestamp()),
+		internal.CompareField("timestamp", expected.Timestamp(), actual.Timestamp()),
+		internal.CompareField("flags", expected.Flags(), actual.Flags()),
+		internal.CompareField("count", expected.Count(), actual.Count()),
+		internal.CompareField("sum", expected.Sum(), actual.Sum()),
+	)
+
+	expQVS, actQVS := expected.QuantileValues(), actual.QuantileValues()
+	return multierr.Append(errs, internal.CompareElements("quantile", expQVS.Len(), actQVS.Len(),
+		func(i int) string { return strconv.FormatFloat(expQVS.At(i).Quantile(), 'g', -1, 64) },
+		func(i int) string { return strconv.FormatFloat(actQVS.At(i).Quantile(), 'g', -1, 64) },
+		func(key string, _ int) string { return key },
+		false,
+		func(i, j int) error {
+			return internal.CompareField("value", expQVS.At(i).Value(), actQVS.At(j).Value())
+		},
+	))
+}
+
+func compareExemplars(expected, actual pmetric.ExemplarSlice) error {
+	return internal.CompareElements("exemplar", expected.Len(), actual.Len(),
+		func(int) string { return "" },
+		func(int) string { return "" },
+		internal.DescribeKey,
+		false,
+		func(i, j int) error {
+			exp, act := expected.At(i), actual.At(j)
+			errs := multierr.Combine(
+				internal.CompareField("timestamp", exp.Timestamp(), act.Timestamp()),
+				internal.CompareAttributes("filtered attributes", exp.FilteredAttributes(), act.FilteredAttributes()),
+				internal.CompareField("trace id", exp.TraceID(), act.TraceID()),
+				internal.CompareField("span id", exp.SpanID(), act.SpanID()),
+			)
+			if err := internal.CompareField("value type", exp.ValueType(), act.ValueType()); err != nil {
+				return multierr.Append(errs, err)
+			}
+			if exp.ValueType() == pmetric.ExemplarValueTypeInt {
+				return multierr.Append(errs, internal.CompareField("value", exp.IntValue(), act.IntValue()))
+			}
+			return multierr.Append(errs, internal.CompareField("value", exp.DoubleValue(), act.DoubleValue()))
+		},
+	)
+}
+
+// unset describes an optional field without value.
+type unset struct{}
+
+func (unset) String() string {
+	return "unset"
+}
+
+func optional(has bool, v float64) any {
+	if !has {
+		return unset{}
+	}
+	return v
+}
@@ -0,0 +1,172 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pmetrictest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/multierr"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func newMetrics() pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "frontend")
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName("instrumentation")
+
+	sum := sm.Metrics().AppendEmpty()
+	sum.SetName("requests")
+	sum.SetUnit("1")
+	sum.SetEmptySum().SetIsMonotonic(true)
+	sum.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	for i, method := range []string{"GET", "POST"} {
+		dp := sum.Sum().DataPoints().AppendEmpty()
+		dp.Attributes().PutStr("method", method)
+		dp.SetStartTimestamp(1000)
+		dp.SetTimestamp(2000)
+		dp.SetIntValue(int64(10 * (i + 1)))
+	}
+
+	hist := sm.Metrics().AppendEmpty()
+	hist.SetName("duration")
+	hist.SetUnit("ms")
+	dp := hist.SetEmptyHistogram().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(1000)
+	dp.SetTimestamp(2000)
+	dp.SetCount(3)
+	dp.SetSum(12.5)
+	dp.BucketCounts().FromRaw([]uint64{1, 2})
+	dp.ExplicitBounds().FromRaw([]float64{5})
+
+	summary := sm.Metrics().AppendEmpty()
+	summary.SetName("latency")
+	sdp := summary.SetEmptySummary().DataPoints().AppendEmpty()
+	sdp.SetCount(3)
+	qv := sdp.QuantileValues().AppendEmpty()
+	qv.SetQuantile(0.5)
+	qv.SetValue(4)
+	return md
+}
+
+func TestCompareMetrics(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(pmetric.Metrics)
+		options []CompareMetricsOption
+		errs    []string
+	}{
+		{
+			name:   "equal",
+			mutate: func(pmetric.Metrics) {},
+		},
+		{
+			name: "data point values",
+			mutate: func(md pmetric.Metrics) {
+				metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+				metrics.At(0).Sum().DataPoints().At(1).SetDoubleValue(20)
+				metrics.At(1).Histogram().DataPoints().At(0).RemoveSum()
+				metrics.At(1).Histogram().DataPoints().At(0).BucketCounts().FromRaw([]uint64{2, 1})
+				metrics.At(2).Summary().DataPoints().At(0).QuantileValues().At(0).SetValue(5)
+			},
+			errs: []string{
+				`resource [service.name="frontend"]: scope "instrumentation": metric "requests": data point [method="POST"]: value type: expected Int, got Double`,
+				`resource [service.name="frontend"]: scope "instrumentation": metric "duration": data point #0: sum: expected 12.5, got unset`,
+				`resource [service.name="frontend"]: scope "instrumentation": metric "duration": data point #0: bucket counts: expected [1 2], got [2 1]`,
+				`resource [service.name="frontend"]: scope "instrumentation": metric "latency": data point #0: quantile 0.5: value: expected 4, got 5`,
+			},
+		},
+		{
+			name: "metric fields",
+			mutate: func(md pmetric.Metrics) {
+				metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+				metrics.At(0).Sum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+				metrics.At(1).SetUnit("s")
+				metrics.At(2).SetEmptyGauge()
+			},
+			errs: []string{
+				`resource [service.name="frontend"]: scope "instrumentation": metric "requests": aggregation temporality: expected Cumulative, got Delta`,
+				`resource [service.name="frontend"]: scope "instrumentation": metric "duration": unit: expected "ms", got "s"`,
+				`resource [service.name="frontend"]: scope "instrumentation": metric "latency": type: expected Summary, got Gauge`,
+			},
+		},
+		{
+			name: "missing and unexpected elements",
+			mutate: func(md pmetric.Metrics) {
+				metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+				metrics.At(0).Sum().DataPoints().At(0).Attributes().PutStr("method", "PUT")
+				metrics.At(2).SetName("other")
+			},
+			errs: []string{
+				`resource [service.name="frontend"]: scope "instrumentation": missing expected metric "latency"`,
+				`resource [service.name="frontend"]: scope "instrumentation": unexpected metric "other"`,
+				`resource [service.name="frontend"]: scope "instrumentation": metric "requests": missing expected data point [method="GET"]`,
+				`resource [service.name="frontend"]: scope "instrumentation": metric "requests": unexpected data point [method="PUT"]`,
+			},
+		},
+		{
+			name: "order",
+			mutate: func(md pmetric.Metrics) {
+				dps := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints()
+				dps.At(0).Attributes().PutStr("method", "POST")
+				dps.At(0).SetIntValue(20)
+				dps.At(1).Attributes().PutStr("method", "GET")
+				dps.At(1).SetIntValue(10)
+			},
+			errs: []string{
+				`resource [service.name="frontend"]: scope "instrumentation": metric "requests": data point [method="GET"]: expected at index 0, found at index 1`,
+				`resource [service.name="frontend"]: scope "instrumentation": metric "requests": data point [method="POST"]: expected at index 1, found at index 0`,
+			},
+		},
+		{
+			name: "ignored order",
+			mutate: func(md pmetric.Metrics) {
+				metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+				reversed := pmetric.NewMetricSlice()
+				for i := metrics.Len() - 1; i >= 0; i-- {
+					metrics.At(i).CopyTo(reversed.AppendEmpty())
+				}
+				reversed.CopyTo(metrics)
+				dps := metrics.At(2).Sum().DataPoints()
+				dps.At(0).Attributes().PutStr("method", "POST")
+				dps.At(0).SetIntValue(20)
+				dps.At(1).Attributes().PutStr("method", "GET")
+				dps.At(1).SetIntValue(10)
+			},
+			options: []CompareMetricsOption{IgnoreMetricsOrder(), IgnoreMetricDataPointsOrder()},
+		},
+		{
+			name: "ignored timestamps and attributes",
+			mutate: func(md pmetric.Metrics) {
+				rangeDataPoints(md, func(dp dataPoint) {
+					dp.SetStartTimestamp(3000)
+					dp.SetTimestamp(4000)
+				})
+				dps := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints()
+				dps.At(0).Attributes().PutStr("host.name", "host")
+				md.ResourceMetrics().At(0).Resource().Attributes().PutStr("host.name", "host")
+			},
+			options: []CompareMetricsOption{
+				IgnoreStartTimestamp(), IgnoreTimestamp(),
+				IgnoreDataPointAttributes("host.name"), IgnoreResourceAttributes("host.name"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := newMetrics()
+			actual := newMetrics()
+			tt.mutate(actual)
+			err := CompareMetrics(expected, actual, tt.options...)
+			var msgs []string
+			for _, e := range multierr.Errors(err) {
+				msgs = append(msgs, e.Error())
+			}
+			assert.ElementsMatch(t, tt.errs, msgs)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pmetrictest // import "go.opentelemetry.io/collector/pdata/pdatatest/pmetrictest"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pdatatest/internal"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// CompareMetricsOption changes how CompareMetrics compares the metrics.
+type CompareMetricsOption interface {
+	apply(*compareOptions)
+}
+
+type compareOptions struct {
+	ignoreResourceMetricsOrder bool
+	ignoreScopeMetricsOrder    bool
+	ignoreMetricsOrder         bool
+	ignoreDataPointsOrder      bool
+	// transforms are applied to copies of both the expected and actual metrics before comparing them.
+	transforms []func(pmetric.Metrics)
+}
+
+type compareOptionFunc func(*compareOptions)
+
+func (fn compareOptionFunc) apply(opts *compareOptions) {
+	fn(opts)
+}
+
+func transform(fn func(pmetric.Metrics)) CompareMetricsOption {
+	return compareOptionFunc(func(opts *compareOptions) {
+		opts.transforms = append(opts.transforms, fn)
+	})
+}
+
+// IgnoreResourceMetricsOrder ignores the order of the resource metrics.
+func IgnoreResourceMetricsOrder() CompareMetricsOption {
+	return compareOptionFunc(func(opts *compareOptions) {
+		opts.ignoreResourceMetricsOrder = true
+	})
+}
+
+// IgnoreScopeMetricsOrder ignores the order of the scope metrics within each resource metrics.
+func IgnoreScopeMetricsOrder() CompareMetricsOption {
+	return compareOptionFunc(func(opts *compareOptions) {
+		opts.ignoreScopeMetricsOrder = true
+	})
+}
+
+// IgnoreMetricsOrder ignores the order of the metrics within each scope metrics.
+func IgnoreMetricsOrder() CompareMetricsOption {
+	return compareOptionFunc(func(opts *compareOptions) {
+		opts.ignoreMetricsOrder = true
+	})
+}
+
+// IgnoreMetricDataPointsOrder ignores the order of the data points within each metric.
+func IgnoreMetricDataPointsOrder() CompareMetricsOption {
+	return compareOptionFunc(func(opts *compareOptions) {
+		opts.ignoreDataPointsOrder = true
+	})
+}
+
+// IgnoreStartTimestamp ignores the start timestamp of the data points.
+func IgnoreStartTimestamp() CompareMetricsOption {
+	return transform(func(md pmetric.Metrics) {
+		rangeDataPoints(md, func(dp dataPoint) {
+			dp.SetStartTimestamp(0)
+		})
+	})
+}
+
+// IgnoreTimestamp ignores the timestamp of the data points.
+func IgnoreTimestamp() CompareMetricsOption {
+	return transform(func(md pmetric.Metrics) {
+		rangeDataPoints(md, func(dp dataPoint) {
+			dp.SetTimestamp(0)
+		})
+	})
+}
+
+// IgnoreResourceAttributes ignores the resource attributes with the given names.
+func IgnoreResourceAttributes(names ...string) CompareMetricsOption {
+	return transform(func(md pmetric.Metrics) {
+		for i := 0; i < md.ResourceMetrics().Len(); i++ {
+			internal.RemoveAttributes(md.ResourceMetrics().At(i).Resource().Attributes(), names)
+		}
+	})
+}
+
+// IgnoreDataPointAttributes ignores the data point attributes with the given names. The data points
+// are matched without these attributes, so data points differing only by them must be unique.
+func IgnoreDataPointAttributes(names ...string) CompareMetricsOption {
+	return transform(func(md pmetric.Metrics) {
+		rangeDataPoints(md, func(dp dataPoint) {
+			internal.RemoveAttributes(dp.Attributes(), names)
+		})
+	})
+}
+
+// dataPoint is implemented by the data points of all the metric types.
+type dataPoint interface {
+	Attributes() pcommon.Map
+	SetStartTimestamp(pcommon.Timestamp)
+	SetTimestamp(pcommon.Timestamp)
+}
+
+func rangeDataPoints(md pmetric.Metrics, fn func(dataPoint)) {
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		sms := md.ResourceMetrics().At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			metrics := sms.At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				rangeMetricDataPoints(metrics.At(k), fn)
+			}
+		}
+	}
+}
+
+func rangeMetricDataPoints(metric pmetric.Metric, fn func(dataPoint)) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		for i := 0; i < metric.Gauge().DataPoints().Len(); i++ {
+			fn(metric.Gauge().DataPoints().At(i))
+		}
+	case pmetric.MetricTypeSum:
+		for i := 0; i < metric.Sum().DataPoints().Len(); i++ {
+			fn(metric.Sum().DataPoints().At(i))
+		}
+	case pmetric.MetricTypeHistogram:
+		for i := 0; i < metric.Histogram().DataPoints().Len(); i++ {
+			fn(metric.Histogram().DataPoints().At(i))
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		for i := 0; i < metric.ExponentialHistogram().DataPoints().Len(); i++ {
+			fn(metric.ExponentialHistogram().DataPoints().At(i))
+		}
+	case pmetric.MetricTypeSummary:
+		for i := 0; i < metric.Summary().DataPoints().Len(); i++ {
+			fn(metric.Summary().DataPoints().At(i))
+		}
+	}
+}
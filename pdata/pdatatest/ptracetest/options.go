@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ptracetest // import "go.opentelemetry.io/collector/pdata/pdatatest/ptracetest"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pdatatest/internal"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// CompareTracesOption changes how CompareTraces compares the traces.
+type CompareTracesOption interface {
+	apply(*compareOptions)
+}
+
+type compareOptions struct {
+	ignoreResourceSpansOrder bool
+	ignoreScopeSpansOrder    bool
+	ignoreSpansOrder         bool
+	// transforms are applied to copies of both the expected and actual traces before comparing them.
+	transforms []func(ptrace.Traces)
+}
+
+type compareOptionFunc func(*compareOptions)
+
+func (fn compareOptionFunc) apply(opts *compareOptions) {
+	fn(opts)
+}
+
+func transform(fn func(ptrace.Traces)) CompareTracesOption {
+	return compareOptionFunc(func(opts *compareOptions) {
+		opts.transforms = append(opts.transforms, fn)
+	})
+}
+
+// IgnoreResourceSpansOrder ignores the order of the resource spans.
+func IgnoreResourceSpansOrder() CompareTracesOption {
+	return compareOptionFunc(func(opts *compareOptions) {
+		opts.ignoreResourceSpansOrder = true
+	})
+}
+
+// IgnoreScopeSpansOrder ignores the order of the scope spans within each resource spans.
+func IgnoreScopeSpansOrder() CompareTracesOption {
+	return compareOptionFunc(func(opts *compareOptions) {
+		opts.ignoreScopeSpansOrder = true
+	})
+}
+
+// IgnoreSpansOrder ignores the order of the spans within each scope spans.
+func IgnoreSpansOrder() CompareTracesOption {
+	return compareOptionFunc(func(opts *compareOptions) {
+		opts.ignoreSpansOrder = true
+	})
+}
+
+// IgnoreStartTimestamp ignores the start timestamp of the spans.
+func IgnoreStartTimestamp() CompareTracesOption {
+	return transform(func(td ptrace.Traces) {
+		rangeSpans(td, func(span ptrace.Span) {
+			span.SetStartTimestamp(0)
+		})
+	})
+}
+
+// IgnoreEndTimestamp ignores the end timestamp of the spans.
+func IgnoreEndTimestamp() CompareTracesOption {
+	return transform(func(td ptrace.Traces) {
+		rangeSpans(td, func(span ptrace.Span) {
+			span.SetEndTimestamp(0)
+		})
+	})
+}
+
+// IgnoreEventsTimestamp ignores the timestamp of the span events.
+func IgnoreEventsTimestamp() CompareTracesOption {
+	return transform(func(td ptrace.Traces) {
+		rangeSpans(td, func(span ptrace.Span) {
+			for i := 0; i < span.Events().Len(); i++ {
+				span.Events().At(i).SetTimestamp(0)
+			}
+		})
+	})
+}
+
+// IgnoreIDs ignores the trace, span and parent span ids of the spans and span links. The spans are
+// then matched by name only.
+func IgnoreIDs() CompareTracesOption {
+	return transform(func(td ptrace.Traces) {
+		rangeSpans(td, func(span ptrace.Span) {
+			span.SetTraceID(pcommon.NewTraceIDEmpty())
+			span.SetSpanID(pcommon.NewSpanIDEmpty())
+			span.SetParentSpanID(pcommon.NewSpanIDEmpty())
+			for i := 0; i < span.Links().Len(); i++ {
+				span.Links().At(i).SetTraceID(pcommon.NewTraceIDEmpty())
+				span.Links().At(i).SetSpanID(pcommon.NewSpanIDEmpty())
+			}
+		})
+	})
+}
+
+// IgnoreResourceAttributes ignores the resource attributes with the given names.
+func IgnoreResourceAttributes(names ...string) CompareTracesOption {
+	return transform(func(td ptrace.Traces) {
+		for i := 0; i < td.ResourceSpans().Len(); i++ {
+			internal.RemoveAttributes(td.ResourceSpans().At(i).Resource().Attributes(), names)
+		}
+	})
+}
+
+// IgnoreSpanAttributes ignores the span attributes with the given names.
+func IgnoreSpanAttributes(names ...string) CompareTracesOption {
+	return transform(func(td ptrace.Traces) {
+		rangeSpans(td, func(span ptrace.Span) {
+			internal.RemoveAttributes(span.Attributes(), names)
+		})
+	})
+}
+
+func rangeSpans(td ptrace.Traces, fn func(ptrace.Span)) {
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		sss := td.ResourceSpans().At(i).ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			spans := sss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				fn(spans.At(k))
+			}
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ptracetest compares ptrace.Traces in tests, and describes their differences.
+package ptracetest // import "go.opentelemetry.io/collector/pdata/pdatatest/ptracetest"
+
+import (
+	"strconv"
+
+	"go.uber.org/multierr"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pdatatest/internal"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// CompareTraces compares the expected and actual traces, and returns an error listing all their
+// differences, nil if they are equal. Each difference is prefixed with the resource, scope and span
+// it is found in.
+//
+// The resources are matched by attributes, the scopes by name and version, and the spans by
+// name, trace id and span id. They are expected in the same order, unless the options ignore it.
+func CompareTraces(expected, actual ptrace.Traces, options ...CompareTracesOption) error {
+	opts := compareOptions{}
+	for _, option := range options {
+		option.apply(&opts)
+	}
+
+	exp, act := ptrace.NewTraces(), ptrace.NewTraces()
+	expected.CopyTo(exp)
+	actual.CopyTo(act)
+	for _, fn := range opts.transforms {
+		fn(exp)
+		fn(act)
+	}
+
+	expRSS, actRSS := exp.ResourceSpans(), act.ResourceSpans()
+	return internal.CompareElements("resource", expRSS.Len(), actRSS.Len(),
+		func(i int) string { return internal.AttributesKey(expRSS.At(i).Resource().Attributes()) },
+		func(i int) string { return internal.AttributesKey(actRSS.At(i).Resource().Attributes()) },
+		internal.DescribeKey,
+		opts.ignoreResourceSpansOrder,
+		func(i, j int) error { return compareResourceSpans(expRSS.At(i), actRSS.At(j), opts) },
+	)
+}
+
+func compareResourceSpans(expected, actual ptrace.ResourceSpans, opts compareOptions) error {
+	errs := multierr.Combine(
+		internal.CompareField("schema url", expected.SchemaUrl(), actual.SchemaUrl()),
+		internal.CompareAttributes("attributes", expected.Resource().Attributes(), actual.Resource().Attributes()),
+		internal.CompareField("dropped attributes count", expected.Resource().DroppedAttributesCount(), actual.Resource().DroppedAttributesCount()),
+	)
+
+	expSSS, actSSS := expected.ScopeSpans(), actual.ScopeSpans()
+	return multierr.Append(errs, internal.CompareElements("scope", expSSS.Len(), actSSS.Len(),
+		func(i int) string { return internal.ScopeKey(expSSS.At(i).Scope()) },
+		func(i int) string { return internal.ScopeKey(actSSS.At(i).Scope()) },
+		func(key string, _ int) string { return key },
+		opts.ignoreScopeSpansOrder,
+		func(i, j int) error { return compareScopeSpans(expSSS.At(i), actSSS.At(j), opts) },
+	))
+}
+
+func compareScopeSpans(expected, actual ptrace.ScopeSpans, opts compareOptions) error {
+	errs := multierr.Combine(
+		internal.CompareField("schema url", expected.SchemaUrl(), actual.SchemaUrl()),
+		internal.CompareAttributes("attributes", expected.Scope().Attributes(), actual.Scope().Attributes()),
+		internal.CompareField("dropped attributes count", expected.Scope().DroppedAttributesCount(), actual.Scope().DroppedAttributesCount()),
+	)
+
+	expSpans, actSpans := expected.Spans(), actual.Spans()
+	return multierr.Append(errs, internal.CompareElements("span", expSpans.Len(), actSpans.Len(),
+		func(i int) string { return spanKey(expSpans.At(i)) },
+		func(i int) string { return spanKey(actSpans.At(i)) },
+		func(key string, _ int) string { return key },
+		opts.ignoreSpansOrder,
+		func(i, j int) error { return compareSpan(expSpans.At(i), actSpans.At(j)) },
+	))
+}
+
+func compareSpan(expected, actual ptrace.Span) error {
+	errs := multierr.Combine(
+		internal.CompareField("parent span id", expected.ParentSpanID(), actual.ParentSpanID()),
+		internal.CompareField("trace state", expected.TraceState().AsRaw(), actual.TraceState().AsRaw()),
+		internal.CompareField("kind", expected.Kind(), actual.Kind()),
+		internal.CompareField("start timestamp", expected.StartTimestamp(), actual.StartTimestamp()),
+		internal.CompareField("end timestamp", expected.EndTimestamp(), actual.EndTimestamp()),
+		internal.CompareAttributes("attributes", expected.Attributes(), actual.Attributes()),
+		internal.CompareField("dropped attributes count", expected.DroppedAttributesCount(), actual.DroppedAttributesCount()),
+		internal.CompareField("dropped events count", expected.DroppedEventsCount(), actual.DroppedEventsCount()),
+		internal.CompareField("dropped links count", expected.DroppedLinksCount(), actual.DroppedLinksCount()),
+		internal.CompareField("status code", expected.Status().Code(), actual.Status().Code()),
+		internal.CompareField("status message", expected.Status().Message(), actual.Status().Message()),
+	)
+
+	expEvents, actEvents := expected.Events(), actual.Events()
+	errs = multierr.Append(errs, internal.CompareElements("event", expEvents.Len(), actEvents.Len(),
+		func(i int) string { return strconv.Quote(expEvents.At(i).Name()) },
+		func(i int) string { return strconv.Quote(actEvents.At(i).Name()) },
+		func(key string, _ int) string { return key },
+		false,
+		func(i, j int) error {
+			return multierr.Combine(
+				internal.CompareField("timestamp", expEvents.At(i).Timestamp(), actEvents.At(j).Timestamp()),
+				internal.CompareAttributes("attributes", expEvents.At(i).Attributes(), actEvents.At(j).Attributes()),
+				internal.CompareField("dropped attributes count", expEvents.At(i).DroppedAttributesCount(), actEvents.At(j).DroppedAttributesCount()),
+			)
+		},
+	))
+
+	expLinks, actLinks := expected.Links(), actual.Links()
+	return multierr.Append(errs, internal.CompareElements("link", expLinks.Len(), actLinks.Len(),
+		func(i int) string { return idsKey(expLinks.At(i).TraceID(), expLinks.At(i).SpanID()) },
+		func(i int) string { return idsKey(actLinks.At(i).TraceID(), actLinks.At(i).SpanID()) },
+		internal.DescribeKey,
+		false,
+		func(i, j int) error {
+			return multierr.Combine(
+				internal.CompareField("trace state", expLinks.At(i).TraceState().AsRaw(), actLinks.At(j).TraceState().AsRaw()),
+				internal.CompareAttributes("attributes", expLinks.At(i).Attributes(), actLinks.At(j).Attributes()),
+				internal.CompareField("dropped attributes count", expLinks.At(i).DroppedAttributesCount(), actLinks.At(j).DroppedAttributesCount()),
+			)
+		},
+	))
+}
+
+// spanKey identifies a span by name, trace id and span id.
+func spanKey(span ptrace.Span) string {
+	key := strconv.Quote(span.Name())
+	if ids := idsKey(span.TraceID(), span.SpanID()); ids != "" {
+		key += " [" + ids + "]"
+	}
+	return key
+}
+
+func idsKey(traceID pcommon.TraceID, spanID pcommon.SpanID) string {
+	if traceID.IsEmpty() && spanID.IsEmpty() {
+		return ""
+	}
+	return "trace_id=" + traceID.String() + ", span_id=" + spanID.String()
+}
@@ -0,0 +1,193 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ptracetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/multierr"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func newTraces() ptrace.Traces {
+	td := ptrace.NewTraces()
+	for _, service := range []string{"frontend", "backend"} {
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("service.name", service)
+		rs.Resource().Attributes().PutStr("host.name", "host-"+service)
+		ss := rs.ScopeSpans().AppendEmpty()
+		ss.Scope().SetName("instrumentation")
+		ss.Scope().SetVersion("1.0")
+		for i, name := range []string{"GET /", "SELECT"} {
+			span := ss.Spans().AppendEmpty()
+			span.SetName(name)
+			span.SetTraceID([16]byte{1})
+			span.SetSpanID([8]byte{byte(i + 1)})
+			span.SetKind(ptrace.SpanKindServer)
+			span.SetStartTimestamp(1000)
+			span.SetEndTimestamp(2000)
+			span.Attributes().PutStr("http.method", "GET")
+			span.Attributes().PutInt("http.status_code", 200)
+			event := span.Events().AppendEmpty()
+			event.SetName("exception")
+			event.SetTimestamp(1500)
+			span.Status().SetCode(ptrace.StatusCodeOk)
+		}
+	}
+	return td
+}
+
+func TestCompareTraces(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(ptrace.Traces)
+		options []CompareTracesOption
+		errs    []string
+	}{
+		{
+			name:   "equal",
+			mutate: func(ptrace.Traces) {},
+		},
+		{
+			name: "span attribute",
+			mutate: func(td ptrace.Traces) {
+				attrs := td.ResourceSpans().At(1).ScopeSpans().At(0).Spans().At(1).Attributes()
+				attrs.PutStr("http.status_code", "200")
+				attrs.Remove("http.method")
+				attrs.PutBool("error", true)
+			},
+			errs: []string{
+				`resource [host.name="host-backend", service.name="backend"]: scope "instrumentation" version "1.0": span "SELECT" [trace_id=01000000000000000000000000000000, span_id=0200000000000000]: attributes: missing expected attribute "http.method"`,
+				`resource [host.name="host-backend", service.name="backend"]: scope "instrumentation" version "1.0": span "SELECT" [trace_id=01000000000000000000000000000000, span_id=0200000000000000]: attributes: attribute "http.status_code": expected 200, got "200"`,
+				`resource [host.name="host-backend", service.name="backend"]: scope "instrumentation" version "1.0": span "SELECT" [trace_id=01000000000000000000000000000000, span_id=0200000000000000]: attributes: unexpected attribute "error"`,
+			},
+		},
+		{
+			name: "span fields",
+			mutate: func(td ptrace.Traces) {
+				span := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+				span.SetKind(ptrace.SpanKindClient)
+				span.Status().SetMessage("message")
+				span.Events().At(0).SetTimestamp(1600)
+			},
+			errs: []string{
+				`resource [host.name="host-frontend", service.name="frontend"]: scope "instrumentation" version "1.0": span "GET /" [trace_id=01000000000000000000000000000000, span_id=0100000000000000]: kind: expected Server, got Client`,
+				`resource [host.name="host-frontend", service.name="frontend"]: scope "instrumentation" version "1.0": span "GET /" [trace_id=01000000000000000000000000000000, span_id=0100000000000000]: status message: expected "", got "message"`,
+				`resource [host.name="host-frontend", service.name="frontend"]: scope "instrumentation" version "1.0": span "GET /" [trace_id=01000000000000000000000000000000, span_id=0100000000000000]: event "exception": timestamp: expected 1970-01-01 00:00:00.0000015 +0000 UTC, got 1970-01-01 00:00:00.0000016 +0000 UTC`,
+			},
+		},
+		{
+			name: "missing and unexpected elements",
+			mutate: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).Resource().Attributes().PutStr("service.name", "other")
+				td.ResourceSpans().At(1).ScopeSpans().At(0).Spans().At(0).SetName("POST /")
+			},
+			errs: []string{
+				`missing expected resource [host.name="host-frontend", service.name="frontend"]`,
+				`unexpected resource [host.name="host-frontend", service.name="other"]`,
+				`resource [host.name="host-backend", service.name="backend"]: scope "instrumentation" version "1.0": missing expected span "GET /" [trace_id=01000000000000000000000000000000, span_id=0100000000000000]`,
+				`resource [host.name="host-backend", service.name="backend"]: scope "instrumentation" version "1.0": unexpected span "POST /" [trace_id=01000000000000000000000000000000, span_id=0100000000000000]`,
+			},
+		},
+		{
+			name: "order",
+			mutate: func(td ptrace.Traces) {
+				reverseSpans(td.ResourceSpans().At(0).ScopeSpans().At(0).Spans())
+			},
+			errs: []string{
+				`resource [host.name="host-frontend", service.name="frontend"]: scope "instrumentation" version "1.0": span "GET /" [trace_id=01000000000000000000000000000000, span_id=0100000000000000]: expected at index 0, found at index 1`,
+				`resource [host.name="host-frontend", service.name="frontend"]: scope "instrumentation" version "1.0": span "SELECT" [trace_id=01000000000000000000000000000000, span_id=0200000000000000]: expected at index 1, found at index 0`,
+			},
+		},
+		{
+			name: "ignored spans order",
+			mutate: func(td ptrace.Traces) {
+				reverseSpans(td.ResourceSpans().At(0).ScopeSpans().At(0).Spans())
+			},
+			options: []CompareTracesOption{IgnoreSpansOrder()},
+		},
+		{
+			name: "ignored order",
+			mutate: func(td ptrace.Traces) {
+				rss := ptrace.NewResourceSpansSlice()
+				td.ResourceSpans().At(1).CopyTo(rss.AppendEmpty())
+				td.ResourceSpans().At(0).CopyTo(rss.AppendEmpty())
+				rss.CopyTo(td.ResourceSpans())
+			},
+			options: []CompareTracesOption{IgnoreResourceSpansOrder()},
+		},
+		{
+			name: "ignored timestamps, ids and attributes",
+			mutate: func(td ptrace.Traces) {
+				rangeSpans(td, func(span ptrace.Span) {
+					span.SetStartTimestamp(pcommon.Timestamp(3000))
+					span.SetEndTimestamp(pcommon.Timestamp(4000))
+					span.Events().At(0).SetTimestamp(3500)
+					span.SetTraceID([16]byte{2})
+					span.Attributes().PutStr("http.method", "POST")
+				})
+				td.ResourceSpans().At(0).Resource().Attributes().PutStr("host.name", "other")
+			},
+			options: []CompareTracesOption{
+				IgnoreStartTimestamp(), IgnoreEndTimestamp(), IgnoreEventsTimestamp(), IgnoreIDs(),
+				IgnoreSpanAttributes("http.method"), IgnoreResourceAttributes("host.name"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := newTraces()
+			actual := newTraces()
+			tt.mutate(actual)
+			err := CompareTraces(expected, actual, tt.options...)
+			var msgs []string
+			for _, e := range multierr.Errors(err) {
+				msgs = append(msgs, e.Error())
+			}
+			assert.ElementsMatch(t, tt.errs, msgs)
+		})
+	}
+}
+
+func reverseSpans(spans ptrace.SpanSlice) {
+	reversed := ptrace.NewSpanSlice()
+	for i := spans.Len() - 1; i >= 0; i-- {
+		spans.At(i).CopyTo(reversed.AppendEmpty())
+	}
+	reversed.CopyTo(spans)
+}
+
+func TestCompareTracesNoChange(t *testing.T) {
+	expected := newTraces()
+	actual := newTraces()
+	assert.NoError(t, CompareTraces(expected, actual, IgnoreStartTimestamp()))
+	// The options are applied to copies of the traces.
+	assert.Equal(t, pcommon.Timestamp(1000), expected.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(1000), actual.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).StartTimestamp())
+}
+
+func TestCompareTracesSameKey(t *testing.T) {
+	newSpans := func(paths ...string) ptrace.Traces {
+		td := ptrace.NewTraces()
+		spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+		for _, path := range paths {
+			span := spans.AppendEmpty()
+			span.SetName("GET")
+			span.Attributes().PutStr("http.target", path)
+		}
+		return td
+	}
+
+	// The spans share the same key, they are matched by content when their order is ignored,
+	// and by position otherwise.
+	assert.NoError(t, CompareTraces(newSpans("/a", "/b"), newSpans("/b", "/a"), IgnoreSpansOrder()))
+	assert.EqualError(t, CompareTraces(newSpans("/a", "/b"), newSpans("/b", "/a")),
+		`resource #0: scope "": span "GET": attributes: attribute "http.target": expected "/a", got "/b"; `+
+			`resource #0: scope "": span "GET": attributes: attribute "http.target": expected "/b", got "/a"`)
+	assert.EqualError(t, CompareTraces(newSpans("/a", "/b"), newSpans("/b", "/c"), IgnoreSpansOrder()),
+		`resource #0: scope "": span "GET": attributes: attribute "http.target": expected "/a", got "/c"`)
+}
@@ -3,6 +3,8 @@
 
 package plog // import "go.opentelemetry.io/collector/pdata/plog"
 
+import "io"
+
 // MarshalSizer is the interface that groups the basic Marshal and Size methods
 type MarshalSizer interface {
 	Marshaler
@@ -29,3 +31,18 @@ type Sizer interface {
 	// LogsSize returns the size in bytes of a marshaled Logs.
 	LogsSize(ld Logs) int
 }
+
+// StreamUnmarshaler is an optional interface implemented by the Unmarshaler, that unmarshalls
+// logs read from an io.Reader one ResourceLogs at a time, so that the encoded logs are never
+// entirely held in memory.
+type StreamUnmarshaler interface {
+	// NewResourceLogsDecoder returns a decoder of the ResourceLogs of the logs read from r.
+	NewResourceLogsDecoder(r io.Reader) ResourceLogsDecoder
+}
+
+// ResourceLogsDecoder decodes ResourceLogs one at a time.
+type ResourceLogsDecoder interface {
+	// DecodeResourceLogs returns the next ResourceLogs, or io.EOF once all of them have been decoded.
+	// If the error is not nil, the returned ResourceLogs cannot be used.
+	DecodeResourceLogs() (ResourceLogs, error)
+}
@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package plog
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeAllResourceLogs(decoder ResourceLogsDecoder) (Logs, error) {
+	ld := NewLogs()
+	for {
+		rs, err := decoder.DecodeResourceLogs()
+		if errors.Is(err, io.EOF) {
+			return ld, nil
+		}
+		if err != nil {
+			return ld, err
+		}
+		rs.MoveTo(ld.ResourceLogs().AppendEmpty())
+	}
+}
+
+func TestStreamUnmarshaler(t *testing.T) {
+	ld := NewLogs()
+	for i := 0; i < 3; i++ {
+		rs := ld.ResourceLogs().AppendEmpty()
+		rs.Resource().Attributes().PutInt("index", int64(i))
+		rs.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("log")
+	}
+	logsOTLP.ResourceLogs().At(0).CopyTo(ld.ResourceLogs().AppendEmpty())
+
+	tests := []struct {
+		name        string
+		marshaler   Marshaler
+		unmarshaler StreamUnmarshaler
+	}{
+		{
+			name:        "proto",
+			marshaler:   &ProtoMarshaler{},
+			unmarshaler: &ProtoUnmarshaler{},
+		},
+		{
+			name:        "json",
+			marshaler:   &JSONMarshaler{},
+			unmarshaler: &JSONUnmarshaler{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf, err := tt.marshaler.MarshalLogs(ld)
+			require.NoError(t, err)
+
+			decoded, err := decodeAllResourceLogs(tt.unmarshaler.NewResourceLogsDecoder(iotest.OneByteReader(bytes.NewReader(buf))))
+			require.NoError(t, err)
+			assert.Equal(t, ld, decoded)
+
+			empty, err := tt.marshaler.MarshalLogs(NewLogs())
+			require.NoError(t, err)
+			decoded, err = decodeAllResourceLogs(tt.unmarshaler.NewResourceLogsDecoder(bytes.NewReader(empty)))
+			require.NoError(t, err)
+			assert.Equal(t, NewLogs(), decoded)
+
+			_, err = decodeAllResourceLogs(tt.unmarshaler.NewResourceLogsDecoder(bytes.NewReader(buf[:len(buf)-1])))
+			assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+		})
+	}
+}
+
+func TestJSONStreamUnmarshalerSkipsUnknownFields(t *testing.T) {
+	unmarshaler := &JSONUnmarshaler{}
+	decoded, err := decodeAllResourceLogs(unmarshaler.NewResourceLogsDecoder(strings.NewReader(
+		`{"unknown":[1,{"resourceLogs":[]}],"resource_logs":[{"schemaUrl":"first"}],"resourceLogs":[{"schemaUrl":"second"}]}`)))
+	require.NoError(t, err)
+	require.Equal(t, 2, decoded.ResourceLogs().Len())
+	assert.Equal(t, "first", decoded.ResourceLogs().At(0).SchemaUrl())
+	assert.Equal(t, "second", decoded.ResourceLogs().At(1).SchemaUrl())
+
+	_, err = decodeAllResourceLogs(unmarshaler.NewResourceLogsDecoder(strings.NewReader(`{"resourceLogs":[{"schemaUrl":1}]}`)))
+	assert.Error(t, err)
+}
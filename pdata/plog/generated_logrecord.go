@@ -43,16 +43,6 @@ func (ms LogRecord) MoveTo(dest LogRecord) {
 	*ms.orig = otlplogs.LogRecord{}
 }
 
-// ObservedTimestamp returns the observedtimestamp associated with this LogRecord.
-func (ms LogRecord) ObservedTimestamp() pcommon.Timestamp {
-	return pcommon.Timestamp(ms.orig.ObservedTimeUnixNano)
-}
-
-// SetObservedTimestamp replaces the observedtimestamp associated with this LogRecord.
-func (ms LogRecord) SetObservedTimestamp(v pcommon.Timestamp) {
-	ms.orig.ObservedTimeUnixNano = uint64(v)
-}
-
 // Timestamp returns the timestamp associated with this LogRecord.
 func (ms LogRecord) Timestamp() pcommon.Timestamp {
 	return pcommon.Timestamp(ms.orig.TimeUnixNano)
@@ -63,34 +53,24 @@ func (ms LogRecord) SetTimestamp(v pcommon.Timestamp) {
 	ms.orig.TimeUnixNano = uint64(v)
 }
 
-// TraceID returns the traceid associated with this LogRecord.
-func (ms LogRecord) TraceID() pcommon.TraceID {
-	return pcommon.TraceID(ms.orig.TraceId)
-}
-
-// SetTraceID replaces the traceid associated with this LogRecord.
-func (ms LogRecord) SetTraceID(v pcommon.TraceID) {
-	ms.orig.TraceId = data.TraceID(v)
-}
-
-// SpanID returns the spanid associated with this LogRecord.
-func (ms LogRecord) SpanID() pcommon.SpanID {
-	return pcommon.SpanID(ms.orig.SpanId)
+// ObservedTimestamp returns the observedtimestamp associated with this LogRecord.
+func (ms LogRecord) ObservedTimestamp() pcommon.Timestamp {
+	return pcommon.Timestamp(ms.orig.ObservedTimeUnixNano)
 }
 
-// SetSpanID replaces the spanid associated with this LogRecord.
-func (ms LogRecord) SetSpanID(v pcommon.SpanID) {
-	ms.orig.SpanId = data.SpanID(v)
+// SetObservedTimestamp replaces the observedtimestamp associated with this LogRecord.
+func (ms LogRecord) SetObservedTimestamp(v pcommon.Timestamp) {
+	ms.orig.ObservedTimeUnixNano = uint64(v)
 }
 
-// Flags returns the flags associated with this LogRecord.
-func (ms LogRecord) Flags() LogRecordFlags {
-	return LogRecordFlags(ms.orig.Flags)
+// SeverityNumber returns the severitynumber associated with this LogRecord.
+func (ms LogRecord) SeverityNumber() SeverityNumber {
+	return SeverityNumber(ms.orig.SeverityNumber)
 }
 
-// SetFlags replaces the flags associated with this LogRecord.
-func (ms LogRecord) SetFlags(v LogRecordFlags) {
-	ms.orig.Flags = uint32(v)
+// SetSeverityNumber replaces the severitynumber associated with this LogRecord.
+func (ms LogRecord) SetSeverityNumber(v SeverityNumber) {
+	ms.orig.SeverityNumber = otlplogs.SeverityNumber(v)
 }
 
 // SeverityText returns the severitytext associated with this LogRecord.
@@ -103,16 +83,6 @@ func (ms LogRecord) SetSeverityText(v string) {
 	ms.orig.SeverityText = v
 }
 
-// SeverityNumber returns the severitynumber associated with this LogRecord.
-func (ms LogRecord) SeverityNumber() SeverityNumber {
-	return SeverityNumber(ms.orig.SeverityNumber)
-}
-
-// SetSeverityNumber replaces the severitynumber associated with this LogRecord.
-func (ms LogRecord) SetSeverityNumber(v SeverityNumber) {
-	ms.orig.SeverityNumber = otlplogs.SeverityNumber(v)
-}
-
 // Body returns the body associated with this LogRecord.
 func (ms LogRecord) Body() pcommon.Value {
 	return pcommon.Value(internal.NewValue(&ms.orig.Body))
@@ -133,16 +103,46 @@ func (ms LogRecord) SetDroppedAttributesCount(v uint32) {
 	ms.orig.DroppedAttributesCount = v
 }
 
+// Flags returns the flags associated with this LogRecord.
+func (ms LogRecord) Flags() LogRecordFlags {
+	return LogRecordFlags(ms.orig.Flags)
+}
+
+// SetFlags replaces the flags associated with this LogRecord.
+func (ms LogRecord) SetFlags(v LogRecordFlags) {
+	ms.orig.Flags = uint32(v)
+}
+
+// TraceID returns the traceid associated with this LogRecord.
+func (ms LogRecord) TraceID() pcommon.TraceID {
+	return pcommon.TraceID(ms.orig.TraceId)
+}
+
+// SetTraceID replaces the traceid associated with this LogRecord.
+func (ms LogRecord) SetTraceID(v pcommon.TraceID) {
+	ms.orig.TraceId = data.TraceID(v)
+}
+
+// SpanID returns the spanid associated with this LogRecord.
+func (ms LogRecord) SpanID() pcommon.SpanID {
+	return pcommon.SpanID(ms.orig.SpanId)
+}
+
+// SetSpanID replaces the spanid associated with this LogRecord.
+func (ms LogRecord) SetSpanID(v pcommon.SpanID) {
+	ms.orig.SpanId = data.SpanID(v)
+}
+
 // CopyTo copies all properties from the current struct overriding the destination.
 func (ms LogRecord) CopyTo(dest LogRecord) {
-	dest.SetObservedTimestamp(ms.ObservedTimestamp())
 	dest.SetTimestamp(ms.Timestamp())
-	dest.SetTraceID(ms.TraceID())
-	dest.SetSpanID(ms.SpanID())
-	dest.SetFlags(ms.Flags())
-	dest.SetSeverityText(ms.SeverityText())
+	dest.SetObservedTimestamp(ms.ObservedTimestamp())
 	dest.SetSeverityNumber(ms.SeverityNumber())
+	dest.SetSeverityText(ms.SeverityText())
 	ms.Body().CopyTo(dest.Body())
 	ms.Attributes().CopyTo(dest.Attributes())
 	dest.SetDroppedAttributesCount(ms.DroppedAttributesCount())
+	dest.SetFlags(ms.Flags())
+	dest.SetTraceID(ms.TraceID())
+	dest.SetSpanID(ms.SpanID())
 }
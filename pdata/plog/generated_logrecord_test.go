@@ -35,14 +35,6 @@ func TestLogRecord_CopyTo(t *testing.T) {
 	assert.Equal(t, orig, ms)
 }
 
-func TestLogRecord_ObservedTimestamp(t *testing.T) {
-	ms := NewLogRecord()
-	assert.Equal(t, pcommon.Timestamp(0), ms.ObservedTimestamp())
-	testValObservedTimestamp := pcommon.Timestamp(1234567890)
-	ms.SetObservedTimestamp(testValObservedTimestamp)
-	assert.Equal(t, testValObservedTimestamp, ms.ObservedTimestamp())
-}
-
 func TestLogRecord_Timestamp(t *testing.T) {
 	ms := NewLogRecord()
 	assert.Equal(t, pcommon.Timestamp(0), ms.Timestamp())
@@ -51,28 +43,20 @@ func TestLogRecord_Timestamp(t *testing.T) {
 	assert.Equal(t, testValTimestamp, ms.Timestamp())
 }
 
-func TestLogRecord_TraceID(t *testing.T) {
-	ms := NewLogRecord()
-	assert.Equal(t, pcommon.TraceID(data.TraceID([16]byte{})), ms.TraceID())
-	testValTraceID := pcommon.TraceID(data.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 8, 7, 6, 5, 4, 3, 2, 1}))
-	ms.SetTraceID(testValTraceID)
-	assert.Equal(t, testValTraceID, ms.TraceID())
-}
-
-func TestLogRecord_SpanID(t *testing.T) {
+func TestLogRecord_ObservedTimestamp(t *testing.T) {
 	ms := NewLogRecord()
-	assert.Equal(t, pcommon.SpanID(data.SpanID([8]byte{})), ms.SpanID())
-	testValSpanID := pcommon.SpanID(data.SpanID([8]byte{8, 7, 6, 5, 4, 3, 2, 1}))
-	ms.SetSpanID(testValSpanID)
-	assert.Equal(t, testValSpanID, ms.SpanID())
+	assert.Equal(t, pcommon.Timestamp(0), ms.ObservedTimestamp())
+	testValObservedTimestamp := pcommon.Timestamp(1234567890)
+	ms.SetObservedTimestamp(testValObservedTimestamp)
+	assert.Equal(t, testValObservedTimestamp, ms.ObservedTimestamp())
 }
 
-func TestLogRecord_Flags(t *testing.T) {
+func TestLogRecord_SeverityNumber(t *testing.T) {
 	ms := NewLogRecord()
-	assert.Equal(t, LogRecordFlags(0), ms.Flags())
-	testValFlags := LogRecordFlags(1)
-	ms.SetFlags(testValFlags)
-	assert.Equal(t, testValFlags, ms.Flags())
+	assert.Equal(t, SeverityNumber(otlplogs.SeverityNumber(0)), ms.SeverityNumber())
+	testValSeverityNumber := SeverityNumber(otlplogs.SeverityNumber(5))
+	ms.SetSeverityNumber(testValSeverityNumber)
+	assert.Equal(t, testValSeverityNumber, ms.SeverityNumber())
 }
 
 func TestLogRecord_SeverityText(t *testing.T) {
@@ -82,14 +66,6 @@ func TestLogRecord_SeverityText(t *testing.T) {
 	assert.Equal(t, "INFO", ms.SeverityText())
 }
 
-func TestLogRecord_SeverityNumber(t *testing.T) {
-	ms := NewLogRecord()
-	assert.Equal(t, SeverityNumber(otlplogs.SeverityNumber(0)), ms.SeverityNumber())
-	testValSeverityNumber := SeverityNumber(otlplogs.SeverityNumber(5))
-	ms.SetSeverityNumber(testValSeverityNumber)
-	assert.Equal(t, testValSeverityNumber, ms.SeverityNumber())
-}
-
 func TestLogRecord_Body(t *testing.T) {
 	ms := NewLogRecord()
 	internal.FillTestValue(internal.Value(ms.Body()))
@@ -110,6 +86,30 @@ func TestLogRecord_DroppedAttributesCount(t *testing.T) {
 	assert.Equal(t, uint32(17), ms.DroppedAttributesCount())
 }
 
+func TestLogRecord_Flags(t *testing.T) {
+	ms := NewLogRecord()
+	assert.Equal(t, LogRecordFlags(0), ms.Flags())
+	testValFlags := LogRecordFlags(1)
+	ms.SetFlags(testValFlags)
+	assert.Equal(t, testValFlags, ms.Flags())
+}
+
+func TestLogRecord_TraceID(t *testing.T) {
+	ms := NewLogRecord()
+	assert.Equal(t, pcommon.TraceID(data.TraceID([16]byte{})), ms.TraceID())
+	testValTraceID := pcommon.TraceID(data.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 8, 7, 6, 5, 4, 3, 2, 1}))
+	ms.SetTraceID(testValTraceID)
+	assert.Equal(t, testValTraceID, ms.TraceID())
+}
+
+func TestLogRecord_SpanID(t *testing.T) {
+	ms := NewLogRecord()
+	assert.Equal(t, pcommon.SpanID(data.SpanID([8]byte{})), ms.SpanID())
+	testValSpanID := pcommon.SpanID(data.SpanID([8]byte{8, 7, 6, 5, 4, 3, 2, 1}))
+	ms.SetSpanID(testValSpanID)
+	assert.Equal(t, testValSpanID, ms.SpanID())
+}
+
 func generateTestLogRecord() LogRecord {
 	tv := NewLogRecord()
 	fillTestLogRecord(tv)
@@ -117,14 +117,14 @@ func generateTestLogRecord() LogRecord {
 }
 
 func fillTestLogRecord(tv LogRecord) {
-	tv.orig.ObservedTimeUnixNano = 1234567890
 	tv.orig.TimeUnixNano = 1234567890
-	tv.orig.TraceId = data.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 8, 7, 6, 5, 4, 3, 2, 1})
-	tv.orig.SpanId = data.SpanID([8]byte{8, 7, 6, 5, 4, 3, 2, 1})
-	tv.orig.Flags = 1
-	tv.orig.SeverityText = "INFO"
+	tv.orig.ObservedTimeUnixNano = 1234567890
 	tv.orig.SeverityNumber = otlplogs.SeverityNumber(5)
+	tv.orig.SeverityText = "INFO"
 	internal.FillTestValue(internal.NewValue(&tv.orig.Body))
 	internal.FillTestMap(internal.NewMap(&tv.orig.Attributes))
 	tv.orig.DroppedAttributesCount = uint32(17)
+	tv.orig.Flags = 1
+	tv.orig.TraceId = data.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 8, 7, 6, 5, 4, 3, 2, 1})
+	tv.orig.SpanId = data.SpanID([8]byte{8, 7, 6, 5, 4, 3, 2, 1})
 }
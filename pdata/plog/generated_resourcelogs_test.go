@@ -39,13 +39,6 @@ func TestResourceLogs_Resource(t *testing.T) {
 	assert.Equal(t, pcommon.Resource(internal.GenerateTestResource()), ms.Resource())
 }
 
-func TestResourceLogs_SchemaUrl(t *testing.T) {
-	ms := NewResourceLogs()
-	assert.Equal(t, "", ms.SchemaUrl())
-	ms.SetSchemaUrl("https://opentelemetry.io/schemas/1.5.0")
-	assert.Equal(t, "https://opentelemetry.io/schemas/1.5.0", ms.SchemaUrl())
-}
-
 func TestResourceLogs_ScopeLogs(t *testing.T) {
 	ms := NewResourceLogs()
 	assert.Equal(t, NewScopeLogsSlice(), ms.ScopeLogs())
@@ -53,6 +46,13 @@ func TestResourceLogs_ScopeLogs(t *testing.T) {
 	assert.Equal(t, generateTestScopeLogsSlice(), ms.ScopeLogs())
 }
 
+func TestResourceLogs_SchemaUrl(t *testing.T) {
+	ms := NewResourceLogs()
+	assert.Equal(t, "", ms.SchemaUrl())
+	ms.SetSchemaUrl("https://opentelemetry.io/schemas/1.5.0")
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.5.0", ms.SchemaUrl())
+}
+
 func generateTestResourceLogs() ResourceLogs {
 	tv := NewResourceLogs()
 	fillTestResourceLogs(tv)
@@ -61,6 +61,6 @@ func generateTestResourceLogs() ResourceLogs {
 
 func fillTestResourceLogs(tv ResourceLogs) {
 	internal.FillTestResource(internal.NewResource(&tv.orig.Resource))
-	tv.orig.SchemaUrl = "https://opentelemetry.io/schemas/1.5.0"
 	fillTestScopeLogsSlice(newScopeLogsSlice(&tv.orig.ScopeLogs))
+	tv.orig.SchemaUrl = "https://opentelemetry.io/schemas/1.5.0"
 }
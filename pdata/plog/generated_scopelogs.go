@@ -47,6 +47,11 @@ func (ms ScopeLogs) Scope() pcommon.InstrumentationScope {
 	return pcommon.InstrumentationScope(internal.NewInstrumentationScope(&ms.orig.Scope))
 }
 
+// LogRecords returns the LogRecords associated with this ScopeLogs.
+func (ms ScopeLogs) LogRecords() LogRecordSlice {
+	return newLogRecordSlice(&ms.orig.LogRecords)
+}
+
 // SchemaUrl returns the schemaurl associated with this ScopeLogs.
 func (ms ScopeLogs) SchemaUrl() string {
 	return ms.orig.SchemaUrl
@@ -57,14 +62,9 @@ func (ms ScopeLogs) SetSchemaUrl(v string) {
 	ms.orig.SchemaUrl = v
 }
 
-// LogRecords returns the LogRecords associated with this ScopeLogs.
-func (ms ScopeLogs) LogRecords() LogRecordSlice {
-	return newLogRecordSlice(&ms.orig.LogRecords)
-}
-
 // CopyTo copies all properties from the current struct overriding the destination.
 func (ms ScopeLogs) CopyTo(dest ScopeLogs) {
 	ms.Scope().CopyTo(dest.Scope())
-	dest.SetSchemaUrl(ms.SchemaUrl())
 	ms.LogRecords().CopyTo(dest.LogRecords())
+	dest.SetSchemaUrl(ms.SchemaUrl())
 }
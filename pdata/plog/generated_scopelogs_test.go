@@ -39,13 +39,6 @@ func TestScopeLogs_Scope(t *testing.T) {
 	assert.Equal(t, pcommon.InstrumentationScope(internal.GenerateTestInstrumentationScope()), ms.Scope())
 }
 
-func TestScopeLogs_SchemaUrl(t *testing.T) {
-	ms := NewScopeLogs()
-	assert.Equal(t, "", ms.SchemaUrl())
-	ms.SetSchemaUrl("https://opentelemetry.io/schemas/1.5.0")
-	assert.Equal(t, "https://opentelemetry.io/schemas/1.5.0", ms.SchemaUrl())
-}
-
 func TestScopeLogs_LogRecords(t *testing.T) {
 	ms := NewScopeLogs()
 	assert.Equal(t, NewLogRecordSlice(), ms.LogRecords())
@@ -53,6 +46,13 @@ func TestScopeLogs_LogRecords(t *testing.T) {
 	assert.Equal(t, generateTestLogRecordSlice(), ms.LogRecords())
 }
 
+func TestScopeLogs_SchemaUrl(t *testing.T) {
+	ms := NewScopeLogs()
+	assert.Equal(t, "", ms.SchemaUrl())
+	ms.SetSchemaUrl("https://opentelemetry.io/schemas/1.5.0")
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.5.0", ms.SchemaUrl())
+}
+
 func generateTestScopeLogs() ScopeLogs {
 	tv := NewScopeLogs()
 	fillTestScopeLogs(tv)
@@ -61,6 +61,6 @@ func generateTestScopeLogs() ScopeLogs {
 
 func fillTestScopeLogs(tv ScopeLogs) {
 	internal.FillTestInstrumentationScope(internal.NewInstrumentationScope(&tv.orig.Scope))
-	tv.orig.SchemaUrl = "https://opentelemetry.io/schemas/1.5.0"
 	fillTestLogRecordSlice(newLogRecordSlice(&tv.orig.LogRecords))
+	tv.orig.SchemaUrl = "https://opentelemetry.io/schemas/1.5.0"
 }
@@ -4,12 +4,11 @@
 package plog // import "go.opentelemetry.io/collector/pdata/plog"
 
 import (
-	"bytes"
 	"fmt"
+	"io"
 
 	jsoniter "github.com/json-iterator/go"
 
-	"go.opentelemetry.io/collector/pdata/internal"
 	otlplogs "go.opentelemetry.io/collector/pdata/internal/data/protogen/logs/v1"
 	"go.opentelemetry.io/collector/pdata/internal/json"
 	"go.opentelemetry.io/collector/pdata/internal/otlp"
@@ -18,14 +17,29 @@ import (
 type JSONMarshaler struct{}
 
 func (*JSONMarshaler) MarshalLogs(ld Logs) ([]byte, error) {
-	buf := bytes.Buffer{}
-	pb := internal.LogsToProto(internal.Logs(ld))
-	err := json.Marshal(&buf, &pb)
-	return buf.Bytes(), err
+	dest := json.BorrowStream()
+	ld.marshalJsoniter(dest)
+	return json.ReturnStream(dest)
+}
+
+func (ms Logs) marshalJsoniter(dest *jsoniter.Stream) {
+	dest.WriteObjectStart()
+	if rls := ms.getOrig().ResourceLogs; rls != nil {
+		json.WriteObjectField(dest, "resourceLogs")
+		dest.WriteArrayStart()
+		for i := range rls {
+			json.WriteArrayElement(dest, i)
+			newResourceLogs(rls[i]).marshalJsoniter(dest)
+		}
+		dest.WriteArrayEnd()
+	}
+	dest.WriteObjectEnd()
 }
 
 var _ Unmarshaler = (*JSONUnmarshaler)(nil)
 
+var _ StreamUnmarshaler = (*JSONUnmarshaler)(nil)
+
 type JSONUnmarshaler struct{}
 
 func (*JSONUnmarshaler) UnmarshalLogs(buf []byte) (Logs, error) {
@@ -40,6 +54,28 @@ func (*JSONUnmarshaler) UnmarshalLogs(buf []byte) (Logs, error) {
 	return ld, nil
 }
 
+func (*JSONUnmarshaler) NewResourceLogsDecoder(r io.Reader) ResourceLogsDecoder {
+	return &jsonResourceLogsDecoder{decoder: json.NewArrayDecoder(r, "resourceLogs", "resource_logs")}
+}
+
+type jsonResourceLogsDecoder struct {
+	decoder *json.ArrayDecoder
+}
+
+func (d *jsonResourceLogsDecoder) DecodeResourceLogs() (ResourceLogs, error) {
+	iter, err := d.decoder.Next()
+	if err != nil {
+		return ResourceLogs{}, err
+	}
+	rs := NewResourceLogs()
+	rs.unmarshalJsoniter(iter)
+	if err = d.decoder.Err(); err != nil {
+		return ResourceLogs{}, err
+	}
+	otlp.MigrateLogs([]*otlplogs.ResourceLogs{rs.orig})
+	return rs, nil
+}
+
 func (ms Logs) unmarshalJsoniter(iter *jsoniter.Iterator) {
 	iter.ReadObjectCB(func(iter *jsoniter.Iterator, f string) bool {
 		switch f {
@@ -4,13 +4,17 @@
 package plog
 
 import (
+	"bytes"
+	"math"
 	"testing"
 
 	jsoniter "github.com/json-iterator/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/collector/pdata/internal"
 	otlplogs "go.opentelemetry.io/collector/pdata/internal/data/protogen/logs/v1"
+	"go.opentelemetry.io/collector/pdata/internal/json"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 )
 
@@ -152,3 +156,85 @@ func BenchmarkJSONUnmarshal(b *testing.B) {
 		}
 	})
 }
+
+// logsEdgeCases holds the values whose JSON encoding needs care: bodies of every type, escaped
+// strings and zero values.
+var logsEdgeCases = func() Logs {
+	ld := NewLogs()
+	lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	lrs.AppendEmpty()
+	lrs.AppendEmpty().Body().SetStr(" <b>\"bold\"</b>\t\x00")
+	lrs.AppendEmpty().Body().SetInt(0)
+	lrs.AppendEmpty().Body().SetDouble(math.Inf(-1))
+	lrs.AppendEmpty().Body().SetBool(false)
+	lrs.AppendEmpty().Body().SetEmptyBytes()
+	lrs.AppendEmpty().Body().SetEmptyBytes().FromRaw([]byte{0, 1, 2})
+	lrs.AppendEmpty().Body().SetEmptySlice().AppendEmpty().SetEmptyMap().PutEmpty("nested")
+	lr := lrs.AppendEmpty()
+	lr.Body().SetEmptyMap()
+	lr.SetSeverityNumber(SeverityNumberFatal4)
+	lr.SetFlags(DefaultLogRecordFlags.WithIsSampled(true))
+	return ld
+}()
+
+func TestJSONMarshalMatchesProtoJSON(t *testing.T) {
+	for name, ld := range map[string]Logs{
+		"empty":      NewLogs(),
+		"otlp":       logsOTLP,
+		"edge cases": logsEdgeCases,
+		"benchmark":  generateJSONBenchmarkLogs(3),
+		"generated": func() Logs {
+			ld := NewLogs()
+			fillTestResourceLogsSlice(ld.ResourceLogs())
+			return ld
+		}(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			expected, err := marshalProtoJSON(ld)
+			require.NoError(t, err)
+			encoder := &JSONMarshaler{}
+			jsonBuf, err := encoder.MarshalLogs(ld)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), string(jsonBuf))
+		})
+	}
+}
+
+// marshalProtoJSON marshals the logs with the generic jsonpb marshaler, which JSONMarshaler replaced.
+func marshalProtoJSON(ld Logs) ([]byte, error) {
+	buf := bytes.Buffer{}
+	pb := internal.LogsToProto(internal.Logs(ld))
+	err := json.Marshal(&buf, &pb)
+	return buf.Bytes(), err
+}
+
+func generateJSONBenchmarkLogs(logsCount int) Logs {
+	ld := NewLogs()
+	logsOTLP.ResourceLogs().At(0).Resource().CopyTo(ld.ResourceLogs().AppendEmpty().Resource())
+	lrs := ld.ResourceLogs().At(0).ScopeLogs().AppendEmpty().LogRecords()
+	for i := 0; i < logsCount; i++ {
+		logsOTLP.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).CopyTo(lrs.AppendEmpty())
+	}
+	return ld
+}
+
+func BenchmarkJSONMarshal(b *testing.B) {
+	ld := generateJSONBenchmarkLogs(128)
+	encoder := &JSONMarshaler{}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_, err := encoder.MarshalLogs(ld)
+		require.NoError(b, err)
+	}
+}
+
+func BenchmarkJSONMarshalProto(b *testing.B) {
+	ld := generateJSONBenchmarkLogs(128)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_, err := marshalProtoJSON(ld)
+		require.NoError(b, err)
+	}
+}
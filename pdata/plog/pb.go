@@ -4,8 +4,11 @@
 package plog // import "go.opentelemetry.io/collector/pdata/plog"
 
 import (
+	"io"
+
 	"go.opentelemetry.io/collector/pdata/internal"
 	otlplogs "go.opentelemetry.io/collector/pdata/internal/data/protogen/logs/v1"
+	"go.opentelemetry.io/collector/pdata/internal/otlp"
 )
 
 var _ MarshalSizer = (*ProtoMarshaler)(nil)
@@ -23,6 +26,7 @@ func (e *ProtoMarshaler) LogsSize(ld Logs) int {
 }
 
 var _ Unmarshaler = (*ProtoUnmarshaler)(nil)
+var _ StreamUnmarshaler = (*ProtoUnmarshaler)(nil)
 
 type ProtoUnmarshaler struct{}
 
@@ -31,3 +35,24 @@ func (d *ProtoUnmarshaler) UnmarshalLogs(buf []byte) (Logs, error) {
 	err := pb.Unmarshal(buf)
 	return Logs(internal.LogsFromProto(pb)), err
 }
+
+func (d *ProtoUnmarshaler) NewResourceLogsDecoder(r io.Reader) ResourceLogsDecoder {
+	return &protoResourceLogsDecoder{reader: otlp.NewFieldReader(r, otlp.ResourceField)}
+}
+
+type protoResourceLogsDecoder struct {
+	reader *otlp.FieldReader
+}
+
+func (d *protoResourceLogsDecoder) DecodeResourceLogs() (ResourceLogs, error) {
+	buf, err := d.reader.Next()
+	if err != nil {
+		return ResourceLogs{}, err
+	}
+	rs := NewResourceLogs()
+	if err = rs.orig.Unmarshal(buf); err != nil {
+		return ResourceLogs{}, err
+	}
+	otlp.MigrateLogs([]*otlplogs.ResourceLogs{rs.orig})
+	return rs, nil
+}
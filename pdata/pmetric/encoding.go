@@ -3,6 +3,8 @@
 
 package pmetric // import "go.opentelemetry.io/collector/pdata/pmetric"
 
+import "io"
+
 // MarshalSizer is the interface that groups the basic Marshal and Size methods
 type MarshalSizer interface {
 	Marshaler
@@ -28,3 +30,18 @@ type Sizer interface {
 	// MetricsSize returns the size in bytes of a marshaled Metrics.
 	MetricsSize(md Metrics) int
 }
+
+// StreamUnmarshaler is an optional interface implemented by the Unmarshaler, that unmarshalls
+// metrics read from an io.Reader one ResourceMetrics at a time, so that the encoded metrics are never
+// entirely held in memory.
+type StreamUnmarshaler interface {
+	// NewResourceMetricsDecoder returns a decoder of the ResourceMetrics of the metrics read from r.
+	NewResourceMetricsDecoder(r io.Reader) ResourceMetricsDecoder
+}
+
+// ResourceMetricsDecoder decodes ResourceMetrics one at a time.
+type ResourceMetricsDecoder interface {
+	// DecodeResourceMetrics returns the next ResourceMetrics, or io.EOF once all of them have been decoded.
+	// If the error is not nil, the returned ResourceMetrics cannot be used.
+	DecodeResourceMetrics() (ResourceMetrics, error)
+}
@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pmetric
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeAllResourceMetrics(decoder ResourceMetricsDecoder) (Metrics, error) {
+	md := NewMetrics()
+	for {
+		rs, err := decoder.DecodeResourceMetrics()
+		if errors.Is(err, io.EOF) {
+			return md, nil
+		}
+		if err != nil {
+			return md, err
+		}
+		rs.MoveTo(md.ResourceMetrics().AppendEmpty())
+	}
+}
+
+func TestStreamUnmarshaler(t *testing.T) {
+	md := NewMetrics()
+	for i := 0; i < 3; i++ {
+		rs := md.ResourceMetrics().AppendEmpty()
+		rs.Resource().Attributes().PutInt("index", int64(i))
+		rs.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("metric")
+	}
+	metricsOTLP.ResourceMetrics().At(0).CopyTo(md.ResourceMetrics().AppendEmpty())
+
+	tests := []struct {
+		name        string
+		marshaler   Marshaler
+		unmarshaler StreamUnmarshaler
+	}{
+		{
+			name:        "proto",
+			marshaler:   &ProtoMarshaler{},
+			unmarshaler: &ProtoUnmarshaler{},
+		},
+		{
+			name:        "json",
+			marshaler:   &JSONMarshaler{},
+			unmarshaler: &JSONUnmarshaler{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf, err := tt.marshaler.MarshalMetrics(md)
+			require.NoError(t, err)
+
+			decoded, err := decodeAllResourceMetrics(tt.unmarshaler.NewResourceMetricsDecoder(iotest.OneByteReader(bytes.NewReader(buf))))
+			require.NoError(t, err)
+			assert.Equal(t, md, decoded)
+
+			empty, err := tt.marshaler.MarshalMetrics(NewMetrics())
+			require.NoError(t, err)
+			decoded, err = decodeAllResourceMetrics(tt.unmarshaler.NewResourceMetricsDecoder(bytes.NewReader(empty)))
+			require.NoError(t, err)
+			assert.Equal(t, NewMetrics(), decoded)
+
+			_, err = decodeAllResourceMetrics(tt.unmarshaler.NewResourceMetricsDecoder(bytes.NewReader(buf[:len(buf)-1])))
+			assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+		})
+	}
+}
+
+func TestJSONStreamUnmarshalerSkipsUnknownFields(t *testing.T) {
+	unmarshaler := &JSONUnmarshaler{}
+	decoded, err := decodeAllResourceMetrics(unmarshaler.NewResourceMetricsDecoder(strings.NewReader(
+		`{"unknown":[1,{"resourceMetrics":[]}],"resource_metrics":[{"schemaUrl":"first"}],"resourceMetrics":[{"schemaUrl":"second"}]}`)))
+	require.NoError(t, err)
+	require.Equal(t, 2, decoded.ResourceMetrics().Len())
+	assert.Equal(t, "first", decoded.ResourceMetrics().At(0).SchemaUrl())
+	assert.Equal(t, "second", decoded.ResourceMetrics().At(1).SchemaUrl())
+
+	_, err = decodeAllResourceMetrics(unmarshaler.NewResourceMetricsDecoder(strings.NewReader(`{"resourceMetrics":[{"schemaUrl":1}]}`)))
+	assert.Error(t, err)
+}
@@ -46,6 +46,11 @@ func (ms Exemplar) MoveTo(dest Exemplar) {
 	*ms.orig = otlpmetrics.Exemplar{}
 }
 
+// FilteredAttributes returns the FilteredAttributes associated with this Exemplar.
+func (ms Exemplar) FilteredAttributes() pcommon.Map {
+	return pcommon.Map(internal.NewMap(&ms.orig.FilteredAttributes))
+}
+
 // Timestamp returns the timestamp associated with this Exemplar.
 func (ms Exemplar) Timestamp() pcommon.Timestamp {
 	return pcommon.Timestamp(ms.orig.TimeUnixNano)
@@ -92,9 +97,14 @@ func (ms Exemplar) SetIntValue(v int64) {
 	}
 }
 
-// FilteredAttributes returns the FilteredAttributes associated with this Exemplar.
-func (ms Exemplar) FilteredAttributes() pcommon.Map {
-	return pcommon.Map(internal.NewMap(&ms.orig.FilteredAttributes))
+// SpanID returns the spanid associated with this Exemplar.
+func (ms Exemplar) SpanID() pcommon.SpanID {
+	return pcommon.SpanID(ms.orig.SpanId)
+}
+
+// SetSpanID replaces the spanid associated with this Exemplar.
+func (ms Exemplar) SetSpanID(v pcommon.SpanID) {
+	ms.orig.SpanId = data.SpanID(v)
 }
 
 // TraceID returns the traceid associated with this Exemplar.
@@ -107,18 +117,9 @@ func (ms Exemplar) SetTraceID(v pcommon.TraceID) {
 	ms.orig.TraceId = data.TraceID(v)
 }
 
-// SpanID returns the spanid associated with this Exemplar.
-func (ms Exemplar) SpanID() pcommon.SpanID {
-	return pcommon.SpanID(ms.orig.SpanId)
-}
-
-// SetSpanID replaces the spanid associated with this Exemplar.
-func (ms Exemplar) SetSpanID(v pcommon.SpanID) {
-	ms.orig.SpanId = data.SpanID(v)
-}
-
 // CopyTo copies all properties from the current struct overriding the destination.
 func (ms Exemplar) CopyTo(dest Exemplar) {
+	ms.FilteredAttributes().CopyTo(dest.FilteredAttributes())
 	dest.SetTimestamp(ms.Timestamp())
 	switch ms.ValueType() {
 	case ExemplarValueTypeDouble:
@@ -127,7 +128,6 @@ func (ms Exemplar) CopyTo(dest Exemplar) {
 		dest.SetIntValue(ms.IntValue())
 	}
 
-	ms.FilteredAttributes().CopyTo(dest.FilteredAttributes())
-	dest.SetTraceID(ms.TraceID())
 	dest.SetSpanID(ms.SpanID())
+	dest.SetTraceID(ms.TraceID())
 }
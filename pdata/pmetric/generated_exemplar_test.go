@@ -35,6 +35,13 @@ func TestExemplar_CopyTo(t *testing.T) {
 	assert.Equal(t, orig, ms)
 }
 
+func TestExemplar_FilteredAttributes(t *testing.T) {
+	ms := NewExemplar()
+	assert.Equal(t, pcommon.NewMap(), ms.FilteredAttributes())
+	internal.FillTestMap(internal.Map(ms.FilteredAttributes()))
+	assert.Equal(t, pcommon.Map(internal.GenerateTestMap()), ms.FilteredAttributes())
+}
+
 func TestExemplar_Timestamp(t *testing.T) {
 	ms := NewExemplar()
 	assert.Equal(t, pcommon.Timestamp(0), ms.Timestamp())
@@ -64,11 +71,12 @@ func TestExemplar_IntValue(t *testing.T) {
 	assert.Equal(t, ExemplarValueTypeInt, ms.ValueType())
 }
 
-func TestExemplar_FilteredAttributes(t *testing.T) {
+func TestExemplar_SpanID(t *testing.T) {
 	ms := NewExemplar()
-	assert.Equal(t, pcommon.NewMap(), ms.FilteredAttributes())
-	internal.FillTestMap(internal.Map(ms.FilteredAttributes()))
-	assert.Equal(t, pcommon.Map(internal.GenerateTestMap()), ms.FilteredAttributes())
+	assert.Equal(t, pcommon.SpanID(data.SpanID([8]byte{})), ms.SpanID())
+	testValSpanID := pcommon.SpanID(data.SpanID([8]byte{8, 7, 6, 5, 4, 3, 2, 1}))
+	ms.SetSpanID(testValSpanID)
+	assert.Equal(t, testValSpanID, ms.SpanID())
 }
 
 func TestExemplar_TraceID(t *testing.T) {
@@ -79,14 +87,6 @@ func TestExemplar_TraceID(t *testing.T) {
 	assert.Equal(t, testValTraceID, ms.TraceID())
 }
 
-func TestExemplar_SpanID(t *testing.T) {
-	ms := NewExemplar()
-	assert.Equal(t, pcommon.SpanID(data.SpanID([8]byte{})), ms.SpanID())
-	testValSpanID := pcommon.SpanID(data.SpanID([8]byte{8, 7, 6, 5, 4, 3, 2, 1}))
-	ms.SetSpanID(testValSpanID)
-	assert.Equal(t, testValSpanID, ms.SpanID())
-}
-
 func generateTestExemplar() Exemplar {
 	tv := NewExemplar()
 	fillTestExemplar(tv)
@@ -94,9 +94,9 @@ func generateTestExemplar() Exemplar {
 }
 
 func fillTestExemplar(tv Exemplar) {
+	internal.FillTestMap(internal.NewMap(&tv.orig.FilteredAttributes))
 	tv.orig.TimeUnixNano = 1234567890
 	tv.orig.Value = &otlpmetrics.Exemplar_AsInt{AsInt: int64(17)}
-	internal.FillTestMap(internal.NewMap(&tv.orig.FilteredAttributes))
-	tv.orig.TraceId = data.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 8, 7, 6, 5, 4, 3, 2, 1})
 	tv.orig.SpanId = data.SpanID([8]byte{8, 7, 6, 5, 4, 3, 2, 1})
+	tv.orig.TraceId = data.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 8, 7, 6, 5, 4, 3, 2, 1})
 }
@@ -32,6 +32,13 @@ func TestExponentialHistogram_CopyTo(t *testing.T) {
 	assert.Equal(t, orig, ms)
 }
 
+func TestExponentialHistogram_DataPoints(t *testing.T) {
+	ms := NewExponentialHistogram()
+	assert.Equal(t, NewExponentialHistogramDataPointSlice(), ms.DataPoints())
+	fillTestExponentialHistogramDataPointSlice(ms.DataPoints())
+	assert.Equal(t, generateTestExponentialHistogramDataPointSlice(), ms.DataPoints())
+}
+
 func TestExponentialHistogram_AggregationTemporality(t *testing.T) {
 	ms := NewExponentialHistogram()
 	assert.Equal(t, AggregationTemporality(otlpmetrics.AggregationTemporality(0)), ms.AggregationTemporality())
@@ -40,13 +47,6 @@ func TestExponentialHistogram_AggregationTemporality(t *testing.T) {
 	assert.Equal(t, testValAggregationTemporality, ms.AggregationTemporality())
 }
 
-func TestExponentialHistogram_DataPoints(t *testing.T) {
-	ms := NewExponentialHistogram()
-	assert.Equal(t, NewExponentialHistogramDataPointSlice(), ms.DataPoints())
-	fillTestExponentialHistogramDataPointSlice(ms.DataPoints())
-	assert.Equal(t, generateTestExponentialHistogramDataPointSlice(), ms.DataPoints())
-}
-
 func generateTestExponentialHistogram() ExponentialHistogram {
 	tv := NewExponentialHistogram()
 	fillTestExponentialHistogram(tv)
@@ -54,6 +54,6 @@ func generateTestExponentialHistogram() ExponentialHistogram {
 }
 
 func fillTestExponentialHistogram(tv ExponentialHistogram) {
-	tv.orig.AggregationTemporality = otlpmetrics.AggregationTemporality(1)
 	fillTestExponentialHistogramDataPointSlice(newExponentialHistogramDataPointSlice(&tv.orig.DataPoints))
+	tv.orig.AggregationTemporality = otlpmetrics.AggregationTemporality(1)
 }
@@ -100,13 +100,6 @@ func TestExponentialHistogramDataPoint_Negative(t *testing.T) {
 	assert.Equal(t, generateTestExponentialHistogramDataPointBuckets(), ms.Negative())
 }
 
-func TestExponentialHistogramDataPoint_Exemplars(t *testing.T) {
-	ms := NewExponentialHistogramDataPoint()
-	assert.Equal(t, NewExemplarSlice(), ms.Exemplars())
-	fillTestExemplarSlice(ms.Exemplars())
-	assert.Equal(t, generateTestExemplarSlice(), ms.Exemplars())
-}
-
 func TestExponentialHistogramDataPoint_Flags(t *testing.T) {
 	ms := NewExponentialHistogramDataPoint()
 	assert.Equal(t, DataPointFlags(0), ms.Flags())
@@ -115,6 +108,13 @@ func TestExponentialHistogramDataPoint_Flags(t *testing.T) {
 	assert.Equal(t, testValFlags, ms.Flags())
 }
 
+func TestExponentialHistogramDataPoint_Exemplars(t *testing.T) {
+	ms := NewExponentialHistogramDataPoint()
+	assert.Equal(t, NewExemplarSlice(), ms.Exemplars())
+	fillTestExemplarSlice(ms.Exemplars())
+	assert.Equal(t, generateTestExemplarSlice(), ms.Exemplars())
+}
+
 func TestExponentialHistogramDataPoint_Min(t *testing.T) {
 	ms := NewExponentialHistogramDataPoint()
 	assert.Equal(t, float64(0.0), ms.Min())
@@ -135,6 +135,13 @@ func TestExponentialHistogramDataPoint_Max(t *testing.T) {
 	assert.False(t, ms.HasMax())
 }
 
+func TestExponentialHistogramDataPoint_ZeroThreshold(t *testing.T) {
+	ms := NewExponentialHistogramDataPoint()
+	assert.Equal(t, float64(0.0), ms.ZeroThreshold())
+	ms.SetZeroThreshold(float64(0.5))
+	assert.Equal(t, float64(0.5), ms.ZeroThreshold())
+}
+
 func generateTestExponentialHistogramDataPoint() ExponentialHistogramDataPoint {
 	tv := NewExponentialHistogramDataPoint()
 	fillTestExponentialHistogramDataPoint(tv)
@@ -151,8 +158,9 @@ func fillTestExponentialHistogramDataPoint(tv ExponentialHistogramDataPoint) {
 	tv.orig.ZeroCount = uint64(201)
 	fillTestExponentialHistogramDataPointBuckets(newExponentialHistogramDataPointBuckets(&tv.orig.Positive))
 	fillTestExponentialHistogramDataPointBuckets(newExponentialHistogramDataPointBuckets(&tv.orig.Negative))
-	fillTestExemplarSlice(newExemplarSlice(&tv.orig.Exemplars))
 	tv.orig.Flags = 1
+	fillTestExemplarSlice(newExemplarSlice(&tv.orig.Exemplars))
 	tv.orig.Min_ = &otlpmetrics.ExponentialHistogramDataPoint_Min{Min: float64(9.23)}
 	tv.orig.Max_ = &otlpmetrics.ExponentialHistogramDataPoint_Max{Max: float64(182.55)}
+	tv.orig.ZeroThreshold = float64(0.5)
 }
@@ -32,6 +32,13 @@ func TestHistogram_CopyTo(t *testing.T) {
 	assert.Equal(t, orig, ms)
 }
 
+func TestHistogram_DataPoints(t *testing.T) {
+	ms := NewHistogram()
+	assert.Equal(t, NewHistogramDataPointSlice(), ms.DataPoints())
+	fillTestHistogramDataPointSlice(ms.DataPoints())
+	assert.Equal(t, generateTestHistogramDataPointSlice(), ms.DataPoints())
+}
+
 func TestHistogram_AggregationTemporality(t *testing.T) {
 	ms := NewHistogram()
 	assert.Equal(t, AggregationTemporality(otlpmetrics.AggregationTemporality(0)), ms.AggregationTemporality())
@@ -40,13 +47,6 @@ func TestHistogram_AggregationTemporality(t *testing.T) {
 	assert.Equal(t, testValAggregationTemporality, ms.AggregationTemporality())
 }
 
-func TestHistogram_DataPoints(t *testing.T) {
-	ms := NewHistogram()
-	assert.Equal(t, NewHistogramDataPointSlice(), ms.DataPoints())
-	fillTestHistogramDataPointSlice(ms.DataPoints())
-	assert.Equal(t, generateTestHistogramDataPointSlice(), ms.DataPoints())
-}
-
 func generateTestHistogram() Histogram {
 	tv := NewHistogram()
 	fillTestHistogram(tv)
@@ -54,6 +54,6 @@ func generateTestHistogram() Histogram {
 }
 
 func fillTestHistogram(tv Histogram) {
-	tv.orig.AggregationTemporality = otlpmetrics.AggregationTemporality(1)
 	fillTestHistogramDataPointSlice(newHistogramDataPointSlice(&tv.orig.DataPoints))
+	tv.orig.AggregationTemporality = otlpmetrics.AggregationTemporality(1)
 }
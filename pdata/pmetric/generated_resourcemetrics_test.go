@@ -39,13 +39,6 @@ func TestResourceMetrics_Resource(t *testing.T) {
 	assert.Equal(t, pcommon.Resource(internal.GenerateTestResource()), ms.Resource())
 }
 
-func TestResourceMetrics_SchemaUrl(t *testing.T) {
-	ms := NewResourceMetrics()
-	assert.Equal(t, "", ms.SchemaUrl())
-	ms.SetSchemaUrl("https://opentelemetry.io/schemas/1.5.0")
-	assert.Equal(t, "https://opentelemetry.io/schemas/1.5.0", ms.SchemaUrl())
-}
-
 func TestResourceMetrics_ScopeMetrics(t *testing.T) {
 	ms := NewResourceMetrics()
 	assert.Equal(t, NewScopeMetricsSlice(), ms.ScopeMetrics())
@@ -53,6 +46,13 @@ func TestResourceMetrics_ScopeMetrics(t *testing.T) {
 	assert.Equal(t, generateTestScopeMetricsSlice(), ms.ScopeMetrics())
 }
 
+func TestResourceMetrics_SchemaUrl(t *testing.T) {
+	ms := NewResourceMetrics()
+	assert.Equal(t, "", ms.SchemaUrl())
+	ms.SetSchemaUrl("https://opentelemetry.io/schemas/1.5.0")
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.5.0", ms.SchemaUrl())
+}
+
 func generateTestResourceMetrics() ResourceMetrics {
 	tv := NewResourceMetrics()
 	fillTestResourceMetrics(tv)
@@ -61,6 +61,6 @@ func generateTestResourceMetrics() ResourceMetrics {
 
 func fillTestResourceMetrics(tv ResourceMetrics) {
 	internal.FillTestResource(internal.NewResource(&tv.orig.Resource))
-	tv.orig.SchemaUrl = "https://opentelemetry.io/schemas/1.5.0"
 	fillTestScopeMetricsSlice(newScopeMetricsSlice(&tv.orig.ScopeMetrics))
+	tv.orig.SchemaUrl = "https://opentelemetry.io/schemas/1.5.0"
 }
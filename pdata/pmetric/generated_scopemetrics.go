@@ -47,6 +47,11 @@ func (ms ScopeMetrics) Scope() pcommon.InstrumentationScope {
 	return pcommon.InstrumentationScope(internal.NewInstrumentationScope(&ms.orig.Scope))
 }
 
+// Metrics returns the Metrics associated with this ScopeMetrics.
+func (ms ScopeMetrics) Metrics() MetricSlice {
+	return newMetricSlice(&ms.orig.Metrics)
+}
+
 // SchemaUrl returns the schemaurl associated with this ScopeMetrics.
 func (ms ScopeMetrics) SchemaUrl() string {
 	return ms.orig.SchemaUrl
@@ -57,14 +62,9 @@ func (ms ScopeMetrics) SetSchemaUrl(v string) {
 	ms.orig.SchemaUrl = v
 }
 
-// Metrics returns the Metrics associated with this ScopeMetrics.
-func (ms ScopeMetrics) Metrics() MetricSlice {
-	return newMetricSlice(&ms.orig.Metrics)
-}
-
 // CopyTo copies all properties from the current struct overriding the destination.
 func (ms ScopeMetrics) CopyTo(dest ScopeMetrics) {
 	ms.Scope().CopyTo(dest.Scope())
-	dest.SetSchemaUrl(ms.SchemaUrl())
 	ms.Metrics().CopyTo(dest.Metrics())
+	dest.SetSchemaUrl(ms.SchemaUrl())
 }
@@ -39,13 +39,6 @@ func TestScopeMetrics_Scope(t *testing.T) {
 	assert.Equal(t, pcommon.InstrumentationScope(internal.GenerateTestInstrumentationScope()), ms.Scope())
 }
 
-func TestScopeMetrics_SchemaUrl(t *testing.T) {
-	ms := NewScopeMetrics()
-	assert.Equal(t, "", ms.SchemaUrl())
-	ms.SetSchemaUrl("https://opentelemetry.io/schemas/1.5.0")
-	assert.Equal(t, "https://opentelemetry.io/schemas/1.5.0", ms.SchemaUrl())
-}
-
 func TestScopeMetrics_Metrics(t *testing.T) {
 	ms := NewScopeMetrics()
 	assert.Equal(t, NewMetricSlice(), ms.Metrics())
@@ -53,6 +46,13 @@ func TestScopeMetrics_Metrics(t *testing.T) {
 	assert.Equal(t, generateTestMetricSlice(), ms.Metrics())
 }
 
+func TestScopeMetrics_SchemaUrl(t *testing.T) {
+	ms := NewScopeMetrics()
+	assert.Equal(t, "", ms.SchemaUrl())
+	ms.SetSchemaUrl("https://opentelemetry.io/schemas/1.5.0")
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.5.0", ms.SchemaUrl())
+}
+
 func generateTestScopeMetrics() ScopeMetrics {
 	tv := NewScopeMetrics()
 	fillTestScopeMetrics(tv)
@@ -61,6 +61,6 @@ func generateTestScopeMetrics() ScopeMetrics {
 
 func fillTestScopeMetrics(tv ScopeMetrics) {
 	internal.FillTestInstrumentationScope(internal.NewInstrumentationScope(&tv.orig.Scope))
-	tv.orig.SchemaUrl = "https://opentelemetry.io/schemas/1.5.0"
 	fillTestMetricSlice(newMetricSlice(&tv.orig.Metrics))
+	tv.orig.SchemaUrl = "https://opentelemetry.io/schemas/1.5.0"
 }
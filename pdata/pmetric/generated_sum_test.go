@@ -32,6 +32,13 @@ func TestSum_CopyTo(t *testing.T) {
 	assert.Equal(t, orig, ms)
 }
 
+func TestSum_DataPoints(t *testing.T) {
+	ms := NewSum()
+	assert.Equal(t, NewNumberDataPointSlice(), ms.DataPoints())
+	fillTestNumberDataPointSlice(ms.DataPoints())
+	assert.Equal(t, generateTestNumberDataPointSlice(), ms.DataPoints())
+}
+
 func TestSum_AggregationTemporality(t *testing.T) {
 	ms := NewSum()
 	assert.Equal(t, AggregationTemporality(otlpmetrics.AggregationTemporality(0)), ms.AggregationTemporality())
@@ -47,13 +54,6 @@ func TestSum_IsMonotonic(t *testing.T) {
 	assert.Equal(t, true, ms.IsMonotonic())
 }
 
-func TestSum_DataPoints(t *testing.T) {
-	ms := NewSum()
-	assert.Equal(t, NewNumberDataPointSlice(), ms.DataPoints())
-	fillTestNumberDataPointSlice(ms.DataPoints())
-	assert.Equal(t, generateTestNumberDataPointSlice(), ms.DataPoints())
-}
-
 func generateTestSum() Sum {
 	tv := NewSum()
 	fillTestSum(tv)
@@ -61,7 +61,7 @@ func generateTestSum() Sum {
 }
 
 func fillTestSum(tv Sum) {
+	fillTestNumberDataPointSlice(newNumberDataPointSlice(&tv.orig.DataPoints))
 	tv.orig.AggregationTemporality = otlpmetrics.AggregationTemporality(1)
 	tv.orig.IsMonotonic = true
-	fillTestNumberDataPointSlice(newNumberDataPointSlice(&tv.orig.DataPoints))
 }
@@ -4,12 +4,11 @@
 package pmetric // import "go.opentelemetry.io/collector/pdata/pmetric"
 
 import (
-	"bytes"
 	"fmt"
+	"io"
 
 	jsoniter "github.com/json-iterator/go"
 
-	"go.opentelemetry.io/collector/pdata/internal"
 	otlpmetrics "go.opentelemetry.io/collector/pdata/internal/data/protogen/metrics/v1"
 	"go.opentelemetry.io/collector/pdata/internal/json"
 	"go.opentelemetry.io/collector/pdata/internal/otlp"
@@ -20,12 +19,27 @@ var _ Marshaler = (*JSONMarshaler)(nil)
 type JSONMarshaler struct{}
 
 func (*JSONMarshaler) MarshalMetrics(md Metrics) ([]byte, error) {
-	buf := bytes.Buffer{}
-	pb := internal.MetricsToProto(internal.Metrics(md))
-	err := json.Marshal(&buf, &pb)
-	return buf.Bytes(), err
+	dest := json.BorrowStream()
+	md.marshalJsoniter(dest)
+	return json.ReturnStream(dest)
 }
 
+func (ms Metrics) marshalJsoniter(dest *jsoniter.Stream) {
+	dest.WriteObjectStart()
+	if rms := ms.getOrig().ResourceMetrics; rms != nil {
+		json.WriteObjectField(dest, "resourceMetrics")
+		dest.WriteArrayStart()
+		for i := range rms {
+			json.WriteArrayElement(dest, i)
+			newResourceMetrics(rms[i]).marshalJsoniter(dest)
+		}
+		dest.WriteArrayEnd()
+	}
+	dest.WriteObjectEnd()
+}
+
+var _ StreamUnmarshaler = (*JSONUnmarshaler)(nil)
+
 type JSONUnmarshaler struct{}
 
 func (*JSONUnmarshaler) UnmarshalMetrics(buf []byte) (Metrics, error) {
@@ -40,6 +54,28 @@ func (*JSONUnmarshaler) UnmarshalMetrics(buf []byte) (Metrics, error) {
 	return md, nil
 }
 
+func (*JSONUnmarshaler) NewResourceMetricsDecoder(r io.Reader) ResourceMetricsDecoder {
+	return &jsonResourceMetricsDecoder{decoder: json.NewArrayDecoder(r, "resourceMetrics", "resource_metrics")}
+}
+
+type jsonResourceMetricsDecoder struct {
+	decoder *json.ArrayDecoder
+}
+
+func (d *jsonResourceMetricsDecoder) DecodeResourceMetrics() (ResourceMetrics, error) {
+	iter, err := d.decoder.Next()
+	if err != nil {
+		return ResourceMetrics{}, err
+	}
+	rs := NewResourceMetrics()
+	rs.unmarshalJsoniter(iter)
+	if err = d.decoder.Err(); err != nil {
+		return ResourceMetrics{}, err
+	}
+	otlp.MigrateMetrics([]*otlpmetrics.ResourceMetrics{rs.orig})
+	return rs, nil
+}
+
 func (ms Metrics) unmarshalJsoniter(iter *jsoniter.Iterator) {
 	iter.ReadObjectCB(func(iter *jsoniter.Iterator, f string) bool {
 		switch f {
@@ -325,6 +361,8 @@ func (ms ExponentialHistogramDataPoint) unmarshalJsoniter(iter *jsoniter.Iterato
 			ms.orig.Min_ = &otlpmetrics.ExponentialHistogramDataPoint_Min{
 				Min: json.ReadFloat64(iter),
 			}
+		case "zeroThreshold", "zero_threshold":
+			ms.orig.ZeroThreshold = json.ReadFloat64(iter)
 		default:
 			iter.Skip()
 		}
@@ -4,13 +4,18 @@
 package pmetric
 
 import (
+	"bytes"
+	"math"
 	"testing"
 	"time"
 
 	jsoniter "github.com/json-iterator/go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/collector/pdata/internal"
 	otlpmetrics "go.opentelemetry.io/collector/pdata/internal/data/protogen/metrics/v1"
+	"go.opentelemetry.io/collector/pdata/internal/json"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 )
 
@@ -442,13 +447,13 @@ func TestUnmarshalJsoniterHistogramDataPoint(t *testing.T) {
 }
 
 func TestUnmarshalJsoniterExponentialHistogramDataPoint(t *testing.T) {
-	jsonStr := `{"extra":"", "count":3}`
+	jsonStr := `{"extra":"", "count":3, "zeroThreshold":0.5}`
 	iter := jsoniter.ConfigFastest.BorrowIterator([]byte(jsonStr))
 	defer jsoniter.ConfigFastest.ReturnIterator(iter)
 	val := NewExponentialHistogramDataPoint()
 	val.unmarshalJsoniter(iter)
 	assert.NoError(t, iter.Error)
-	assert.EqualValues(t, &otlpmetrics.ExponentialHistogramDataPoint{Count: 3}, val.orig)
+	assert.EqualValues(t, &otlpmetrics.ExponentialHistogramDataPoint{Count: 3, ZeroThreshold: 0.5}, val.orig)
 }
 
 func TestUnmarshalJsoniterExponentialHistogramDataPointBuckets(t *testing.T) {
@@ -552,3 +557,99 @@ func TestExemplar(t *testing.T) {
 	assert.NoError(t, iter.Error)
 	assert.EqualValues(t, NewExemplar(), val)
 }
+
+// metricsEdgeCases holds the values whose JSON encoding needs care: a metric without data, zero
+// values, unset optional fields and non-finite numbers.
+var metricsEdgeCases = func() Metrics {
+	md := NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	ms.AppendEmpty().SetName("no data")
+	gauge := ms.AppendEmpty().SetEmptyGauge()
+	gauge.DataPoints().AppendEmpty().SetDoubleValue(math.NaN())
+	gauge.DataPoints().AppendEmpty().SetDoubleValue(math.Inf(1))
+	gauge.DataPoints().AppendEmpty().SetIntValue(0)
+	gauge.DataPoints().AppendEmpty().SetIntValue(math.MinInt64)
+	gauge.DataPoints().AppendEmpty().Exemplars().AppendEmpty().SetDoubleValue(-0.5)
+	sum := ms.AppendEmpty().SetEmptySum()
+	sum.SetAggregationTemporality(AggregationTemporalityDelta)
+	sum.DataPoints().AppendEmpty().SetFlags(DefaultDataPointFlags.WithNoRecordedValue(true))
+	histogram := ms.AppendEmpty().SetEmptyHistogram()
+	histogram.DataPoints().AppendEmpty().SetSum(0)
+	histogram.DataPoints().AppendEmpty().BucketCounts().FromRaw([]uint64{0, math.MaxUint64})
+	expHistogram := ms.AppendEmpty().SetEmptyExponentialHistogram()
+	dp := expHistogram.DataPoints().AppendEmpty()
+	dp.SetScale(-1)
+	dp.SetMin(-1e-9)
+	dp.Negative().SetOffset(-2)
+	ms.AppendEmpty().SetEmptySummary().DataPoints().AppendEmpty().QuantileValues().AppendEmpty()
+	return md
+}()
+
+func TestJSONMarshalMatchesProtoJSON(t *testing.T) {
+	for name, md := range map[string]Metrics{
+		"empty":                 NewMetrics(),
+		"otlp":                  metricsOTLP,
+		"sum":                   metricsSumOTLPFull(),
+		"gauge":                 metricsGaugeOTLPFull(),
+		"histogram":             metricsHistogramOTLPFull(),
+		"exponential histogram": metricsExponentialHistogramOTLPFull(),
+		"summary":               metricsSummaryOTLPFull(),
+		"edge cases":            metricsEdgeCases,
+		"generated": func() Metrics {
+			md := NewMetrics()
+			fillTestResourceMetricsSlice(md.ResourceMetrics())
+			return md
+		}(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			expected, err := marshalProtoJSON(md)
+			require.NoError(t, err)
+			encoder := &JSONMarshaler{}
+			jsonBuf, err := encoder.MarshalMetrics(md)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), string(jsonBuf))
+		})
+	}
+}
+
+// marshalProtoJSON marshals the metrics with the generic jsonpb marshaler, which JSONMarshaler replaced.
+func marshalProtoJSON(md Metrics) ([]byte, error) {
+	buf := bytes.Buffer{}
+	pb := internal.MetricsToProto(internal.Metrics(md))
+	err := json.Marshal(&buf, &pb)
+	return buf.Bytes(), err
+}
+
+func generateJSONBenchmarkMetrics(count int) Metrics {
+	md := NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	for _, full := range []Metrics{metricsSumOTLPFull(), metricsGaugeOTLPFull(), metricsHistogramOTLPFull(),
+		metricsExponentialHistogramOTLPFull(), metricsSummaryOTLPFull()} {
+		full.ResourceMetrics().At(0).Resource().CopyTo(md.ResourceMetrics().At(0).Resource())
+		for i := 0; i < count; i++ {
+			full.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).CopyTo(ms.AppendEmpty())
+		}
+	}
+	return md
+}
+
+func BenchmarkJSONMarshal(b *testing.B) {
+	md := generateJSONBenchmarkMetrics(128)
+	encoder := &JSONMarshaler{}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_, err := encoder.MarshalMetrics(md)
+		require.NoError(b, err)
+	}
+}
+
+func BenchmarkJSONMarshalProto(b *testing.B) {
+	md := generateJSONBenchmarkMetrics(128)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_, err := marshalProtoJSON(md)
+		require.NoError(b, err)
+	}
+}
@@ -4,8 +4,11 @@
 package pmetric // import "go.opentelemetry.io/collector/pdata/pmetric"
 
 import (
+	"io"
+
 	"go.opentelemetry.io/collector/pdata/internal"
 	otlpmetrics "go.opentelemetry.io/collector/pdata/internal/data/protogen/metrics/v1"
+	"go.opentelemetry.io/collector/pdata/internal/otlp"
 )
 
 var _ MarshalSizer = (*ProtoMarshaler)(nil)
@@ -22,6 +25,8 @@ func (e *ProtoMarshaler) MetricsSize(md Metrics) int {
 	return pb.Size()
 }
 
+var _ StreamUnmarshaler = (*ProtoUnmarshaler)(nil)
+
 type ProtoUnmarshaler struct{}
 
 func (d *ProtoUnmarshaler) UnmarshalMetrics(buf []byte) (Metrics, error) {
@@ -29,3 +34,24 @@ func (d *ProtoUnmarshaler) UnmarshalMetrics(buf []byte) (Metrics, error) {
 	err := pb.Unmarshal(buf)
 	return Metrics(internal.MetricsFromProto(pb)), err
 }
+
+func (d *ProtoUnmarshaler) NewResourceMetricsDecoder(r io.Reader) ResourceMetricsDecoder {
+	return &protoResourceMetricsDecoder{reader: otlp.NewFieldReader(r, otlp.ResourceField)}
+}
+
+type protoResourceMetricsDecoder struct {
+	reader *otlp.FieldReader
+}
+
+func (d *protoResourceMetricsDecoder) DecodeResourceMetrics() (ResourceMetrics, error) {
+	buf, err := d.reader.Next()
+	if err != nil {
+		return ResourceMetrics{}, err
+	}
+	rs := NewResourceMetrics()
+	if err = rs.orig.Unmarshal(buf); err != nil {
+		return ResourceMetrics{}, err
+	}
+	otlp.MigrateMetrics([]*otlpmetrics.ResourceMetrics{rs.orig})
+	return rs, nil
+}
@@ -3,6 +3,8 @@
 
 package ptrace // import "go.opentelemetry.io/collector/pdata/ptrace"
 
+import "io"
+
 // MarshalSizer is the interface that groups the basic Marshal and Size methods
 type MarshalSizer interface {
 	Marshaler
@@ -29,3 +31,18 @@ type Sizer interface {
 	// TracesSize returns the size in bytes of a marshaled Traces.
 	TracesSize(td Traces) int
 }
+
+// StreamUnmarshaler is an optional interface implemented by the Unmarshaler, that unmarshalls
+// traces read from an io.Reader one ResourceSpans at a time, so that the encoded traces are never
+// entirely held in memory.
+type StreamUnmarshaler interface {
+	// NewResourceSpansDecoder returns a decoder of the ResourceSpans of the traces read from r.
+	NewResourceSpansDecoder(r io.Reader) ResourceSpansDecoder
+}
+
+// ResourceSpansDecoder decodes ResourceSpans one at a time.
+type ResourceSpansDecoder interface {
+	// DecodeResourceSpans returns the next ResourceSpans, or io.EOF once all of them have been decoded.
+	// If the error is not nil, the returned ResourceSpans cannot be used.
+	DecodeResourceSpans() (ResourceSpans, error)
+}
@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ptrace
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeAllResourceSpans(decoder ResourceSpansDecoder) (Traces, error) {
+	td := NewTraces()
+	for {
+		rs, err := decoder.DecodeResourceSpans()
+		if errors.Is(err, io.EOF) {
+			return td, nil
+		}
+		if err != nil {
+			return td, err
+		}
+		rs.MoveTo(td.ResourceSpans().AppendEmpty())
+	}
+}
+
+func TestStreamUnmarshaler(t *testing.T) {
+	td := NewTraces()
+	for i := 0; i < 3; i++ {
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutInt("index", int64(i))
+		rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span")
+	}
+	tracesOTLP.ResourceSpans().At(0).CopyTo(td.ResourceSpans().AppendEmpty())
+
+	tests := []struct {
+		name        string
+		marshaler   Marshaler
+		unmarshaler StreamUnmarshaler
+	}{
+		{
+			name:        "proto",
+			marshaler:   &ProtoMarshaler{},
+			unmarshaler: &ProtoUnmarshaler{},
+		},
+		{
+			name:        "json",
+			marshaler:   &JSONMarshaler{},
+			unmarshaler: &JSONUnmarshaler{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf, err := tt.marshaler.MarshalTraces(td)
+			require.NoError(t, err)
+
+			decoded, err := decodeAllResourceSpans(tt.unmarshaler.NewResourceSpansDecoder(iotest.OneByteReader(bytes.NewReader(buf))))
+			require.NoError(t, err)
+			assert.Equal(t, td, decoded)
+
+			empty, err := tt.marshaler.MarshalTraces(NewTraces())
+			require.NoError(t, err)
+			decoded, err = decodeAllResourceSpans(tt.unmarshaler.NewResourceSpansDecoder(bytes.NewReader(empty)))
+			require.NoError(t, err)
+			assert.Equal(t, NewTraces(), decoded)
+
+			_, err = decodeAllResourceSpans(tt.unmarshaler.NewResourceSpansDecoder(bytes.NewReader(buf[:len(buf)-1])))
+			assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+		})
+	}
+}
+
+func TestJSONStreamUnmarshalerSkipsUnknownFields(t *testing.T) {
+	unmarshaler := &JSONUnmarshaler{}
+	decoded, err := decodeAllResourceSpans(unmarshaler.NewResourceSpansDecoder(strings.NewReader(
+		`{"unknown":[1,{"resourceSpans":[]}],"resource_spans":[{"schemaUrl":"first"}],"resourceSpans":[{"schemaUrl":"second"}]}`)))
+	require.NoError(t, err)
+	require.Equal(t, 2, decoded.ResourceSpans().Len())
+	assert.Equal(t, "first", decoded.ResourceSpans().At(0).SchemaUrl())
+	assert.Equal(t, "second", decoded.ResourceSpans().At(1).SchemaUrl())
+
+	_, err = decodeAllResourceSpans(unmarshaler.NewResourceSpansDecoder(strings.NewReader(`{"resourceSpans":[{"schemaUrl":1}]}`)))
+	assert.Error(t, err)
+}
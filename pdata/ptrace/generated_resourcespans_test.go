@@ -39,13 +39,6 @@ func TestResourceSpans_Resource(t *testing.T) {
 	assert.Equal(t, pcommon.Resource(internal.GenerateTestResource()), ms.Resource())
 }
 
-func TestResourceSpans_SchemaUrl(t *testing.T) {
-	ms := NewResourceSpans()
-	assert.Equal(t, "", ms.SchemaUrl())
-	ms.SetSchemaUrl("https://opentelemetry.io/schemas/1.5.0")
-	assert.Equal(t, "https://opentelemetry.io/schemas/1.5.0", ms.SchemaUrl())
-}
-
 func TestResourceSpans_ScopeSpans(t *testing.T) {
 	ms := NewResourceSpans()
 	assert.Equal(t, NewScopeSpansSlice(), ms.ScopeSpans())
@@ -53,6 +46,13 @@ func TestResourceSpans_ScopeSpans(t *testing.T) {
 	assert.Equal(t, generateTestScopeSpansSlice(), ms.ScopeSpans())
 }
 
+func TestResourceSpans_SchemaUrl(t *testing.T) {
+	ms := NewResourceSpans()
+	assert.Equal(t, "", ms.SchemaUrl())
+	ms.SetSchemaUrl("https://opentelemetry.io/schemas/1.5.0")
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.5.0", ms.SchemaUrl())
+}
+
 func generateTestResourceSpans() ResourceSpans {
 	tv := NewResourceSpans()
 	fillTestResourceSpans(tv)
@@ -61,6 +61,6 @@ func generateTestResourceSpans() ResourceSpans {
 
 func fillTestResourceSpans(tv ResourceSpans) {
 	internal.FillTestResource(internal.NewResource(&tv.orig.Resource))
-	tv.orig.SchemaUrl = "https://opentelemetry.io/schemas/1.5.0"
 	fillTestScopeSpansSlice(newScopeSpansSlice(&tv.orig.ScopeSpans))
+	tv.orig.SchemaUrl = "https://opentelemetry.io/schemas/1.5.0"
 }
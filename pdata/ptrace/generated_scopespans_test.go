@@ -39,13 +39,6 @@ func TestScopeSpans_Scope(t *testing.T) {
 	assert.Equal(t, pcommon.InstrumentationScope(internal.GenerateTestInstrumentationScope()), ms.Scope())
 }
 
-func TestScopeSpans_SchemaUrl(t *testing.T) {
-	ms := NewScopeSpans()
-	assert.Equal(t, "", ms.SchemaUrl())
-	ms.SetSchemaUrl("https://opentelemetry.io/schemas/1.5.0")
-	assert.Equal(t, "https://opentelemetry.io/schemas/1.5.0", ms.SchemaUrl())
-}
-
 func TestScopeSpans_Spans(t *testing.T) {
 	ms := NewScopeSpans()
 	assert.Equal(t, NewSpanSlice(), ms.Spans())
@@ -53,6 +46,13 @@ func TestScopeSpans_Spans(t *testing.T) {
 	assert.Equal(t, generateTestSpanSlice(), ms.Spans())
 }
 
+func TestScopeSpans_SchemaUrl(t *testing.T) {
+	ms := NewScopeSpans()
+	assert.Equal(t, "", ms.SchemaUrl())
+	ms.SetSchemaUrl("https://opentelemetry.io/schemas/1.5.0")
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.5.0", ms.SchemaUrl())
+}
+
 func generateTestScopeSpans() ScopeSpans {
 	tv := NewScopeSpans()
 	fillTestScopeSpans(tv)
@@ -61,6 +61,6 @@ func generateTestScopeSpans() ScopeSpans {
 
 func fillTestScopeSpans(tv ScopeSpans) {
 	internal.FillTestInstrumentationScope(internal.NewInstrumentationScope(&tv.orig.Scope))
-	tv.orig.SchemaUrl = "https://opentelemetry.io/schemas/1.5.0"
 	fillTestSpanSlice(newSpanSlice(&tv.orig.Spans))
+	tv.orig.SchemaUrl = "https://opentelemetry.io/schemas/1.5.0"
 }
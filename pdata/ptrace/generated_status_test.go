@@ -30,6 +30,13 @@ func TestStatus_CopyTo(t *testing.T) {
 	assert.Equal(t, orig, ms)
 }
 
+func TestStatus_Message(t *testing.T) {
+	ms := NewStatus()
+	assert.Equal(t, "", ms.Message())
+	ms.SetMessage("cancelled")
+	assert.Equal(t, "cancelled", ms.Message())
+}
+
 func TestStatus_Code(t *testing.T) {
 	ms := NewStatus()
 	assert.Equal(t, StatusCode(0), ms.Code())
@@ -38,13 +45,6 @@ func TestStatus_Code(t *testing.T) {
 	assert.Equal(t, testValCode, ms.Code())
 }
 
-func TestStatus_Message(t *testing.T) {
-	ms := NewStatus()
-	assert.Equal(t, "", ms.Message())
-	ms.SetMessage("cancelled")
-	assert.Equal(t, "cancelled", ms.Message())
-}
-
 func generateTestStatus() Status {
 	tv := NewStatus()
 	fillTestStatus(tv)
@@ -52,6 +52,6 @@ func generateTestStatus() Status {
 }
 
 func fillTestStatus(tv Status) {
-	tv.orig.Code = 1
 	tv.orig.Message = "cancelled"
+	tv.orig.Code = 1
 }
@@ -4,8 +4,8 @@
 package ptrace // import "go.opentelemetry.io/collector/pdata/ptrace"
 
 import (
-	"bytes"
 	"fmt"
+	"io"
 
 	jsoniter "github.com/json-iterator/go"
 
@@ -18,12 +18,27 @@ import (
 type JSONMarshaler struct{}
 
 func (*JSONMarshaler) MarshalTraces(td Traces) ([]byte, error) {
-	buf := bytes.Buffer{}
-	pb := internal.TracesToProto(internal.Traces(td))
-	err := json.Marshal(&buf, &pb)
-	return buf.Bytes(), err
+	dest := json.BorrowStream()
+	td.marshalJsoniter(dest)
+	return json.ReturnStream(dest)
 }
 
+func (ms Traces) marshalJsoniter(dest *jsoniter.Stream) {
+	dest.WriteObjectStart()
+	if rss := ms.getOrig().ResourceSpans; rss != nil {
+		json.WriteObjectField(dest, "resourceSpans")
+		dest.WriteArrayStart()
+		for i := range rss {
+			json.WriteArrayElement(dest, i)
+			newResourceSpans(rss[i]).marshalJsoniter(dest)
+		}
+		dest.WriteArrayEnd()
+	}
+	dest.WriteObjectEnd()
+}
+
+var _ StreamUnmarshaler = (*JSONUnmarshaler)(nil)
+
 type JSONUnmarshaler struct{}
 
 func (*JSONUnmarshaler) UnmarshalTraces(buf []byte) (Traces, error) {
@@ -38,6 +53,28 @@ func (*JSONUnmarshaler) UnmarshalTraces(buf []byte) (Traces, error) {
 	return td, nil
 }
 
+func (*JSONUnmarshaler) NewResourceSpansDecoder(r io.Reader) ResourceSpansDecoder {
+	return &jsonResourceSpansDecoder{decoder: json.NewArrayDecoder(r, "resourceSpans", "resource_spans")}
+}
+
+type jsonResourceSpansDecoder struct {
+	decoder *json.ArrayDecoder
+}
+
+func (d *jsonResourceSpansDecoder) DecodeResourceSpans() (ResourceSpans, error) {
+	iter, err := d.decoder.Next()
+	if err != nil {
+		return ResourceSpans{}, err
+	}
+	rs := NewResourceSpans()
+	rs.unmarshalJsoniter(iter)
+	if err = d.decoder.Err(); err != nil {
+		return ResourceSpans{}, err
+	}
+	otlp.MigrateTraces([]*otlptrace.ResourceSpans{rs.orig})
+	return rs, nil
+}
+
 func (ms Traces) unmarshalJsoniter(iter *jsoniter.Iterator) {
 	iter.ReadObjectCB(func(iter *jsoniter.Iterator, f string) bool {
 		switch f {
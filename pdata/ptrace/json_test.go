@@ -4,11 +4,16 @@
 package ptrace
 
 import (
+	"bytes"
+	"math"
 	"testing"
 
 	jsoniter "github.com/json-iterator/go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/collector/pdata/internal"
+	"go.opentelemetry.io/collector/pdata/internal/json"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 )
 
@@ -247,3 +252,93 @@ func BenchmarkJSONUnmarshal(b *testing.B) {
 		}
 	})
 }
+
+// tracesEdgeCases holds the values whose JSON encoding needs care: escaped strings, zero values,
+// empty but non-nil collections and non-finite numbers.
+var tracesEdgeCases = func() Traces {
+	td := NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("html", "<script>&</script>")
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Scope().SetName("  \x01 \xff")
+	sp := ss.Spans().AppendEmpty()
+	sp.SetName("\"quoted\"\n")
+	sp.SetSpanID([8]byte{0xff})
+	sp.Attributes().PutInt("zero", 0)
+	sp.Attributes().PutInt("negative", -1)
+	sp.Attributes().PutBool("false", false)
+	sp.Attributes().PutStr("empty", "")
+	sp.Attributes().PutDouble("nan", math.NaN())
+	sp.Attributes().PutDouble("inf", math.Inf(-1))
+	sp.Attributes().PutDouble("small", 1e-9)
+	sp.Attributes().PutEmptyBytes("bytes")
+	sp.Attributes().PutEmpty("empty value")
+	sp.Attributes().PutEmptySlice("slice")
+	sp.Attributes().PutEmptyMap("map")
+	sp.Events().AppendEmpty()
+	sp.Links().AppendEmpty()
+	sp.Status().SetCode(StatusCodeError)
+	return td
+}()
+
+func TestJSONMarshalMatchesProtoJSON(t *testing.T) {
+	for name, td := range map[string]Traces{
+		"empty":      NewTraces(),
+		"otlp":       tracesOTLP,
+		"edge cases": tracesEdgeCases,
+		"benchmark":  generateJSONBenchmarkTraces(3),
+		"generated": func() Traces {
+			td := NewTraces()
+			fillTestResourceSpansSlice(td.ResourceSpans())
+			return td
+		}(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			expected, err := marshalProtoJSON(td)
+			require.NoError(t, err)
+			encoder := &JSONMarshaler{}
+			jsonBuf, err := encoder.MarshalTraces(td)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), string(jsonBuf))
+		})
+	}
+}
+
+// marshalProtoJSON marshals the traces with the generic jsonpb marshaler, which JSONMarshaler replaced.
+func marshalProtoJSON(td Traces) ([]byte, error) {
+	buf := bytes.Buffer{}
+	pb := internal.TracesToProto(internal.Traces(td))
+	err := json.Marshal(&buf, &pb)
+	return buf.Bytes(), err
+}
+
+func generateJSONBenchmarkTraces(spansCount int) Traces {
+	td := NewTraces()
+	tracesOTLP.ResourceSpans().At(0).Resource().CopyTo(td.ResourceSpans().AppendEmpty().Resource())
+	spans := td.ResourceSpans().At(0).ScopeSpans().AppendEmpty().Spans()
+	for i := 0; i < spansCount; i++ {
+		tracesOTLP.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).CopyTo(spans.AppendEmpty())
+	}
+	return td
+}
+
+func BenchmarkJSONMarshal(b *testing.B) {
+	td := generateJSONBenchmarkTraces(128)
+	encoder := &JSONMarshaler{}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_, err := encoder.MarshalTraces(td)
+		require.NoError(b, err)
+	}
+}
+
+func BenchmarkJSONMarshalProto(b *testing.B) {
+	td := generateJSONBenchmarkTraces(128)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_, err := marshalProtoJSON(td)
+		require.NoError(b, err)
+	}
+}
@@ -4,8 +4,11 @@
 package ptrace // import "go.opentelemetry.io/collector/pdata/ptrace"
 
 import (
+	"io"
+
 	"go.opentelemetry.io/collector/pdata/internal"
 	otlptrace "go.opentelemetry.io/collector/pdata/internal/data/protogen/trace/v1"
+	"go.opentelemetry.io/collector/pdata/internal/otlp"
 )
 
 var _ MarshalSizer = (*ProtoMarshaler)(nil)
@@ -22,6 +25,8 @@ func (e *ProtoMarshaler) TracesSize(td Traces) int {
 	return pb.Size()
 }
 
+var _ StreamUnmarshaler = (*ProtoUnmarshaler)(nil)
+
 type ProtoUnmarshaler struct{}
 
 func (d *ProtoUnmarshaler) UnmarshalTraces(buf []byte) (Traces, error) {
@@ -29,3 +34,24 @@ func (d *ProtoUnmarshaler) UnmarshalTraces(buf []byte) (Traces, error) {
 	err := pb.Unmarshal(buf)
 	return Traces(internal.TracesFromProto(pb)), err
 }
+
+func (d *ProtoUnmarshaler) NewResourceSpansDecoder(r io.Reader) ResourceSpansDecoder {
+	return &protoResourceSpansDecoder{reader: otlp.NewFieldReader(r, otlp.ResourceField)}
+}
+
+type protoResourceSpansDecoder struct {
+	reader *otlp.FieldReader
+}
+
+func (d *protoResourceSpansDecoder) DecodeResourceSpans() (ResourceSpans, error) {
+	buf, err := d.reader.Next()
+	if err != nil {
+		return ResourceSpans{}, err
+	}
+	rs := NewResourceSpans()
+	if err = rs.orig.Unmarshal(buf); err != nil {
+		return ResourceSpans{}, err
+	}
+	otlp.MigrateTraces([]*otlptrace.ResourceSpans{rs.orig})
+	return rs, nil
+}
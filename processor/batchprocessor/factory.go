@@ -50,7 +50,7 @@ func createTraces(
 	cfg component.Config,
 	nextConsumer consumer.Traces,
 ) (processor.Traces, error) {
-	return newBatchTracesProcessor(set, nextConsumer, cfg.(*Config), obsreportconfig.UseOtelForInternalMetricsfeatureGate.IsEnabled())
+	return newBatchTracesProcessor(set, nextConsumer, cfg.(*Config), set.TelemetrySettings.FeatureGates.IsEnabled(obsreportconfig.UseOtelForInternalMetricsfeatureGate.ID()))
 }
 
 func createMetrics(
@@ -59,7 +59,7 @@ func createMetrics(
 	cfg component.Config,
 	nextConsumer consumer.Metrics,
 ) (processor.Metrics, error) {
-	return newBatchMetricsProcessor(set, nextConsumer, cfg.(*Config), obsreportconfig.UseOtelForInternalMetricsfeatureGate.IsEnabled())
+	return newBatchMetricsProcessor(set, nextConsumer, cfg.(*Config), set.TelemetrySettings.FeatureGates.IsEnabled(obsreportconfig.UseOtelForInternalMetricsfeatureGate.ID()))
 }
 
 func createLogs(
@@ -68,5 +68,5 @@ func createLogs(
 	cfg component.Config,
 	nextConsumer consumer.Logs,
 ) (processor.Logs, error) {
-	return newBatchLogsProcessor(set, nextConsumer, cfg.(*Config), obsreportconfig.UseOtelForInternalMetricsfeatureGate.IsEnabled())
+	return newBatchLogsProcessor(set, nextConsumer, cfg.(*Config), set.TelemetrySettings.FeatureGates.IsEnabled(obsreportconfig.UseOtelForInternalMetricsfeatureGate.ID()))
 }
@@ -8,8 +8,11 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/featuregate"
+	"go.opentelemetry.io/collector/internal/obsreportconfig"
 	"go.opentelemetry.io/collector/processor/processortest"
 )
 
@@ -38,3 +41,21 @@ func TestCreateProcessor(t *testing.T) {
 	assert.NotNil(t, lp)
 	assert.NoError(t, err, "cannot create logs processor")
 }
+
+func TestCreateProcessorFeatureGates(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	creationSet := processortest.NewNopCreateSettings()
+	tp, err := factory.CreateTracesProcessor(context.Background(), creationSet, cfg, nil)
+	require.NoError(t, err)
+	assert.False(t, tp.(*batchProcessor).telemetry.useOtel)
+
+	// The gate can be enabled for a single processor.
+	view, err := featuregate.GlobalRegistry().NewView(map[string]bool{obsreportconfig.UseOtelForInternalMetricsfeatureGate.ID(): true})
+	require.NoError(t, err)
+	creationSet.TelemetrySettings.FeatureGates = view
+	tp, err = factory.CreateTracesProcessor(context.Background(), creationSet, cfg, nil)
+	require.NoError(t, err)
+	assert.True(t, tp.(*batchProcessor).telemetry.useOtel)
+	assert.False(t, obsreportconfig.UseOtelForInternalMetricsfeatureGate.IsEnabled())
+}
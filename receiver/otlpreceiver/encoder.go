@@ -5,13 +5,18 @@ package otlpreceiver // import "go.opentelemetry.io/collector/receiver/otlprecei
 
 import (
 	"bytes"
+	"errors"
+	"io"
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
 	spb "google.golang.org/genproto/googleapis/rpc/status"
 
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
 )
 
@@ -27,9 +32,9 @@ var (
 )
 
 type encoder interface {
-	unmarshalTracesRequest(buf []byte) (ptraceotlp.ExportRequest, error)
-	unmarshalMetricsRequest(buf []byte) (pmetricotlp.ExportRequest, error)
-	unmarshalLogsRequest(buf []byte) (plogotlp.ExportRequest, error)
+	newResourceSpansDecoder(r io.Reader) ptrace.ResourceSpansDecoder
+	newResourceMetricsDecoder(r io.Reader) pmetric.ResourceMetricsDecoder
+	newResourceLogsDecoder(r io.Reader) plog.ResourceLogsDecoder
 
 	marshalTracesResponse(ptraceotlp.ExportResponse) ([]byte, error)
 	marshalMetricsResponse(pmetricotlp.ExportResponse) ([]byte, error)
@@ -42,22 +47,16 @@ type encoder interface {
 
 type protoEncoder struct{}
 
-func (protoEncoder) unmarshalTracesRequest(buf []byte) (ptraceotlp.ExportRequest, error) {
-	req := ptraceotlp.NewExportRequest()
-	err := req.UnmarshalProto(buf)
-	return req, err
+func (protoEncoder) newResourceSpansDecoder(r io.Reader) ptrace.ResourceSpansDecoder {
+	return (&ptrace.ProtoUnmarshaler{}).NewResourceSpansDecoder(r)
 }
 
-func (protoEncoder) unmarshalMetricsRequest(buf []byte) (pmetricotlp.ExportRequest, error) {
-	req := pmetricotlp.NewExportRequest()
-	err := req.UnmarshalProto(buf)
-	return req, err
+func (protoEncoder) newResourceMetricsDecoder(r io.Reader) pmetric.ResourceMetricsDecoder {
+	return (&pmetric.ProtoUnmarshaler{}).NewResourceMetricsDecoder(r)
 }
 
-func (protoEncoder) unmarshalLogsRequest(buf []byte) (plogotlp.ExportRequest, error) {
-	req := plogotlp.NewExportRequest()
-	err := req.UnmarshalProto(buf)
-	return req, err
+func (protoEncoder) newResourceLogsDecoder(r io.Reader) plog.ResourceLogsDecoder {
+	return (&plog.ProtoUnmarshaler{}).NewResourceLogsDecoder(r)
 }
 
 func (protoEncoder) marshalTracesResponse(resp ptraceotlp.ExportResponse) ([]byte, error) {
@@ -82,22 +81,16 @@ func (protoEncoder) contentType() string {
 
 type jsonEncoder struct{}
 
-func (jsonEncoder) unmarshalTracesRequest(buf []byte) (ptraceotlp.ExportRequest, error) {
-	req := ptraceotlp.NewExportRequest()
-	err := req.UnmarshalJSON(buf)
-	return req, err
+func (jsonEncoder) newResourceSpansDecoder(r io.Reader) ptrace.ResourceSpansDecoder {
+	return (&ptrace.JSONUnmarshaler{}).NewResourceSpansDecoder(r)
 }
 
-func (jsonEncoder) unmarshalMetricsRequest(buf []byte) (pmetricotlp.ExportRequest, error) {
-	req := pmetricotlp.NewExportRequest()
-	err := req.UnmarshalJSON(buf)
-	return req, err
+func (jsonEncoder) newResourceMetricsDecoder(r io.Reader) pmetric.ResourceMetricsDecoder {
+	return (&pmetric.JSONUnmarshaler{}).NewResourceMetricsDecoder(r)
 }
 
-func (jsonEncoder) unmarshalLogsRequest(buf []byte) (plogotlp.ExportRequest, error) {
-	req := plogotlp.NewExportRequest()
-	err := req.UnmarshalJSON(buf)
-	return req, err
+func (jsonEncoder) newResourceLogsDecoder(r io.Reader) plog.ResourceLogsDecoder {
+	return (&plog.JSONUnmarshaler{}).NewResourceLogsDecoder(r)
 }
 
 func (jsonEncoder) marshalTracesResponse(resp ptraceotlp.ExportResponse) ([]byte, error) {
@@ -121,3 +114,112 @@ func (jsonEncoder) marshalStatus(resp *spb.Status) ([]byte, error) {
 func (jsonEncoder) contentType() string {
 	return jsonContentType
 }
+
+// maxBatchSize is the number of spans, data points or log records from which the data decoded
+// from a request is passed to the next consumer, without waiting for the end of the request.
+const maxBatchSize = 8192
+
+// decodeError is an error decoding a request, as opposed to an error returned when consuming it.
+type decodeError struct {
+	err error
+}
+
+func (e *decodeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *decodeError) Unwrap() error {
+	return e.err
+}
+
+// decodeTraces decodes the request one ResourceSpans at a time, and passes them to consume in
+// batches of at least maxBatchSize spans, but for the last one, so that neither the encoded request
+// nor the decoded traces are ever entirely held in memory. A ResourceSpans is never split.
+//
+// Decoding stops at the first error, either a *decodeError or the error returned by consume.
+// The batches consumed before the error are not rolled back.
+func decodeTraces(decoder ptrace.ResourceSpansDecoder, consume func(ptrace.Traces) error) error {
+	td, count := ptrace.NewTraces(), 0
+	for {
+		rs, err := decoder.DecodeResourceSpans()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return &decodeError{err: err}
+		}
+		// Count the spans of the decoded ResourceSpans alone, rather than of the whole batch.
+		decoded := ptrace.NewTraces()
+		rs.MoveTo(decoded.ResourceSpans().AppendEmpty())
+		count += decoded.SpanCount()
+		decoded.ResourceSpans().MoveAndAppendTo(td.ResourceSpans())
+		if count >= maxBatchSize {
+			if err = consume(td); err != nil {
+				return err
+			}
+			td, count = ptrace.NewTraces(), 0
+		}
+	}
+	if td.ResourceSpans().Len() == 0 {
+		return nil
+	}
+	return consume(td)
+}
+
+// decodeMetrics decodes the request one ResourceMetrics at a time, and passes them to consume in
+// batches of at least maxBatchSize data points, like decodeTraces.
+func decodeMetrics(decoder pmetric.ResourceMetricsDecoder, consume func(pmetric.Metrics) error) error {
+	md, count := pmetric.NewMetrics(), 0
+	for {
+		rm, err := decoder.DecodeResourceMetrics()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return &decodeError{err: err}
+		}
+		decoded := pmetric.NewMetrics()
+		rm.MoveTo(decoded.ResourceMetrics().AppendEmpty())
+		count += decoded.DataPointCount()
+		decoded.ResourceMetrics().MoveAndAppendTo(md.ResourceMetrics())
+		if count >= maxBatchSize {
+			if err = consume(md); err != nil {
+				return err
+			}
+			md, count = pmetric.NewMetrics(), 0
+		}
+	}
+	if md.ResourceMetrics().Len() == 0 {
+		return nil
+	}
+	return consume(md)
+}
+
+// decodeLogs decodes the request one ResourceLogs at a time, and passes them to consume in
+// batches of at least maxBatchSize log records, like decodeTraces.
+func decodeLogs(decoder plog.ResourceLogsDecoder, consume func(plog.Logs) error) error {
+	ld, count := plog.NewLogs(), 0
+	for {
+		rl, err := decoder.DecodeResourceLogs()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return &decodeError{err: err}
+		}
+		decoded := plog.NewLogs()
+		rl.MoveTo(decoded.ResourceLogs().AppendEmpty())
+		count += decoded.LogRecordCount()
+		decoded.ResourceLogs().MoveAndAppendTo(ld.ResourceLogs())
+		if count >= maxBatchSize {
+			if err = consume(ld); err != nil {
+				return err
+			}
+			ld, count = plog.NewLogs(), 0
+		}
+	}
+	if ld.ResourceLogs().Len() == 0 {
+		return nil
+	}
+	return consume(ld)
+}
@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpreceiver
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+)
+
+func TestDecodeTraces(t *testing.T) {
+	req := ptraceotlp.NewExportRequestFromTraces(traceOtlp)
+	pbBody, err := req.MarshalProto()
+	require.NoError(t, err)
+	jsonBody, err := req.MarshalJSON()
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		encoder encoder
+		body    []byte
+	}{
+		{encoder: pbEncoder, body: pbBody},
+		{encoder: jsEncoder, body: jsonBody},
+	} {
+		t.Run(tt.encoder.contentType(), func(t *testing.T) {
+			var batches []ptrace.Traces
+			consume := func(td ptrace.Traces) error {
+				batches = append(batches, td)
+				return nil
+			}
+			require.NoError(t, decodeTraces(tt.encoder.newResourceSpansDecoder(bytes.NewReader(tt.body)), consume))
+			require.Len(t, batches, 1)
+			assert.Equal(t, traceOtlp, batches[0])
+
+			err := decodeTraces(tt.encoder.newResourceSpansDecoder(bytes.NewReader(tt.body[:len(tt.body)-1])), consume)
+			assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+			assert.Equal(t, http.StatusBadRequest, errorStatusCode(err))
+		})
+	}
+}
+
+func TestDecodeTracesBatches(t *testing.T) {
+	// The first two resources reach the batch size, the last one is consumed at the end.
+	td := ptrace.NewTraces()
+	for i := 0; i < 3; i++ {
+		spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+		for j := 0; j < maxBatchSize/2+1; j++ {
+			spans.AppendEmpty().SetName("span")
+		}
+	}
+	body, err := ptraceotlp.NewExportRequestFromTraces(td).MarshalProto()
+	require.NoError(t, err)
+
+	var counts []int
+	require.NoError(t, decodeTraces(pbEncoder.newResourceSpansDecoder(bytes.NewReader(body)), func(td ptrace.Traces) error {
+		counts = append(counts, td.SpanCount())
+		return nil
+	}))
+	assert.Equal(t, []int{maxBatchSize + 2, maxBatchSize/2 + 1}, counts)
+
+	// Decoding stops at the first error of the consumer, the batches before it are consumed.
+	counts = nil
+	consumeErr := errors.New("consume error")
+	err = decodeTraces(pbEncoder.newResourceSpansDecoder(bytes.NewReader(body)), func(td ptrace.Traces) error {
+		counts = append(counts, td.SpanCount())
+		return consumeErr
+	})
+	assert.ErrorIs(t, err, consumeErr)
+	assert.Equal(t, http.StatusInternalServerError, errorStatusCode(err))
+	assert.Equal(t, []int{maxBatchSize + 2}, counts)
+
+	// The batches decoded before an invalid part of the request are consumed.
+	counts = nil
+	err = decodeTraces(pbEncoder.newResourceSpansDecoder(bytes.NewReader(body[:len(body)-1])), func(td ptrace.Traces) error {
+		counts = append(counts, td.SpanCount())
+		return nil
+	})
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	assert.Equal(t, []int{maxBatchSize + 2}, counts)
+}
+
+func TestDecodeMetricsBatches(t *testing.T) {
+	md := pmetric.NewMetrics()
+	for i := 0; i < 3; i++ {
+		dps := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints()
+		for j := 0; j < maxBatchSize/2+1; j++ {
+			dps.AppendEmpty().SetIntValue(int64(j))
+		}
+	}
+	body, err := pmetricotlp.NewExportRequestFromMetrics(md).MarshalJSON()
+	require.NoError(t, err)
+
+	var counts []int
+	require.NoError(t, decodeMetrics(jsEncoder.newResourceMetricsDecoder(bytes.NewReader(body)), func(md pmetric.Metrics) error {
+		counts = append(counts, md.DataPointCount())
+		return nil
+	}))
+	assert.Equal(t, []int{maxBatchSize + 2, maxBatchSize/2 + 1}, counts)
+}
+
+func TestDecodeLogsBatches(t *testing.T) {
+	ld := plog.NewLogs()
+	for i := 0; i < 3; i++ {
+		lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+		for j := 0; j < maxBatchSize/2+1; j++ {
+			lrs.AppendEmpty().Body().SetStr("log")
+		}
+	}
+	body, err := plogotlp.NewExportRequestFromLogs(ld).MarshalProto()
+	require.NoError(t, err)
+
+	var counts []int
+	require.NoError(t, decodeLogs(pbEncoder.newResourceLogsDecoder(bytes.NewReader(body)), func(ld plog.Logs) error {
+		counts = append(counts, ld.LogRecordCount())
+		return nil
+	}))
+	assert.Equal(t, []int{maxBatchSize + 2, maxBatchSize/2 + 1}, counts)
+}
+
+func TestDecodeEmpty(t *testing.T) {
+	// Nothing is consumed for an empty request.
+	require.NoError(t, decodeTraces(pbEncoder.newResourceSpansDecoder(bytes.NewReader(nil)), func(ptrace.Traces) error {
+		t.Fatal("unexpected consume")
+		return nil
+	}))
+}
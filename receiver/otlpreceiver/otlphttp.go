@@ -4,7 +4,7 @@
 package otlpreceiver // import "go.opentelemetry.io/collector/receiver/otlpreceiver"
 
 import (
-	"io"
+	"errors"
 	"mime"
 	"net/http"
 
@@ -12,6 +12,12 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
 	"go.opentelemetry.io/collector/receiver/otlpreceiver/internal/logs"
 	"go.opentelemetry.io/collector/receiver/otlpreceiver/internal/metrics"
 	"go.opentelemetry.io/collector/receiver/otlpreceiver/internal/trace"
@@ -23,24 +29,17 @@ var fallbackMsg = []byte(`{"code": 13, "message": "failed to marshal error messa
 const fallbackContentType = "application/json"
 
 func handleTraces(resp http.ResponseWriter, req *http.Request, tracesReceiver *trace.Receiver, encoder encoder) {
-	body, ok := readAndCloseBody(resp, req, encoder)
-	if !ok {
-		return
-	}
-
-	otlpReq, err := encoder.unmarshalTracesRequest(body)
-	if err != nil {
-		writeError(resp, encoder, err, http.StatusBadRequest)
-		return
-	}
-
-	otlpResp, err := tracesReceiver.Export(req.Context(), otlpReq)
+	defer closeBody(req)
+	err := decodeTraces(encoder.newResourceSpansDecoder(req.Body), func(data ptrace.Traces) error {
+		_, err := tracesReceiver.Export(req.Context(), ptraceotlp.NewExportRequestFromTraces(data))
+		return err
+	})
 	if err != nil {
-		writeError(resp, encoder, err, http.StatusInternalServerError)
+		writeError(resp, encoder, err, errorStatusCode(err))
 		return
 	}
 
-	msg, err := encoder.marshalTracesResponse(otlpResp)
+	msg, err := encoder.marshalTracesResponse(ptraceotlp.NewExportResponse())
 	if err != nil {
 		writeError(resp, encoder, err, http.StatusInternalServerError)
 		return
@@ -49,24 +48,17 @@ func handleTraces(resp http.ResponseWriter, req *http.Request, tracesReceiver *t
 }
 
 func handleMetrics(resp http.ResponseWriter, req *http.Request, metricsReceiver *metrics.Receiver, encoder encoder) {
-	body, ok := readAndCloseBody(resp, req, encoder)
-	if !ok {
-		return
-	}
-
-	otlpReq, err := encoder.unmarshalMetricsRequest(body)
+	defer closeBody(req)
+	err := decodeMetrics(encoder.newResourceMetricsDecoder(req.Body), func(data pmetric.Metrics) error {
+		_, err := metricsReceiver.Export(req.Context(), pmetricotlp.NewExportRequestFromMetrics(data))
+		return err
+	})
 	if err != nil {
-		writeError(resp, encoder, err, http.StatusBadRequest)
+		writeError(resp, encoder, err, errorStatusCode(err))
 		return
 	}
 
-	otlpResp, err := metricsReceiver.Export(req.Context(), otlpReq)
-	if err != nil {
-		writeError(resp, encoder, err, http.StatusInternalServerError)
-		return
-	}
-
-	msg, err := encoder.marshalMetricsResponse(otlpResp)
+	msg, err := encoder.marshalMetricsResponse(pmetricotlp.NewExportResponse())
 	if err != nil {
 		writeError(resp, encoder, err, http.StatusInternalServerError)
 		return
@@ -75,24 +67,17 @@ func handleMetrics(resp http.ResponseWriter, req *http.Request, metricsReceiver
 }
 
 func handleLogs(resp http.ResponseWriter, req *http.Request, logsReceiver *logs.Receiver, encoder encoder) {
-	body, ok := readAndCloseBody(resp, req, encoder)
-	if !ok {
-		return
-	}
-
-	otlpReq, err := encoder.unmarshalLogsRequest(body)
+	defer closeBody(req)
+	err := decodeLogs(encoder.newResourceLogsDecoder(req.Body), func(data plog.Logs) error {
+		_, err := logsReceiver.Export(req.Context(), plogotlp.NewExportRequestFromLogs(data))
+		return err
+	})
 	if err != nil {
-		writeError(resp, encoder, err, http.StatusBadRequest)
+		writeError(resp, encoder, err, errorStatusCode(err))
 		return
 	}
 
-	otlpResp, err := logsReceiver.Export(req.Context(), otlpReq)
-	if err != nil {
-		writeError(resp, encoder, err, http.StatusInternalServerError)
-		return
-	}
-
-	msg, err := encoder.marshalLogsResponse(otlpResp)
+	msg, err := encoder.marshalLogsResponse(plogotlp.NewExportResponse())
 	if err != nil {
 		writeError(resp, encoder, err, http.StatusInternalServerError)
 		return
@@ -100,17 +85,21 @@ func handleLogs(resp http.ResponseWriter, req *http.Request, logsReceiver *logs.
 	writeResponse(resp, encoder.contentType(), http.StatusOK, msg)
 }
 
-func readAndCloseBody(resp http.ResponseWriter, req *http.Request, encoder encoder) ([]byte, bool) {
-	body, err := io.ReadAll(req.Body)
-	if err != nil {
-		writeError(resp, encoder, err, http.StatusBadRequest)
-		return nil, false
-	}
-	if err = req.Body.Close(); err != nil {
-		writeError(resp, encoder, err, http.StatusBadRequest)
-		return nil, false
+// closeBody closes the body of the request, which may not have been read entirely if
+// decoding or consuming it failed.
+func closeBody(req *http.Request) {
+	// Nothing we can do with the error, the response does not depend on it.
+	_ = req.Body.Close()
+}
+
+// errorStatusCode returns the status code of the response to a request which could not be decoded,
+// or whose data could not be consumed.
+func errorStatusCode(err error) int {
+	var decodeErr *decodeError
+	if errors.As(err, &decodeErr) {
+		return http.StatusBadRequest
 	}
-	return body, true
+	return http.StatusInternalServerError
 }
 
 // writeError encodes the HTTP error inside a rpc.Status message as required by the OTLP protocol.
@@ -22,6 +22,7 @@ import (
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/receiver"
 )
@@ -44,8 +45,10 @@ type Generator interface {
 	// to the receiver and return a copy of generated element ids.
 	// The generated data must contain uniquely identifiable elements, each with a
 	// different value of attribute named UniqueIDAttrName.
-	// CreateOneLogWithID() can be used a helper to create such logs.
-	// May be called concurrently from multiple goroutines.
+	// CreateOneLogWithID(), CreateOneSpanWithID() and CreateOneMetricWithID() can be used
+	// as helpers to create such data.
+	// May be called concurrently from multiple goroutines, and while the receiver is
+	// shutting down, in which case the receiver may refuse the data.
 	Generate() []UniqueIDAttrVal
 }
 
@@ -66,15 +69,13 @@ type CheckConsumeContractParams struct {
 
 // CheckConsumeContract checks the contract between the receiver and its next consumer. For the contract
 // description see ../doc.go. The checker will detect violations of contract on different scenarios: on success,
-// on permanent and non-permanent errors and mix of error types.
+// on permanent and non-permanent errors and mix of error types, with many concurrent senders, with a next
+// consumer that blocks and with the receiver shut down while data is being sent.
 func CheckConsumeContract(params CheckConsumeContractParams) {
 	// Different scenarios to test for.
 	// The decision function defines the testing scenario (i.e. to test for
 	// success case or for error case or a mix of both). See for example randomErrorsConsumeDecision.
-	scenarios := []struct {
-		name         string
-		decisionFunc func(ids idSet) error
-	}{
+	scenarios := []contractScenario{
 		{
 			name: "always_succeed",
 			// Always succeed. We expect all data to be delivered as is.
@@ -92,19 +93,53 @@ func CheckConsumeContract(params CheckConsumeContractParams) {
 			name:         "random_error",
 			decisionFunc: randomErrorsConsumeDecision,
 		},
+		{
+			name:         "concurrent_senders",
+			decisionFunc: randomErrorsConsumeDecision,
+			concurrency:  32,
+		},
+		{
+			name: "blocking_consumer",
+			// The next consumer blocks before deciding, so that the receiver has many
+			// calls in flight at the same time.
+			decisionFunc: blockingConsumeDecision(randomErrorsConsumeDecision),
+		},
+		{
+			name:         "shutdown_mid_test",
+			decisionFunc: blockingConsumeDecision(randomErrorsConsumeDecision),
+			// The receiver is shut down when half of the data is generated. The data refused
+			// by the receiver while shutting down is not delivered, but the data that is
+			// delivered must still be delivered exactly once.
+			shutdownMidTest: true,
+		},
 	}
 
 	for _, scenario := range scenarios {
+		scenario := scenario
 		params.T.Run(
 			scenario.name, func(t *testing.T) {
-				checkConsumeContractScenario(params, scenario.decisionFunc)
+				scenarioParams := params
+				scenarioParams.T = t
+				checkConsumeContractScenario(scenarioParams, scenario)
 			},
 		)
 	}
 }
 
-func checkConsumeContractScenario(params CheckConsumeContractParams, decisionFunc func(ids idSet) error) {
-	consumer := &mockConsumer{t: params.T, consumeDecisionFunc: decisionFunc}
+// contractScenario is a scenario tested by CheckConsumeContract.
+type contractScenario struct {
+	name         string
+	decisionFunc consumeDecisionFunc
+	// concurrency is the number of goroutines calling the generator, defaultConcurrency if not set.
+	concurrency int
+	// shutdownMidTest shuts down the receiver when half of the data is generated.
+	shutdownMidTest bool
+}
+
+const defaultConcurrency = 4
+
+func checkConsumeContractScenario(params CheckConsumeContractParams, scenario contractScenario) {
+	consumer := &mockConsumer{t: params.T, consumeDecisionFunc: scenario.decisionFunc}
 	ctx := context.Background()
 
 	// Create and start the receiver.
@@ -116,8 +151,7 @@ func checkConsumeContractScenario(params CheckConsumeContractParams, decisionFun
 	case component.DataTypeTraces:
 		receiver, err = params.Factory.CreateTracesReceiver(ctx, NewNopCreateSettings(), params.Config, consumer)
 	case component.DataTypeMetrics:
-		// TODO: add metrics support to mockConsumer so that this case can be also implemented.
-		require.FailNow(params.T, "DataTypeMetrics is not implemented")
+		receiver, err = params.Factory.CreateMetricsReceiver(ctx, NewNopCreateSettings(), params.Config, consumer)
 	default:
 		require.FailNow(params.T, "must specify a valid DataType to test for")
 	}
@@ -134,19 +168,50 @@ func checkConsumeContractScenario(params CheckConsumeContractParams, decisionFun
 	var mux sync.Mutex
 	var wg sync.WaitGroup
 
-	const concurrency = 4
+	concurrency := scenario.concurrency
+	if concurrency == 0 {
+		concurrency = defaultConcurrency
+	}
+
+	// shutdownDone is closed when the receiver is shut down in the shutdown_mid_test scenario.
+	// The generator keeps being called while the receiver is shutting down, but not after.
+	shutdownDone := make(chan struct{})
+	var shutdownErr error
+	var shutdownWg sync.WaitGroup
 
 	params.Generator.Start()
 	defer params.Generator.Stop()
 
 	// Create concurrent goroutines that use the generator.
-	// The total number of generator calls will be equal to params.GenerateCount.
+	// The total number of generator calls will be equal to params.GenerateCount,
+	// unless the receiver is shut down before.
 
 	for j := 0; j < concurrency; j++ {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			for atomic.AddInt64(&generatedIndex, 1) <= int64(params.GenerateCount) {
+			for {
+				select {
+				case <-shutdownDone:
+					return
+				default:
+				}
+				index := atomic.AddInt64(&generatedIndex, 1)
+				if index > int64(params.GenerateCount) {
+					return
+				}
+				if scenario.shutdownMidTest && index == int64(params.GenerateCount/2)+1 {
+					// Shut down the receiver while the other goroutines are still generating.
+					shutdownWg.Add(1)
+					go func() {
+						defer shutdownWg.Done()
+						shutdownErr = receiver.Shutdown(ctx)
+						consumer.receiverShutdown()
+						close(shutdownDone)
+					}()
+					continue
+				}
+
 				ids := params.Generator.Generate()
 				require.Greater(params.T, len(ids), 0)
 
@@ -164,6 +229,24 @@ func checkConsumeContractScenario(params CheckConsumeContractParams, decisionFun
 	// Wait until all generator goroutines are done.
 	wg.Wait()
 
+	if scenario.shutdownMidTest {
+		// Wait until the receiver is shut down. The data refused by the receiver while shutting
+		// down is lost, so only check that the delivered data was generated and delivered once.
+		shutdownWg.Wait()
+		assert.NoError(params.T, shutdownErr)
+
+		acceptedAndDropped, duplicates := consumer.acceptedAndDropped()
+		if len(duplicates) != 0 {
+			assert.Failf(params.T, "found duplicate elements in accepted and dropped data", "keys=%v", duplicates)
+		}
+		_, onlyInOther := generatedIds.compare(acceptedAndDropped)
+		if len(onlyInOther) != 0 {
+			assert.Failf(params.T, "found elements in accepted and dropped data that was never sent", "keys=%v", onlyInOther)
+		}
+		consumer.printStats(len(generatedIds))
+		return
+	}
+
 	// Wait until all data is seen by the consumer.
 	assert.Eventually(params.T, func() bool {
 		// Calculate the union of accepted and dropped data.
@@ -194,15 +277,9 @@ func checkConsumeContractScenario(params CheckConsumeContractParams, decisionFun
 
 	err = receiver.Shutdown(ctx)
 	assert.NoError(params.T, err)
+	consumer.receiverShutdown()
 
-	// Print some stats to help debug test failures.
-	fmt.Printf(
-		"Sent %d, accepted=%d, expected dropped=%d, non-permanent errors retried=%d\n",
-		len(generatedIds),
-		len(consumer.acceptedIds),
-		len(consumer.droppedIds),
-		consumer.nonPermanentFailures,
-	)
+	consumer.printStats(len(generatedIds))
 }
 
 // idSet is a set of unique ids of data elements used in the test (logs, spans or metric data points).
@@ -313,6 +390,15 @@ func randomErrorsConsumeDecision(_ idSet) error {
 	return nil
 }
 
+// blockingConsumeDecision returns a decision function that blocks for a random duration of up
+// to 10ms, like a slow next consumer, before deciding with the given decision function.
+func blockingConsumeDecision(decisionFunc consumeDecisionFunc) consumeDecisionFunc {
+	return func(ids idSet) error {
+		time.Sleep(time.Duration(rand.Int63n(int64(10 * time.Millisecond))))
+		return decisionFunc(ids)
+	}
+}
+
 // mockConsumer accepts or drops the data from the receiver based on the decision made by
 // consumeDecisionFunc and remembers the accepted and dropped data sets for later checks.
 // mockConsumer implements all 3 consume functions: ConsumeLogs/ConsumeTraces/ConsumeMetrics
@@ -324,6 +410,9 @@ type mockConsumer struct {
 	acceptedIds          idSet
 	droppedIds           idSet
 	nonPermanentFailures int
+	// shutdown is set once the receiver is shut down. The receiver must not call
+	// its next consumer anymore after that.
+	shutdown bool
 }
 
 func (m *mockConsumer) Capabilities() consumer.Capabilities {
@@ -390,15 +479,87 @@ func idSetFromLogs(data plog.Logs) (idSet, error) {
 	return ds, nil
 }
 
-// TODO: Implement mockConsumer.ConsumeMetrics()
+func (m *mockConsumer) ConsumeMetrics(_ context.Context, data pmetric.Metrics) error {
+	ids, err := idSetFromMetrics(data)
+	require.NoError(m.t, err)
+	return m.consume(ids)
+}
+
+// idSetFromMetrics computes an idSet from given pmetric.Metrics. The idSet will contain ids of all metric data points.
+func idSetFromMetrics(data pmetric.Metrics) (idSet, error) {
+	ds := map[UniqueIDAttrVal]bool{}
+	rss := data.ResourceMetrics()
+	for i := 0; i < rss.Len(); i++ {
+		ils := rss.At(i).ScopeMetrics()
+		for j := 0; j < ils.Len(); j++ {
+			ms := ils.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				for _, attrs := range dataPointsAttributes(ms.At(k)) {
+					key, exists := attrs.Get(UniqueIDAttrName)
+					if !exists {
+						return ds, fmt.Errorf("invalid data element, attribute %q is missing", UniqueIDAttrName)
+					}
+					if key.Type() != pcommon.ValueTypeStr {
+						return ds, fmt.Errorf("invalid data element, attribute %q is wrong type %v", UniqueIDAttrName, key.Type())
+					}
+					ds[UniqueIDAttrVal(key.Str())] = true
+				}
+			}
+		}
+	}
+	return ds, nil
+}
+
+// dataPointsAttributes returns the attributes of all the data points of the metric, whatever its type.
+func dataPointsAttributes(metric pmetric.Metric) []pcommon.Map {
+	var attrs []pcommon.Map
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			attrs = append(attrs, dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeSum:
+		dps := metric.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			attrs = append(attrs, dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			attrs = append(attrs, dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			attrs = append(attrs, dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			attrs = append(attrs, dps.At(i).Attributes())
+		}
+	}
+	return attrs
+}
 
 // consume the elements with the specified ids, regardless of the element data type.
 func (m *mockConsumer) consume(ids idSet) error {
 	m.mux.Lock()
-	defer m.mux.Unlock()
+	if m.shutdown {
+		assert.Fail(m.t, "the next consumer was called after the receiver was shut down")
+	}
+	m.mux.Unlock()
 
 	// Consult with user-defined decision function to decide what to do with the data.
-	if err := m.consumeDecisionFunc(ids); err != nil {
+	// The decision function may block, so it is called without holding the lock to let
+	// the receiver call the consumer concurrently.
+	err := m.consumeDecisionFunc(ids)
+
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	if err != nil {
 		// The decision is to return an error to the receiver.
 
 		if consumererror.IsPermanent(err) {
@@ -421,6 +582,26 @@ func (m *mockConsumer) consume(ids idSet) error {
 	return nil
 }
 
+// receiverShutdown records that the receiver was shut down.
+func (m *mockConsumer) receiverShutdown() {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	m.shutdown = true
+}
+
+// printStats prints some stats to help debug test failures.
+func (m *mockConsumer) printStats(generated int) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	fmt.Printf(
+		"Sent %d, accepted=%d, expected dropped=%d, non-permanent errors retried=%d\n",
+		generated,
+		len(m.acceptedIds),
+		len(m.droppedIds),
+		m.nonPermanentFailures,
+	)
+}
+
 // Calculate union of accepted and dropped ids.
 // Returns the union and the list of duplicates between the two sets (if any)
 func (m *mockConsumer) acceptedAndDropped() (acceptedAndDropped idSet, duplicates []UniqueIDAttrVal) {
@@ -437,3 +618,19 @@ func CreateOneLogWithID(id UniqueIDAttrVal) plog.Logs {
 	)
 	return data
 }
+
+func CreateOneSpanWithID(id UniqueIDAttrVal) ptrace.Traces {
+	data := ptrace.NewTraces()
+	data.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().Attributes().PutStr(
+		UniqueIDAttrName,
+		string(id),
+	)
+	return data
+}
+
+func CreateOneMetricWithID(id UniqueIDAttrVal) pmetric.Metrics {
+	data := pmetric.NewMetrics()
+	data.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints().
+		AppendEmpty().Attributes().PutStr(UniqueIDAttrName, string(id))
+	return data
+}
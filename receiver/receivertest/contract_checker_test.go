@@ -5,14 +5,19 @@ package receivertest
 
 import (
 	"context"
+	"errors"
 	"strconv"
+	"sync"
 	"sync/atomic"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumererror"
-	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
 )
 
@@ -20,7 +25,13 @@ import (
 // We declare a trivial example receiver, a data generator and then use them in TestConsumeContract().
 
 type exampleReceiver struct {
-	nextConsumer consumer.Logs
+	// consume sends the data to the next consumer.
+	consume func(ctx context.Context, id UniqueIDAttrVal) error
+
+	// mux protects shutdown, and is held while receiving so that Shutdown waits for
+	// the data being received to be consumed.
+	mux      sync.RWMutex
+	shutdown bool
 }
 
 func (s *exampleReceiver) Start(_ context.Context, _ component.Host) error {
@@ -28,13 +39,24 @@ func (s *exampleReceiver) Start(_ context.Context, _ component.Host) error {
 }
 
 func (s *exampleReceiver) Shutdown(_ context.Context) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.shutdown = true
 	return nil
 }
 
-func (s *exampleReceiver) Receive(data plog.Logs) {
+func (s *exampleReceiver) Receive(id UniqueIDAttrVal) error {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	if s.shutdown {
+		// The next consumer must not be called anymore once the receiver is shut down,
+		// the data is refused instead.
+		return errors.New("receiver is shut down")
+	}
+
 	// This very simple implementation demonstrates how a single items receiving should happen.
 	for {
-		err := s.nextConsumer.ConsumeLogs(context.Background(), data)
+		err := s.consume(context.Background(), id)
 		if err != nil {
 			// The next consumer returned an error.
 			if !consumererror.IsPermanent(err) {
@@ -44,9 +66,9 @@ func (s *exampleReceiver) Receive(data plog.Logs) {
 				continue
 			}
 		}
-		// If we are hear either the ConsumeLogs returned success or it returned a permanent error.
+		// If we are hear either the Consume*() returned success or it returned a permanent error.
 		// In either case we don't need to retry the same data, we are done.
-		return
+		return err
 	}
 }
 
@@ -72,10 +94,9 @@ func (g *exampleGenerator) Generate() []UniqueIDAttrVal {
 	// Make sure the id is atomically incremented. Generate() may be called concurrently.
 	id := UniqueIDAttrVal(strconv.FormatInt(atomic.AddInt64(&g.sequenceNum, 1), 10))
 
-	data := CreateOneLogWithID(id)
-
-	// Send the generated data to the receiver.
-	g.receiver.Receive(data)
+	// Send the generated data to the receiver. The data may be refused if the receiver
+	// is shutting down, it is then up to the sender to send it again later.
+	_ = g.receiver.Receive(id)
 
 	// And return the ids for bookkeeping by the test.
 	return []UniqueIDAttrVal{id}
@@ -88,6 +109,8 @@ func newExampleFactory() receiver.Factory {
 			return &exampleReceiverConfig{}
 		},
 		receiver.WithLogs(createLog, component.StabilityLevelBeta),
+		receiver.WithTraces(createExampleTraces, component.StabilityLevelBeta),
+		receiver.WithMetrics(createExampleMetrics, component.StabilityLevelBeta),
 	)
 }
 
@@ -97,7 +120,35 @@ func createLog(
 	cfg component.Config,
 	consumer consumer.Logs,
 ) (receiver.Logs, error) {
-	rcv := &exampleReceiver{nextConsumer: consumer}
+	rcv := &exampleReceiver{consume: func(ctx context.Context, id UniqueIDAttrVal) error {
+		return consumer.ConsumeLogs(ctx, CreateOneLogWithID(id))
+	}}
+	cfg.(*exampleReceiverConfig).generator.receiver = rcv
+	return rcv, nil
+}
+
+func createExampleTraces(
+	_ context.Context,
+	_ receiver.CreateSettings,
+	cfg component.Config,
+	consumer consumer.Traces,
+) (receiver.Traces, error) {
+	rcv := &exampleReceiver{consume: func(ctx context.Context, id UniqueIDAttrVal) error {
+		return consumer.ConsumeTraces(ctx, CreateOneSpanWithID(id))
+	}}
+	cfg.(*exampleReceiverConfig).generator.receiver = rcv
+	return rcv, nil
+}
+
+func createExampleMetrics(
+	_ context.Context,
+	_ receiver.CreateSettings,
+	cfg component.Config,
+	consumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	rcv := &exampleReceiver{consume: func(ctx context.Context, id UniqueIDAttrVal) error {
+		return consumer.ConsumeMetrics(ctx, CreateOneMetricWithID(id))
+	}}
 	cfg.(*exampleReceiverConfig).generator.receiver = rcv
 	return rcv, nil
 }
@@ -124,3 +175,46 @@ func TestConsumeContract(t *testing.T) {
 	// Run the contract checker. This will trigger test failures if any problems are found.
 	CheckConsumeContract(params)
 }
+
+func TestConsumeContractTracesAndMetrics(t *testing.T) {
+	for _, dataType := range []component.DataType{component.DataTypeTraces, component.DataTypeMetrics} {
+		t.Run(string(dataType), func(t *testing.T) {
+			generator := &exampleGenerator{t: t}
+			CheckConsumeContract(CheckConsumeContractParams{
+				T:             t,
+				Factory:       newExampleFactory(),
+				DataType:      dataType,
+				Config:        &exampleReceiverConfig{generator: generator},
+				Generator:     generator,
+				GenerateCount: 100,
+			})
+		})
+	}
+}
+
+func TestIDSetFromMetrics(t *testing.T) {
+	md := pmetric.NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	ms.AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty().Attributes().PutStr(UniqueIDAttrName, "gauge")
+	ms.AppendEmpty().SetEmptySum().DataPoints().AppendEmpty().Attributes().PutStr(UniqueIDAttrName, "sum")
+	ms.AppendEmpty().SetEmptyHistogram().DataPoints().AppendEmpty().Attributes().PutStr(UniqueIDAttrName, "histogram")
+	ms.AppendEmpty().SetEmptyExponentialHistogram().DataPoints().AppendEmpty().Attributes().PutStr(UniqueIDAttrName, "exponential_histogram")
+	summary := ms.AppendEmpty().SetEmptySummary().DataPoints()
+	summary.AppendEmpty().Attributes().PutStr(UniqueIDAttrName, "summary_1")
+	summary.AppendEmpty().Attributes().PutStr(UniqueIDAttrName, "summary_2")
+
+	ids, err := idSetFromMetrics(md)
+	require.NoError(t, err)
+	assert.Equal(t, idSet{
+		"gauge":                 true,
+		"sum":                   true,
+		"histogram":             true,
+		"exponential_histogram": true,
+		"summary_1":             true,
+		"summary_2":             true,
+	}, ids)
+
+	ms.AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+	_, err = idSetFromMetrics(md)
+	assert.EqualError(t, err, `invalid data element, attribute "test_id" is missing`)
+}
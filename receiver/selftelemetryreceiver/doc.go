@@ -0,0 +1,6 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package selftelemetryreceiver reads the Collector's own metrics in-process, and
+// emits them into a metrics pipeline.
+package selftelemetryreceiver // import "go.opentelemetry.io/collector/receiver/selftelemetryreceiver"
@@ -0,0 +1,186 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package selftelemetryreceiver // import "go.opentelemetry.io/collector/receiver/selftelemetryreceiver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"go.opentelemetry.io/collector/internal/selftelemetry"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+)
+
+// scraper reads the metrics of the Collector's own telemetry, and converts them to pdata.
+type scraper struct {
+	// resource is the resource of the Collector's own telemetry.
+	resource pcommon.Resource
+}
+
+func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	scopeMetrics, err := selftelemetry.Collect(ctx)
+	md := pmetric.NewMetrics()
+	if err != nil && len(scopeMetrics) == 0 {
+		return md, err
+	}
+
+	rm := md.ResourceMetrics().AppendEmpty()
+	s.resource.CopyTo(rm.Resource())
+	for _, sm := range scopeMetrics {
+		appendScopeMetrics(rm.ScopeMetrics(), sm)
+	}
+	if err != nil {
+		// Some of the metrics could be read.
+		return md, scrapererror.NewPartialScrapeError(err, 0)
+	}
+	return md, nil
+}
+
+func appendScopeMetrics(dest pmetric.ScopeMetricsSlice, sm metricdata.ScopeMetrics) {
+	ilm := dest.AppendEmpty()
+	ilm.Scope().SetName(sm.Scope.Name)
+	ilm.Scope().SetVersion(sm.Scope.Version)
+	ilm.SetSchemaUrl(sm.Scope.SchemaURL)
+	ilm.Metrics().EnsureCapacity(len(sm.Metrics))
+	for _, m := range sm.Metrics {
+		metric := pmetric.NewMetric()
+		if !setData(metric, m.Data) {
+			// A metric without data is invalid, skip the aggregations which can not be converted.
+			continue
+		}
+		metric.SetName(m.Name)
+		metric.SetDescription(m.Description)
+		metric.SetUnit(m.Unit)
+		metric.MoveTo(ilm.Metrics().AppendEmpty())
+	}
+}
+
+// setData sets the data of the metric from the aggregation. It returns false if the aggregation is not supported.
+func setData(metric pmetric.Metric, data metricdata.Aggregation) bool {
+	switch data := data.(type) {
+	case metricdata.Gauge[int64]:
+		appendNumberDataPoints(metric.SetEmptyGauge().DataPoints(), data.DataPoints)
+	case metricdata.Gauge[float64]:
+		appendNumberDataPoints(metric.SetEmptyGauge().DataPoints(), data.DataPoints)
+	case metricdata.Sum[int64]:
+		sum := metric.SetEmptySum()
+		sum.SetIsMonotonic(data.IsMonotonic)
+		sum.SetAggregationTemporality(temporality(data.Temporality))
+		appendNumberDataPoints(sum.DataPoints(), data.DataPoints)
+	case metricdata.Sum[float64]:
+		sum := metric.SetEmptySum()
+		sum.SetIsMonotonic(data.IsMonotonic)
+		sum.SetAggregationTemporality(temporality(data.Temporality))
+		appendNumberDataPoints(sum.DataPoints(), data.DataPoints)
+	case metricdata.Histogram[int64]:
+		histogram := metric.SetEmptyHistogram()
+		histogram.SetAggregationTemporality(temporality(data.Temporality))
+		appendHistogramDataPoints(histogram.DataPoints(), data.DataPoints)
+	case metricdata.Histogram[float64]:
+		histogram := metric.SetEmptyHistogram()
+		histogram.SetAggregationTemporality(temporality(data.Temporality))
+		appendHistogramDataPoints(histogram.DataPoints(), data.DataPoints)
+	default:
+		return false
+	}
+	return true
+}
+
+func appendNumberDataPoints[N int64 | float64](dest pmetric.NumberDataPointSlice, dps []metricdata.DataPoint[N]) {
+	dest.EnsureCapacity(len(dps))
+	for _, dp := range dps {
+		ndp := dest.AppendEmpty()
+		putAttributes(ndp.Attributes(), dp.Attributes)
+		ndp.SetStartTimestamp(timestamp(dp.StartTime))
+		ndp.SetTimestamp(timestamp(dp.Time))
+		switch v := any(dp.Value).(type) {
+		case int64:
+			ndp.SetIntValue(v)
+		case float64:
+			ndp.SetDoubleValue(v)
+		}
+	}
+}
+
+func appendHistogramDataPoints[N int64 | float64](dest pmetric.HistogramDataPointSlice, dps []metricdata.HistogramDataPoint[N]) {
+	dest.EnsureCapacity(len(dps))
+	for _, dp := range dps {
+		hdp := dest.AppendEmpty()
+		putAttributes(hdp.Attributes(), dp.Attributes)
+		hdp.SetStartTimestamp(timestamp(dp.StartTime))
+		hdp.SetTimestamp(timestamp(dp.Time))
+		hdp.SetCount(dp.Count)
+		hdp.SetSum(float64(dp.Sum))
+		if v, ok := dp.Min.Value(); ok {
+			hdp.SetMin(float64(v))
+		}
+		if v, ok := dp.Max.Value(); ok {
+			hdp.SetMax(float64(v))
+		}
+		hdp.ExplicitBounds().FromRaw(dp.Bounds)
+		hdp.BucketCounts().FromRaw(dp.BucketCounts)
+	}
+}
+
+func putAttributes(dest pcommon.Map, attrs attribute.Set) {
+	dest.EnsureCapacity(attrs.Len())
+	for iter := attrs.Iter(); iter.Next(); {
+		kv := iter.Attribute()
+		key := string(kv.Key)
+		switch kv.Value.Type() {
+		case attribute.BOOL:
+			dest.PutBool(key, kv.Value.AsBool())
+		case attribute.INT64:
+			dest.PutInt(key, kv.Value.AsInt64())
+		case attribute.FLOAT64:
+			dest.PutDouble(key, kv.Value.AsFloat64())
+		case attribute.STRING:
+			dest.PutStr(key, kv.Value.AsString())
+		case attribute.BOOLSLICE:
+			s := dest.PutEmptySlice(key)
+			for _, v := range kv.Value.AsBoolSlice() {
+				s.AppendEmpty().SetBool(v)
+			}
+		case attribute.INT64SLICE:
+			s := dest.PutEmptySlice(key)
+			for _, v := range kv.Value.AsInt64Slice() {
+				s.AppendEmpty().SetInt(v)
+			}
+		case attribute.FLOAT64SLICE:
+			s := dest.PutEmptySlice(key)
+			for _, v := range kv.Value.AsFloat64Slice() {
+				s.AppendEmpty().SetDouble(v)
+			}
+		case attribute.STRINGSLICE:
+			s := dest.PutEmptySlice(key)
+			for _, v := range kv.Value.AsStringSlice() {
+				s.AppendEmpty().SetStr(v)
+			}
+		default:
+			dest.PutStr(key, kv.Value.Emit())
+		}
+	}
+}
+
+func temporality(t metricdata.Temporality) pmetric.AggregationTemporality {
+	switch t {
+	case metricdata.CumulativeTemporality:
+		return pmetric.AggregationTemporalityCumulative
+	case metricdata.DeltaTemporality:
+		return pmetric.AggregationTemporalityDelta
+	}
+	return pmetric.AggregationTemporalityUnspecified
+}
+
+// timestamp returns the timestamp of the time, 0 if the time is not set.
+func timestamp(t time.Time) pcommon.Timestamp {
+	if t.IsZero() {
+		return 0
+	}
+	return pcommon.NewTimestampFromTime(t)
+}
@@ -0,0 +1,184 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package selftelemetryreceiver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/internal/selftelemetry"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+// newTestMeterProvider returns a meter provider read by the receiver, as the one of the Collector's
+// own telemetry is when it uses OpenTelemetry.
+func newTestMeterProvider(t *testing.T) metric.MeterProvider {
+	reader := selftelemetry.NewReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	selftelemetry.SetReader(reader)
+	t.Cleanup(func() {
+		selftelemetry.SetReader(nil)
+		assert.NoError(t, mp.Shutdown(context.Background()))
+	})
+	return mp
+}
+
+func findMetric(t *testing.T, md pmetric.Metrics, name string) pmetric.Metric {
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				if ms.At(k).Name() == name {
+					return ms.At(k)
+				}
+			}
+		}
+	}
+	require.Failf(t, "metric not found", "metric %q", name)
+	return pmetric.Metric{}
+}
+
+func TestScrape(t *testing.T) {
+	meter := newTestMeterProvider(t).Meter("go.opentelemetry.io/collector/test", metric.WithInstrumentationVersion("1.2.3"))
+	ctx := context.Background()
+
+	counter, err := meter.Int64Counter("requests", metric.WithDescription("Number of requests"), metric.WithUnit("1"))
+	require.NoError(t, err)
+	counter.Add(ctx, 3, metric.WithAttributes(attribute.String("exporter", "otlp"), attribute.Bool("success", true)))
+
+	upDownCounter, err := meter.Float64UpDownCounter("queue_size")
+	require.NoError(t, err)
+	upDownCounter.Add(ctx, 2.5, metric.WithAttributes(attribute.Int("shard", 1), attribute.Float64("ratio", 0.5)))
+
+	histogram, err := meter.Int64Histogram("latency", metric.WithUnit("ms"))
+	require.NoError(t, err)
+	histogram.Record(ctx, 3)
+	histogram.Record(ctx, 30)
+
+	_, err = meter.Int64ObservableGauge("memory", metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+		o.Observe(1024, metric.WithAttributes(attribute.StringSlice("tags", []string{"a", "b"}), attribute.Int64Slice("ids", []int64{1, 2})))
+		return nil
+	}))
+	require.NoError(t, err)
+
+	res := pcommon.NewResource()
+	res.Attributes().PutStr("service.name", "otelcol")
+	md, err := (&scraper{resource: res}).scrape(ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+	rm := md.ResourceMetrics().At(0)
+	assert.Equal(t, map[string]any{"service.name": "otelcol"}, rm.Resource().Attributes().AsRaw())
+	require.Equal(t, 1, rm.ScopeMetrics().Len())
+	assert.Equal(t, "go.opentelemetry.io/collector/test", rm.ScopeMetrics().At(0).Scope().Name())
+	assert.Equal(t, "1.2.3", rm.ScopeMetrics().At(0).Scope().Version())
+
+	requests := findMetric(t, md, "requests")
+	assert.Equal(t, "Number of requests", requests.Description())
+	assert.Equal(t, "1", requests.Unit())
+	require.Equal(t, pmetric.MetricTypeSum, requests.Type())
+	assert.True(t, requests.Sum().IsMonotonic())
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, requests.Sum().AggregationTemporality())
+	require.Equal(t, 1, requests.Sum().DataPoints().Len())
+	dp := requests.Sum().DataPoints().At(0)
+	assert.Equal(t, int64(3), dp.IntValue())
+	assert.Equal(t, map[string]any{"exporter": "otlp", "success": true}, dp.Attributes().AsRaw())
+	assert.NotZero(t, dp.StartTimestamp())
+	assert.GreaterOrEqual(t, dp.Timestamp(), dp.StartTimestamp())
+
+	queueSize := findMetric(t, md, "queue_size")
+	require.Equal(t, pmetric.MetricTypeSum, queueSize.Type())
+	assert.False(t, queueSize.Sum().IsMonotonic())
+	dp = queueSize.Sum().DataPoints().At(0)
+	assert.Equal(t, 2.5, dp.DoubleValue())
+	assert.Equal(t, map[string]any{"shard": int64(1), "ratio": 0.5}, dp.Attributes().AsRaw())
+
+	latency := findMetric(t, md, "latency")
+	require.Equal(t, pmetric.MetricTypeHistogram, latency.Type())
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, latency.Histogram().AggregationTemporality())
+	hdp := latency.Histogram().DataPoints().At(0)
+	assert.Equal(t, uint64(2), hdp.Count())
+	assert.Equal(t, 33.0, hdp.Sum())
+	assert.Equal(t, 3.0, hdp.Min())
+	assert.Equal(t, 30.0, hdp.Max())
+	assert.Equal(t, hdp.ExplicitBounds().Len()+1, hdp.BucketCounts().Len())
+
+	memory := findMetric(t, md, "memory")
+	require.Equal(t, pmetric.MetricTypeGauge, memory.Type())
+	dp = memory.Gauge().DataPoints().At(0)
+	assert.Equal(t, int64(1024), dp.IntValue())
+	assert.Equal(t, map[string]any{"tags": []any{"a", "b"}, "ids": []any{int64(1), int64(2)}}, dp.Attributes().AsRaw())
+}
+
+// unknownAggregation is an aggregation the receiver does not support.
+type unknownAggregation struct {
+	metricdata.Gauge[int64]
+}
+
+func TestAppendScopeMetricsUnknownAggregation(t *testing.T) {
+	sms := pmetric.NewScopeMetricsSlice()
+	appendScopeMetrics(sms, metricdata.ScopeMetrics{
+		Metrics: []metricdata.Metrics{
+			{Name: "unknown", Data: unknownAggregation{}},
+			{Name: "memory", Data: metricdata.Gauge[int64]{DataPoints: []metricdata.DataPoint[int64]{{Value: 1024}}}},
+			{Name: "nil"},
+		},
+	})
+	require.Equal(t, 1, sms.Len())
+	// The metrics whose aggregation is not supported are skipped.
+	require.Equal(t, 1, sms.At(0).Metrics().Len())
+	memory := sms.At(0).Metrics().At(0)
+	assert.Equal(t, "memory", memory.Name())
+	require.Equal(t, pmetric.MetricTypeGauge, memory.Type())
+	assert.Equal(t, int64(1024), memory.Gauge().DataPoints().At(0).IntValue())
+}
+
+func TestTemporality(t *testing.T) {
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, temporality(metricdata.CumulativeTemporality))
+	assert.Equal(t, pmetric.AggregationTemporalityDelta, temporality(metricdata.DeltaTemporality))
+	assert.Equal(t, pmetric.AggregationTemporalityUnspecified, temporality(metricdata.Temporality(0)))
+	assert.Equal(t, pcommon.Timestamp(0), timestamp(time.Time{}))
+}
+
+func TestReceiver(t *testing.T) {
+	meter := newTestMeterProvider(t).Meter("go.opentelemetry.io/collector/test")
+	counter, err := meter.Int64Counter("requests")
+	require.NoError(t, err)
+	counter.Add(context.Background(), 1)
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.InitialDelay = 0
+	sink := new(consumertest.MetricsSink)
+	rcvr, err := factory.CreateMetricsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+
+	require.NoError(t, rcvr.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, rcvr.Shutdown(context.Background())) }()
+	assert.Eventually(t, func() bool {
+		return len(sink.AllMetrics()) > 0
+	}, 10*time.Second, 10*time.Millisecond)
+	findMetric(t, sink.AllMetrics()[0], "requests")
+}
+
+func TestCreateMetricsReceiverNilConsumer(t *testing.T) {
+	factory := NewFactory()
+	_, err := factory.CreateMetricsReceiver(context.Background(), receivertest.NewNopCreateSettings(), factory.CreateDefaultConfig(), nil)
+	assert.ErrorIs(t, err, component.ErrNilNextConsumer)
+}
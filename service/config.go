@@ -6,7 +6,10 @@ package service // import "go.opentelemetry.io/collector/service"
 import (
 	"fmt"
 
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/featuregate"
 	"go.opentelemetry.io/collector/service/extensions"
+	"go.opentelemetry.io/collector/service/internal/graph"
 	"go.opentelemetry.io/collector/service/pipelines"
 	"go.opentelemetry.io/collector/service/telemetry"
 )
@@ -24,6 +27,10 @@ type Config struct {
 
 	// Pipelines are the set of data pipelines configured for the service.
 	Pipelines pipelines.Config `mapstructure:"pipelines"`
+
+	// FeatureGates enables or disables the feature gates of the global registry, by id. They are set
+	// before building the components, after the gates set with the --feature-gates flag.
+	FeatureGates map[string]bool `mapstructure:"feature_gates"`
 }
 
 func (cfg *Config) Validate() error {
@@ -31,9 +38,22 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("service::pipelines config validation failed: %w", err)
 	}
 
+	if err := validateFeatureGates(featuregate.GlobalRegistry(), cfg.FeatureGates); err != nil {
+		return fmt.Errorf("service::feature_gates config validation failed: %w", err)
+	}
+
 	if err := cfg.Telemetry.Validate(); err != nil {
 		fmt.Printf("service::telemetry config validation failed: %v\n", err)
 	}
 
 	return nil
 }
+
+// DeadPipelines returns the pipelines whose data never comes from a receiver, and the pipelines whose
+// data never reaches an exporter, the given connectors passing the data between the pipelines.
+func (cfg *Config) DeadPipelines(connectors map[component.ID]component.Config) (noReceiver, noExporter []component.ID) {
+	return graph.DeadPipelines(cfg.Pipelines, func(id component.ID) bool {
+		_, ok := connectors[id]
+		return ok
+	})
+}
@@ -61,6 +61,15 @@ func TestConfigValidate(t *testing.T) {
 			},
 			expected: fmt.Errorf(`service::pipelines config validation failed: %w`, errors.New(`pipeline "wrongtype": unknown datatype "wrongtype"`)),
 		},
+		{
+			name: "unknown-feature-gate",
+			cfgFn: func() *Config {
+				cfg := generateConfig()
+				cfg.FeatureGates = map[string]bool{"unknown": true}
+				return cfg
+			},
+			expected: fmt.Errorf(`service::feature_gates config validation failed: %w`, errors.New(`no such feature gate "unknown"`)),
+		},
 		{
 			name: "invalid-telemetry-metric-config",
 			cfgFn: func() *Config {
@@ -81,6 +90,21 @@ func TestConfigValidate(t *testing.T) {
 	}
 }
 
+func TestConfigDeadPipelines(t *testing.T) {
+	cfg := generateConfig()
+	noReceiver, noExporter := cfg.DeadPipelines(nil)
+	assert.Empty(t, noReceiver)
+	assert.Empty(t, noExporter)
+
+	cfg.Pipelines[component.NewIDWithName("traces", "dead")] = &pipelines.PipelineConfig{
+		Receivers: []component.ID{component.NewID("nop")},
+		Exporters: []component.ID{component.NewID("forward")},
+	}
+	noReceiver, noExporter = cfg.DeadPipelines(map[component.ID]component.Config{component.NewID("forward"): nil})
+	assert.Empty(t, noReceiver)
+	assert.Equal(t, []component.ID{component.NewIDWithName("traces", "dead")}, noExporter)
+}
+
 func generateConfig() *Config {
 	return &Config{
 		Telemetry: telemetry.Config{
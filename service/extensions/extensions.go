@@ -131,6 +131,10 @@ type Settings struct {
 
 	// Extensions builder for extensions.
 	Extensions *extension.Builder
+
+	// FeatureGates resolve the feature gates of the extensions which override them, the other
+	// extensions use the FeatureGates of Telemetry.
+	FeatureGates map[component.ID]component.FeatureGates
 }
 
 // New creates a new Extensions from Config.
@@ -149,6 +153,9 @@ func New(ctx context.Context, set Settings, cfg Config) (*Extensions, error) {
 			BuildInfo:         set.BuildInfo,
 		}
 		extSet.TelemetrySettings.Logger = components.ExtensionLogger(set.Telemetry.Logger, extID)
+		if gates, ok := set.FeatureGates[extID]; ok {
+			extSet.TelemetrySettings.FeatureGates = gates
+		}
 
 		ext, err := set.Extensions.Create(ctx, extSet)
 		if err != nil {
@@ -0,0 +1,181 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service // import "go.opentelemetry.io/collector/service"
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.uber.org/multierr"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/featuregate"
+)
+
+// ComponentFeatureGates holds the feature gates overridden for individual components, with the
+// feature_gates key of their configuration, by component kind and id.
+type ComponentFeatureGates map[component.Kind]map[component.ID]map[string]bool
+
+// componentFeatureGateViews are the views of the registry resolving the feature gates of the components
+// which override them, by component kind and id.
+type componentFeatureGateViews map[component.Kind]map[component.ID]*featuregate.View
+
+// newComponentFeatureGateViews returns the views of the registry resolving the feature gates of each component.
+func newComponentFeatureGateViews(reg *featuregate.Registry, cfg ComponentFeatureGates) (componentFeatureGateViews, error) {
+	views := make(componentFeatureGateViews, len(cfg))
+	for kind, gates := range cfg {
+		views[kind] = make(map[component.ID]*featuregate.View, len(gates))
+		for id, overrides := range gates {
+			view, err := reg.NewView(overrides)
+			if err != nil {
+				return nil, fmt.Errorf("component %q: %w", id, err)
+			}
+			views[kind][id] = view
+		}
+	}
+	return views, nil
+}
+
+// ofKind returns the views resolving the feature gates of the components of the given kind.
+func (views componentFeatureGateViews) ofKind(kind component.Kind) map[component.ID]component.FeatureGates {
+	gates := make(map[component.ID]component.FeatureGates, len(views[kind]))
+	for id, view := range views[kind] {
+		gates[id] = view
+	}
+	return gates
+}
+
+// validateResolved checks that the components resolved all the feature gates they override, once
+// they are created. Overriding a gate which a component does not use would silently have no effect.
+func (views componentFeatureGateViews) validateResolved() error {
+	var errs error
+	kinds := []struct {
+		kind component.Kind
+		name string
+	}{
+		{component.KindReceiver, "receiver"},
+		{component.KindProcessor, "processor"},
+		{component.KindExporter, "exporter"},
+		{component.KindConnector, "connector"},
+		{component.KindExtension, "extension"},
+	}
+	for _, k := range kinds {
+		ids := make([]component.ID, 0, len(views[k.kind]))
+		for id := range views[k.kind] {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+		for _, id := range ids {
+			for _, gate := range views[k.kind][id].UnresolvedOverrides() {
+				errs = multierr.Append(errs, fmt.Errorf("%s %q does not use the feature gate %q overridden in its configuration", k.name, id, gate))
+			}
+		}
+	}
+	return errs
+}
+
+// enablesFeatureGate returns true if any component enables the feature gate with the given id.
+func (cfg ComponentFeatureGates) enablesFeatureGate(id string) bool {
+	for _, gates := range cfg {
+		for _, overrides := range gates {
+			if overrides[id] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// featureGatesResourceAttribute is the resource attribute of the Collector's own telemetry listing the
+// feature gates which are not in their default state, in the format of the --feature-gates flag.
+const featureGatesResourceAttribute = "otelcol.feature_gates"
+
+// validateFeatureGates checks that all the configured feature gates are registered.
+func validateFeatureGates(reg *featuregate.Registry, gates map[string]bool) error {
+	registered := registeredFeatureGates(reg)
+	var errs error
+	for _, id := range sortedFeatureGateIDs(gates) {
+		if _, ok := registered[id]; !ok {
+			errs = multierr.Append(errs, fmt.Errorf("no such feature gate %q", id))
+		}
+	}
+	return errs
+}
+
+// applyFeatureGates sets the configured feature gates in the registry. It returns the previous state
+// of the gates it changed, to restore them with restoreFeatureGates, and warnings for the gates which
+// should no longer be configured, because they are stable, deprecated or expired in the given
+// Collector version.
+func applyFeatureGates(reg *featuregate.Registry, gates map[string]bool, version string) (map[string]bool, []error, error) {
+	registered := registeredFeatureGates(reg)
+	previous := make(map[string]bool)
+	var warnings []error
+	var errs error
+	for _, id := range sortedFeatureGateIDs(gates) {
+		enabled := gates[id]
+		g, ok := registered[id]
+		if !ok {
+			errs = multierr.Append(errs, fmt.Errorf("no such feature gate %q", id))
+			continue
+		}
+		if g.IsExpired(version) {
+			warnings = append(warnings, fmt.Errorf("feature gate %q was removed in version %v, remove it from the configuration", id, g.ToVersion()))
+		}
+		if (g.Stage() == featuregate.StageStable || g.Stage() == featuregate.StageDeprecated) && g.IsEnabled() == enabled {
+			// The registry would only print this warning, log it instead.
+			warnings = append(warnings, fmt.Errorf("feature gate %q is %v and can no longer be changed, it will be removed in version %v", id, g.Stage(), g.ToVersion()))
+			continue
+		}
+		wasEnabled := g.IsEnabled()
+		if err := reg.Set(id, enabled); err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		previous[id] = wasEnabled
+	}
+	return previous, warnings, errs
+}
+
+// restoreFeatureGates sets back the feature gates changed by applyFeatureGates to their previous state.
+func restoreFeatureGates(reg *featuregate.Registry, previous map[string]bool) error {
+	var errs error
+	for _, id := range sortedFeatureGateIDs(previous) {
+		errs = multierr.Append(errs, reg.Set(id, previous[id]))
+	}
+	return errs
+}
+
+// nonDefaultFeatureGates returns the feature gates which are not in the default state of their stage,
+// in the format of the --feature-gates flag.
+func nonDefaultFeatureGates(reg *featuregate.Registry) string {
+	var ids []string
+	reg.VisitAll(func(g *featuregate.Gate) {
+		enabledByDefault := g.Stage() == featuregate.StageBeta || g.Stage() == featuregate.StageStable
+		switch {
+		case g.IsEnabled() && !enabledByDefault:
+			ids = append(ids, g.ID())
+		case !g.IsEnabled() && enabledByDefault:
+			ids = append(ids, "-"+g.ID())
+		}
+	})
+	return strings.Join(ids, ",")
+}
+
+func registeredFeatureGates(reg *featuregate.Registry) map[string]*featuregate.Gate {
+	registered := map[string]*featuregate.Gate{}
+	reg.VisitAll(func(g *featuregate.Gate) {
+		registered[g.ID()] = g
+	})
+	return registered
+}
+
+func sortedFeatureGateIDs(gates map[string]bool) []string {
+	ids := make([]string, 0, len(gates))
+	for id := range gates {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/featuregate"
+)
+
+func newTestFeatureGateRegistry() *featuregate.Registry {
+	reg := featuregate.NewRegistry()
+	reg.MustRegister("alpha", featuregate.StageAlpha)
+	reg.MustRegister("beta", featuregate.StageBeta)
+	reg.MustRegister("expired", featuregate.StageAlpha, featuregate.WithRegisterToVersion("v0.70.0"))
+	reg.MustRegister("stable", featuregate.StageStable, featuregate.WithRegisterToVersion("v0.90.0"))
+	reg.MustRegister("deprecated", featuregate.StageDeprecated, featuregate.WithRegisterToVersion("v0.90.0"))
+	return reg
+}
+
+func TestValidateFeatureGates(t *testing.T) {
+	reg := newTestFeatureGateRegistry()
+	assert.NoError(t, validateFeatureGates(reg, nil))
+	assert.NoError(t, validateFeatureGates(reg, map[string]bool{"alpha": true, "stable": true}))
+	assert.EqualError(t, validateFeatureGates(reg, map[string]bool{"alpha": true, "unknown": false, "other": true}),
+		`no such feature gate "other"; no such feature gate "unknown"`)
+}
+
+func TestApplyFeatureGates(t *testing.T) {
+	reg := newTestFeatureGateRegistry()
+	assert.Equal(t, "", nonDefaultFeatureGates(reg))
+
+	previous, warnings, err := applyFeatureGates(reg, map[string]bool{
+		"alpha":      true,
+		"beta":       false,
+		"expired":    true,
+		"stable":     true,
+		"deprecated": false,
+	}, "v0.80.0")
+	require.NoError(t, err)
+	require.Len(t, warnings, 3)
+	assert.EqualError(t, warnings[0], `feature gate "deprecated" is Deprecated and can no longer be changed, it will be removed in version v0.90.0`)
+	assert.EqualError(t, warnings[1], `feature gate "expired" was removed in version v0.70.0, remove it from the configuration`)
+	assert.EqualError(t, warnings[2], `feature gate "stable" is Stable and can no longer be changed, it will be removed in version v0.90.0`)
+	assert.Equal(t, "alpha,-beta,expired", nonDefaultFeatureGates(reg))
+
+	assert.Equal(t, map[string]bool{"alpha": false, "beta": true, "expired": false}, previous)
+	require.NoError(t, restoreFeatureGates(reg, previous))
+	assert.Equal(t, "", nonDefaultFeatureGates(reg))
+}
+
+func TestApplyFeatureGatesErrors(t *testing.T) {
+	reg := newTestFeatureGateRegistry()
+	previous, _, err := applyFeatureGates(reg, map[string]bool{
+		"alpha":      true,
+		"unknown":    true,
+		"stable":     false,
+		"deprecated": true,
+	}, "v0.80.0")
+	assert.EqualError(t, err, `feature gate "deprecated" is deprecated, can not be enabled; `+
+		`feature gate "stable" is stable, can not be disabled; no such feature gate "unknown"`)
+	assert.Equal(t, "alpha", nonDefaultFeatureGates(reg))
+	// Only the gates which were changed are restored.
+	assert.Equal(t, map[string]bool{"alpha": false}, previous)
+}
+
+func TestComponentFeatureGateViews(t *testing.T) {
+	reg := newTestFeatureGateRegistry()
+	rcvrID := component.NewID("otlp")
+	views, err := newComponentFeatureGateViews(reg, ComponentFeatureGates{
+		component.KindReceiver: {rcvrID: {"alpha": true, "beta": false}},
+	})
+	require.NoError(t, err)
+
+	receivers := views.ofKind(component.KindReceiver)
+	assert.True(t, receivers[rcvrID].IsEnabled("alpha"))
+	assert.False(t, receivers[rcvrID].IsEnabled("beta"))
+	assert.Empty(t, views.ofKind(component.KindExporter))
+	assert.Equal(t, "", nonDefaultFeatureGates(reg))
+
+	_, err = newComponentFeatureGateViews(reg, ComponentFeatureGates{
+		component.KindExporter: {component.NewID("otlp"): {"stable": false}},
+	})
+	assert.EqualError(t, err, `component "otlp": feature gate "stable" is stable, can not be disabled`)
+}
+
+func TestComponentFeatureGateViewsValidateResolved(t *testing.T) {
+	reg := newTestFeatureGateRegistry()
+	views, err := newComponentFeatureGateViews(reg, ComponentFeatureGates{
+		component.KindReceiver: {component.NewID("otlp"): {"alpha": true}},
+		component.KindExporter: {
+			component.NewID("otlp"):                   {"alpha": true, "beta": false},
+			component.NewIDWithName("otlp", "canary"): {"alpha": true},
+		},
+	})
+	require.NoError(t, err)
+
+	views.ofKind(component.KindReceiver)[component.NewID("otlp")].IsEnabled("alpha")
+	views.ofKind(component.KindExporter)[component.NewID("otlp")].IsEnabled("beta")
+	assert.EqualError(t, views.validateResolved(), `exporter "otlp" does not use the feature gate "alpha" overridden in its configuration; `+
+		`exporter "otlp/canary" does not use the feature gate "alpha" overridden in its configuration`)
+
+	views.ofKind(component.KindExporter)[component.NewID("otlp")].IsEnabled("alpha")
+	views.ofKind(component.KindExporter)[component.NewIDWithName("otlp", "canary")].IsEnabled("alpha")
+	assert.NoError(t, views.validateResolved())
+}
+
+func TestComponentFeatureGatesEnablesFeatureGate(t *testing.T) {
+	cfg := ComponentFeatureGates{
+		component.KindReceiver:  {component.NewID("otlp"): {"alpha": false}},
+		component.KindProcessor: {component.NewID("batch"): {"beta": true}},
+	}
+	assert.False(t, cfg.enablesFeatureGate("alpha"))
+	assert.True(t, cfg.enablesFeatureGate("beta"))
+	assert.False(t, ComponentFeatureGates(nil).enablesFeatureGate("beta"))
+}
@@ -0,0 +1,34 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service // import "go.opentelemetry.io/collector/service"
+
+import (
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/service/internal/graph"
+)
+
+// GraphFormat is a text format of the graph of the pipelines, see PipelinesGraph.
+type GraphFormat string
+
+const (
+	// GraphFormatDOT is the Graphviz DOT format.
+	GraphFormatDOT = GraphFormat(graph.FormatDOT)
+	// GraphFormatMermaid is the Mermaid flowchart format.
+	GraphFormatMermaid = GraphFormat(graph.FormatMermaid)
+)
+
+// PipelinesGraph returns the graph of the pipelines of the configuration in the given format, with
+// a node for each instance of the receivers, processors, connectors and exporters, labeled by the
+// component ID and its signal. The components are not created, the connectors builder is used to
+// know which components are connectors.
+func PipelinesGraph(cfg Config, connectors *connector.Builder, format GraphFormat) (string, error) {
+	g, err := graph.New(graph.Settings{
+		ConnectorBuilder: connectors,
+		PipelineConfigs:  cfg.Pipelines,
+	})
+	if err != nil {
+		return "", err
+	}
+	return g.Render(graph.Format(format))
+}
@@ -0,0 +1,24 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/connector/connectortest"
+)
+
+func TestPipelinesGraph(t *testing.T) {
+	out, err := PipelinesGraph(newNopConfig(), connectortest.NewNopBuilder(), GraphFormatMermaid)
+	require.NoError(t, err)
+	assert.Contains(t, out, `receiver__nop__traces["receiver<br>nop<br>traces"]`)
+	assert.Contains(t, out, "receiver__nop__traces --> processor__nop__traces")
+	assert.Contains(t, out, "processor__nop__traces --> exporter__nop__traces")
+
+	_, err = PipelinesGraph(newNopConfig(), connectortest.NewNopBuilder(), "svg")
+	assert.Error(t, err)
+}
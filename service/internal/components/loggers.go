@@ -57,3 +57,8 @@ func ConnectorLogger(logger *zap.Logger, id component.ID, expDT, rcvDT component
 		zap.String(zapExporterInPipeline, string(expDT)),
 		zap.String(zapReceiverInPipeline, string(rcvDT)))
 }
+
+func FanoutLogger(logger *zap.Logger, pipelineID component.ID) *zap.Logger {
+	return logger.With(
+		zap.String(zapKindPipeline, pipelineID.String()))
+}
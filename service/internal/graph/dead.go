@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package graph // import "go.opentelemetry.io/collector/service/internal/graph"
+
+import (
+	"sort"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/service/pipelines"
+)
+
+// DeadPipelines returns the pipelines whose data never comes from a receiver, and the pipelines
+// whose data never reaches an exporter, the connectors passing the data between the pipelines.
+// Unlike Build, it only uses the configuration of the pipelines, the components are not created.
+func DeadPipelines(pipelineCfgs pipelines.Config, isConnector func(component.ID) bool) (noReceiver, noExporter []component.ID) {
+	// The data flows from the receivers to a pipeline, from a pipeline to the exporters,
+	// and from a pipeline to another through a connector.
+	flow := simple.NewDirectedGraph()
+	receivers := flow.NewNode()
+	flow.AddNode(receivers)
+	exporters := flow.NewNode()
+	flow.AddNode(exporters)
+
+	nodes := make(map[component.ID]graph.Node, len(pipelineCfgs))
+	asReceiver := make(map[component.ID][]graph.Node)
+	for pipelineID, pipelineCfg := range pipelineCfgs {
+		node := flow.NewNode()
+		flow.AddNode(node)
+		nodes[pipelineID] = node
+		for _, recvID := range pipelineCfg.Receivers {
+			if isConnector(recvID) {
+				asReceiver[recvID] = append(asReceiver[recvID], node)
+				continue
+			}
+			flow.SetEdge(flow.NewEdge(receivers, node))
+		}
+	}
+	for pipelineID, pipelineCfg := range pipelineCfgs {
+		from := nodes[pipelineID]
+		for _, exprID := range pipelineCfg.Exporters {
+			if !isConnector(exprID) {
+				flow.SetEdge(flow.NewEdge(from, exporters))
+				continue
+			}
+			for _, to := range asReceiver[exprID] {
+				if to.ID() != from.ID() {
+					flow.SetEdge(flow.NewEdge(from, to))
+				}
+			}
+		}
+	}
+
+	fromReceivers := reachable(receivers, flow.From)
+	toExporters := reachable(exporters, flow.To)
+	for pipelineID, node := range nodes {
+		if !fromReceivers[node.ID()] {
+			noReceiver = append(noReceiver, pipelineID)
+		}
+		if !toExporters[node.ID()] {
+			noExporter = append(noExporter, pipelineID)
+		}
+	}
+	sortIDs(noReceiver)
+	sortIDs(noExporter)
+	return noReceiver, noExporter
+}
+
+// reachable returns the nodes reachable from the start node, following the edges returned by next.
+func reachable(start graph.Node, next func(int64) graph.Nodes) map[int64]bool {
+	ret := map[int64]bool{}
+	queue := []int64{start.ID()}
+	for len(queue) > 0 {
+		nodes := next(queue[0])
+		queue = queue[1:]
+		for nodes.Next() {
+			if id := nodes.Node().ID(); !ret[id] {
+				ret[id] = true
+				queue = append(queue, id)
+			}
+		}
+	}
+	return ret
+}
+
+func sortIDs(ids []component.ID) {
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+}
@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/service/pipelines"
+)
+
+func TestDeadPipelines(t *testing.T) {
+	isConnector := func(id component.ID) bool {
+		return id.Type() == "connector"
+	}
+	for _, tt := range []struct {
+		name       string
+		cfgs       pipelines.Config
+		noReceiver []component.ID
+		noExporter []component.ID
+	}{
+		{
+			name: "simple",
+			cfgs: pipelines.Config{
+				component.NewID("traces"): {
+					Receivers: []component.ID{component.NewID("receiver")},
+					Exporters: []component.ID{component.NewID("exporter")},
+				},
+			},
+		},
+		{
+			name: "connected",
+			cfgs: pipelines.Config{
+				component.NewIDWithName("traces", "in"): {
+					Receivers: []component.ID{component.NewID("receiver")},
+					Exporters: []component.ID{component.NewID("connector")},
+				},
+				component.NewIDWithName("metrics", "out"): {
+					Receivers: []component.ID{component.NewID("connector")},
+					Exporters: []component.ID{component.NewID("exporter")},
+				},
+			},
+		},
+		{
+			name: "dead-branches",
+			cfgs: pipelines.Config{
+				// The data of the connector is only sent back to the pipeline it comes from.
+				component.NewIDWithName("traces", "loop"): {
+					Receivers: []component.ID{component.NewID("connector")},
+					Exporters: []component.ID{component.NewID("connector")},
+				},
+				component.NewIDWithName("traces", "in"): {
+					Receivers: []component.ID{component.NewID("receiver")},
+					Exporters: []component.ID{component.NewIDWithName("connector", "2")},
+				},
+				component.NewIDWithName("traces", "out"): {
+					Receivers: []component.ID{component.NewIDWithName("connector", "2")},
+					Exporters: []component.ID{component.NewIDWithName("connector", "3")},
+				},
+			},
+			noReceiver: []component.ID{component.NewIDWithName("traces", "loop")},
+			noExporter: []component.ID{
+				component.NewIDWithName("traces", "in"),
+				component.NewIDWithName("traces", "loop"),
+				component.NewIDWithName("traces", "out"),
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			noReceiver, noExporter := DeadPipelines(tt.cfgs, isConnector)
+			assert.Equal(t, tt.noReceiver, noReceiver)
+			assert.Equal(t, tt.noExporter, noExporter)
+		})
+	}
+}
@@ -37,6 +37,19 @@ type Settings struct {
 
 	// PipelineConfigs is a map of component.ID to PipelineConfig.
 	PipelineConfigs pipelines.Config
+
+	// FeatureGates resolve the feature gates of the components which override them, the other
+	// components use the FeatureGates of Telemetry.
+	FeatureGates map[component.Kind]map[component.ID]component.FeatureGates
+}
+
+// telemetry returns the telemetry settings of a component, with its feature gates.
+func (set Settings) telemetry(kind component.Kind, id component.ID) component.TelemetrySettings {
+	tel := set.Telemetry
+	if gates, ok := set.FeatureGates[kind][id]; ok {
+		tel.FeatureGates = gates
+	}
+	return tel
 }
 
 type Graph struct {
@@ -48,6 +61,16 @@ type Graph struct {
 }
 
 func Build(ctx context.Context, set Settings) (*Graph, error) {
+	pipelines, err := New(set)
+	if err != nil {
+		return nil, err
+	}
+	return pipelines, pipelines.buildComponents(ctx, set)
+}
+
+// New returns the graph of the pipelines without creating the components, e.g. to render it.
+// Only the ConnectorBuilder and the PipelineConfigs of the settings are used.
+func New(set Settings) (*Graph, error) {
 	pipelines := &Graph{
 		componentGraph: simple.NewDirectedGraph(),
 		pipelines:      make(map[component.ID]*pipelineNodes, len(set.PipelineConfigs)),
@@ -62,7 +85,7 @@ func Build(ctx context.Context, set Settings) (*Graph, error) {
 		return nil, err
 	}
 	pipelines.createEdges()
-	return pipelines, pipelines.buildComponents(ctx, set)
+	return pipelines, nil
 }
 
 // Creates a node for each instance of a component and adds it to the graph
@@ -92,7 +115,7 @@ func (g *Graph) createNodes(set Settings) error {
 			pipe.processors = append(pipe.processors, g.createProcessor(pipelineID, procID))
 		}
 
-		pipe.fanOutNode = newFanOutNode(pipelineID)
+		pipe.fanOutNode = newFanOutNode(pipelineID, pipelineCfg.Fanout)
 
 		for _, exprID := range pipelineCfg.Exporters {
 			if set.ConnectorBuilder.IsConfigured(exprID) {
@@ -229,13 +252,13 @@ func (g *Graph) buildComponents(ctx context.Context, set Settings) error {
 		node := nodes[i]
 		switch n := node.(type) {
 		case *receiverNode:
-			err = n.buildComponent(ctx, set.Telemetry, set.BuildInfo, set.ReceiverBuilder, g.nextConsumers(n.ID()))
+			err = n.buildComponent(ctx, set.telemetry(component.KindReceiver, n.componentID), set.BuildInfo, set.ReceiverBuilder, g.nextConsumers(n.ID()))
 		case *processorNode:
-			err = n.buildComponent(ctx, set.Telemetry, set.BuildInfo, set.ProcessorBuilder, g.nextConsumers(n.ID())[0])
+			err = n.buildComponent(ctx, set.telemetry(component.KindProcessor, n.componentID), set.BuildInfo, set.ProcessorBuilder, g.nextConsumers(n.ID())[0])
 		case *exporterNode:
-			err = n.buildComponent(ctx, set.Telemetry, set.BuildInfo, set.ExporterBuilder)
+			err = n.buildComponent(ctx, set.telemetry(component.KindExporter, n.componentID), set.BuildInfo, set.ExporterBuilder)
 		case *connectorNode:
-			err = n.buildComponent(ctx, set.Telemetry, set.BuildInfo, set.ConnectorBuilder, g.nextConsumers(n.ID()))
+			err = n.buildComponent(ctx, set.telemetry(component.KindConnector, n.componentID), set.BuildInfo, set.ConnectorBuilder, g.nextConsumers(n.ID()))
 		case *capabilitiesNode:
 			capability := consumer.Capabilities{MutatesData: false}
 			for _, proc := range g.pipelines[n.pipelineID].processors {
@@ -257,6 +280,11 @@ func (g *Graph) buildComponents(ctx context.Context, set Settings) error {
 				n.ConsumeLogsFunc = cc.ConsumeLogs
 			}
 		case *fanOutNode:
+			if n.cfg.Isolated {
+				nexts, ids := g.nextNamedConsumers(n.ID())
+				n.buildIsolated(set.Telemetry, nexts, ids)
+				break
+			}
 			nexts := g.nextConsumers(n.ID())
 			switch n.pipelineID.Type() {
 			case component.DataTypeTraces:
@@ -297,6 +325,27 @@ func (g *Graph) nextConsumers(nodeID int64) []baseConsumer {
 	return nexts
 }
 
+// nextNamedConsumers is like nextConsumers, but returns the consumers keyed by
+// the name of their component, as shown in logs, along with their component ids.
+func (g *Graph) nextNamedConsumers(nodeID int64) (map[string]baseConsumer, map[string]component.ID) {
+	nextNodes := g.componentGraph.From(nodeID)
+	nexts := make(map[string]baseConsumer, nextNodes.Len())
+	ids := make(map[string]component.ID, nextNodes.Len())
+	for nextNodes.Next() {
+		var name string
+		switch n := nextNodes.Node().(type) {
+		case *exporterNode:
+			name = fmt.Sprintf("exporter/%s", n.componentID)
+			ids[name] = n.componentID
+		case *connectorNode:
+			name = fmt.Sprintf("connector/%s (%s to %s)", n.componentID, n.exprPipelineType, n.rcvrPipelineType)
+			ids[name] = n.componentID
+		}
+		nexts[name] = nextNodes.Node().(consumerNode).getConsumer()
+	}
+	return nexts, ids
+}
+
 // A node-based representation of a pipeline configuration.
 type pipelineNodes struct {
 	// Use map to assist with deduplication of connector instances.
@@ -328,7 +377,7 @@ func (g *Graph) StartAll(ctx context.Context, host component.Host) error {
 	for i := len(nodes) - 1; i >= 0; i-- {
 		comp, ok := nodes[i].(component.Component)
 		if !ok {
-			// Skip capabilities/fanout nodes
+			// Skip capabilities nodes
 			continue
 		}
 		if compErr := comp.Start(ctx, host); compErr != nil {
@@ -352,7 +401,7 @@ func (g *Graph) ShutdownAll(ctx context.Context) error {
 	for i := 0; i < len(nodes); i++ {
 		comp, ok := nodes[i].(component.Component)
 		if !ok {
-			// Skip capabilities/fanout nodes
+			// Skip capabilities nodes
 			continue
 		}
 		errs = multierr.Append(errs, comp.Shutdown(ctx))
@@ -22,6 +22,7 @@ import (
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/featuregate"
 	"go.opentelemetry.io/collector/internal/testdata"
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/processor/processortest"
@@ -324,6 +325,30 @@ func TestConnectorPipelinesGraph(t *testing.T) {
 			},
 			expectedPerExporter: 2,
 		},
+		{
+			name: "pipelines_multi_isolated_fanout.yaml",
+			pipelineConfigs: pipelines.Config{
+				component.NewID("traces"): {
+					Receivers:  []component.ID{component.NewID("examplereceiver"), component.NewIDWithName("examplereceiver", "1")},
+					Processors: []component.ID{component.NewIDWithName("exampleprocessor", "mutate"), component.NewID("exampleprocessor")},
+					Exporters:  []component.ID{component.NewID("exampleexporter"), component.NewIDWithName("exampleexporter", "1")},
+					Fanout:     pipelines.FanoutConfig{Isolated: true},
+				},
+				component.NewID("metrics"): {
+					Receivers:  []component.ID{component.NewID("examplereceiver"), component.NewIDWithName("examplereceiver", "1")},
+					Processors: []component.ID{component.NewIDWithName("exampleprocessor", "mutate"), component.NewID("exampleprocessor")},
+					Exporters:  []component.ID{component.NewID("exampleexporter"), component.NewIDWithName("exampleexporter", "1")},
+					Fanout:     pipelines.FanoutConfig{Isolated: true},
+				},
+				component.NewID("logs"): {
+					Receivers:  []component.ID{component.NewID("examplereceiver"), component.NewIDWithName("examplereceiver", "1")},
+					Processors: []component.ID{component.NewIDWithName("exampleprocessor", "mutate"), component.NewID("exampleprocessor")},
+					Exporters:  []component.ID{component.NewID("exampleexporter"), component.NewIDWithName("exampleexporter", "1")},
+					Fanout:     pipelines.FanoutConfig{Isolated: true},
+				},
+			},
+			expectedPerExporter: 2,
+		},
 		{
 			name: "multi_pipeline_receivers_and_exporters.yaml",
 			pipelineConfigs: pipelines.Config{
@@ -2273,3 +2298,25 @@ func (e errComponent) Start(context.Context, component.Host) error {
 func (e errComponent) Shutdown(context.Context) error {
 	return errors.New("my error")
 }
+
+func TestSettingsTelemetryFeatureGates(t *testing.T) {
+	reg := featuregate.NewRegistry()
+	reg.MustRegister("alpha", featuregate.StageAlpha)
+	defaultView, err := reg.NewView(nil)
+	require.NoError(t, err)
+	view, err := reg.NewView(map[string]bool{"alpha": true})
+	require.NoError(t, err)
+
+	rcvrID := component.NewID("examplereceiver")
+	set := Settings{
+		Telemetry: componenttest.NewNopTelemetrySettings(),
+		FeatureGates: map[component.Kind]map[component.ID]component.FeatureGates{
+			component.KindReceiver: {rcvrID: view},
+		},
+	}
+	set.Telemetry.FeatureGates = defaultView
+	assert.True(t, set.telemetry(component.KindReceiver, rcvrID).FeatureGates.IsEnabled("alpha"))
+	// The components without overrides use the feature gates of the service.
+	assert.Equal(t, defaultView, set.telemetry(component.KindExporter, rcvrID).FeatureGates)
+	assert.False(t, set.telemetry(component.KindExporter, rcvrID).FeatureGates.IsEnabled("alpha"))
+}
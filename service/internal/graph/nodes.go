@@ -18,6 +18,7 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/service/internal/capabilityconsumer"
 	"go.opentelemetry.io/collector/service/internal/components"
+	"go.opentelemetry.io/collector/service/pipelines"
 )
 
 const (
@@ -372,16 +373,76 @@ var _ consumerNode = &fanOutNode{}
 type fanOutNode struct {
 	nodeID
 	pipelineID component.ID
+	cfg        pipelines.FanoutConfig
 	baseConsumer
 }
 
-func newFanOutNode(pipelineID component.ID) *fanOutNode {
+func newFanOutNode(pipelineID component.ID, cfg pipelines.FanoutConfig) *fanOutNode {
 	return &fanOutNode{
 		nodeID:     newNodeID(fanOutToExporters, pipelineID.String()),
 		pipelineID: pipelineID,
+		cfg:        cfg,
 	}
 }
 
 func (n *fanOutNode) getConsumer() baseConsumer {
 	return n.baseConsumer
 }
+
+// buildIsolated creates a fan-out consumer sending data to each of the
+// consumers through a dedicated queue.
+func (n *fanOutNode) buildIsolated(tel component.TelemetrySettings, nexts map[string]baseConsumer, ids map[string]component.ID) {
+	set := fanoutconsumer.IsolatedSettings{
+		Logger:          components.FanoutLogger(tel.Logger, n.pipelineID),
+		MeterProvider:   tel.MeterProvider,
+		Name:            n.pipelineID.String(),
+		QueueSize:       n.cfg.QueueSize,
+		PropagateErrors: make(map[string]bool, len(n.cfg.PropagateErrors)),
+	}
+	if set.QueueSize == 0 {
+		set.QueueSize = pipelines.DefaultFanoutQueueSize
+	}
+	for name, id := range ids {
+		for _, propagateID := range n.cfg.PropagateErrors {
+			if id == propagateID {
+				set.PropagateErrors[name] = true
+			}
+		}
+	}
+	switch n.pipelineID.Type() {
+	case component.DataTypeTraces:
+		consumers := make(map[string]consumer.Traces, len(nexts))
+		for name, next := range nexts {
+			consumers[name] = next.(consumer.Traces)
+		}
+		n.baseConsumer = fanoutconsumer.NewIsolatedTraces(set, consumers)
+	case component.DataTypeMetrics:
+		consumers := make(map[string]consumer.Metrics, len(nexts))
+		for name, next := range nexts {
+			consumers[name] = next.(consumer.Metrics)
+		}
+		n.baseConsumer = fanoutconsumer.NewIsolatedMetrics(set, consumers)
+	case component.DataTypeLogs:
+		consumers := make(map[string]consumer.Logs, len(nexts))
+		for name, next := range nexts {
+			consumers[name] = next.(consumer.Logs)
+		}
+		n.baseConsumer = fanoutconsumer.NewIsolatedLogs(set, consumers)
+	}
+}
+
+// Start starts the isolated fan-out consumer, if any.
+func (n *fanOutNode) Start(ctx context.Context, host component.Host) error {
+	if comp, ok := n.baseConsumer.(component.Component); ok {
+		return comp.Start(ctx, host)
+	}
+	return nil
+}
+
+// Shutdown drains the queues of the isolated fan-out consumer, if any.
+func (n *fanOutNode) Shutdown(ctx context.Context) error {
+	if comp, ok := n.baseConsumer.(component.Component); ok {
+		return comp.Shutdown(ctx)
+	}
+	return nil
+}
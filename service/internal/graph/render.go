@@ -0,0 +1,202 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package graph // import "go.opentelemetry.io/collector/service/internal/graph"
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// Format is a text format of the graph.
+type Format string
+
+const (
+	// FormatDOT is the Graphviz DOT format.
+	FormatDOT Format = "dot"
+	// FormatMermaid is the Mermaid flowchart format.
+	FormatMermaid Format = "mermaid"
+)
+
+// renderedNode is a component of the graph, as shown when the graph is rendered.
+// The id is set once all the nodes are known, to be unique.
+type renderedNode struct {
+	id    string
+	kind  string
+	name  string
+	label string
+}
+
+// Render returns the graph in the given format, with a node for each instance of a component
+// labeled by the component ID and its signal. The processors are labeled by their pipeline.
+// The capabilities and fan-out nodes are not shown, their edges are shown between the components.
+func (g *Graph) Render(format Format) (string, error) {
+	nodes, edges := g.renderedGraph()
+	var b strings.Builder
+	switch format {
+	case FormatDOT:
+		b.WriteString("digraph pipelines {\n")
+		b.WriteString("  rankdir=LR;\n")
+		for _, n := range nodes {
+			fmt.Fprintf(&b, "  %s [label=%s, shape=%s];\n", n.id, strconv.Quote(n.kind+"\n"+n.name+"\n"+n.label), dotShape(n.kind))
+		}
+		for _, e := range edges {
+			fmt.Fprintf(&b, "  %s -> %s;\n", e[0], e[1])
+		}
+		b.WriteString("}\n")
+	case FormatMermaid:
+		b.WriteString("flowchart LR\n")
+		for _, n := range nodes {
+			fmt.Fprintf(&b, "  %s[\"%s<br>%s<br>%s\"]\n", n.id, n.kind, mermaidEscape(n.name), mermaidEscape(n.label))
+		}
+		for _, e := range edges {
+			fmt.Fprintf(&b, "  %s --> %s\n", e[0], e[1])
+		}
+	default:
+		return "", fmt.Errorf("unsupported graph format %q, must be either %q or %q", format, FormatDOT, FormatMermaid)
+	}
+	return b.String(), nil
+}
+
+// renderedGraph returns the components of the graph and the edges between them, sorted
+// so that the graph is rendered the same way for the same configuration.
+func (g *Graph) renderedGraph() ([]renderedNode, [][2]string) {
+	var nodes []renderedNode
+	var graphIDs []int64
+	all := g.componentGraph.Nodes()
+	for all.Next() {
+		if n, ok := newRenderedNode(all.Node()); ok {
+			nodes = append(nodes, n)
+			graphIDs = append(graphIDs, all.Node().ID())
+		}
+	}
+	sort.Sort(byLabel{nodes: nodes, graphIDs: graphIDs})
+
+	ids := make(map[int64]string, len(nodes))
+	used := make(map[string]bool, len(nodes))
+	for i := range nodes {
+		id := nodeName(nodes[i].kind, nodes[i].name, nodes[i].label)
+		// Different IDs may have the same name once the invalid characters are replaced.
+		for suffix := 2; used[id]; suffix++ {
+			id = nodeName(nodes[i].kind, nodes[i].name, nodes[i].label, strconv.Itoa(suffix))
+		}
+		used[id] = true
+		nodes[i].id = id
+		ids[graphIDs[i]] = id
+	}
+
+	var edges [][2]string
+	seen := map[[2]string]bool{}
+	for from, fromID := range ids {
+		for _, to := range g.nextComponents(from) {
+			// The components shared by pipelines may be connected through each pipeline.
+			if e := [2]string{fromID, ids[to]}; !seen[e] {
+				seen[e] = true
+				edges = append(edges, e)
+			}
+		}
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i][0] != edges[j][0] {
+			return edges[i][0] < edges[j][0]
+		}
+		return edges[i][1] < edges[j][1]
+	})
+	return nodes, edges
+}
+
+// nextComponents returns the components the node sends data to, going through the
+// capabilities and fan-out nodes.
+func (g *Graph) nextComponents(nodeID int64) []int64 {
+	var ret []int64
+	next := g.componentGraph.From(nodeID)
+	for next.Next() {
+		switch n := next.Node().(type) {
+		case *capabilitiesNode, *fanOutNode:
+			ret = append(ret, g.nextComponents(n.ID())...)
+		default:
+			ret = append(ret, n.ID())
+		}
+	}
+	return ret
+}
+
+func newRenderedNode(node graph.Node) (renderedNode, bool) {
+	var n renderedNode
+	switch c := node.(type) {
+	case *receiverNode:
+		n = renderedNode{kind: receiverSeed, name: c.componentID.String(), label: string(c.pipelineType)}
+	case *processorNode:
+		n = renderedNode{kind: processorSeed, name: c.componentID.String(), label: c.pipelineID.String()}
+	case *exporterNode:
+		n = renderedNode{kind: exporterSeed, name: c.componentID.String(), label: string(c.pipelineType)}
+	case *connectorNode:
+		n = renderedNode{kind: connectorSeed, name: c.componentID.String(),
+			label: string(c.exprPipelineType) + " to " + string(c.rcvrPipelineType)}
+	default:
+		return n, false
+	}
+	return n, true
+}
+
+// byLabel sorts the nodes, and their IDs in the graph, by kind, name and label.
+type byLabel struct {
+	nodes    []renderedNode
+	graphIDs []int64
+}
+
+func (s byLabel) Len() int {
+	return len(s.nodes)
+}
+
+func (s byLabel) Less(i, j int) bool {
+	a, b := s.nodes[i], s.nodes[j]
+	if a.kind != b.kind {
+		return a.kind < b.kind
+	}
+	if a.name != b.name {
+		return a.name < b.name
+	}
+	return a.label < b.label
+}
+
+func (s byLabel) Swap(i, j int) {
+	s.nodes[i], s.nodes[j] = s.nodes[j], s.nodes[i]
+	s.graphIDs[i], s.graphIDs[j] = s.graphIDs[j], s.graphIDs[i]
+}
+
+// nodeName returns an identifier of the node valid both in DOT and Mermaid, readable in the output.
+func nodeName(parts ...string) string {
+	var b strings.Builder
+	for i, part := range parts {
+		if i > 0 {
+			b.WriteString("__")
+		}
+		for _, r := range part {
+			if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+				b.WriteRune(r)
+			} else {
+				b.WriteRune('_')
+			}
+		}
+	}
+	return b.String()
+}
+
+func dotShape(kind string) string {
+	switch kind {
+	case receiverSeed, exporterSeed:
+		return "box"
+	case connectorSeed:
+		return "diamond"
+	}
+	return "ellipse"
+}
+
+func mermaidEscape(s string) string {
+	return strings.ReplaceAll(s, `"`, "#quot;")
+}
@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/service/internal/testcomponents"
+	"go.opentelemetry.io/collector/service/pipelines"
+)
+
+func newRenderTestGraph(t *testing.T) *Graph {
+	g, err := New(Settings{
+		ConnectorBuilder: connector.NewBuilder(
+			map[component.ID]component.Config{
+				component.NewID("exampleconnector"): testcomponents.ExampleConnectorFactory.CreateDefaultConfig(),
+			},
+			map[component.Type]connector.Factory{
+				testcomponents.ExampleConnectorFactory.Type(): testcomponents.ExampleConnectorFactory,
+			}),
+		PipelineConfigs: pipelines.Config{
+			component.NewIDWithName("traces", "in"): {
+				Receivers:  []component.ID{component.NewID("otlp")},
+				Processors: []component.ID{component.NewID("batch")},
+				Exporters:  []component.ID{component.NewID("exampleconnector"), component.NewID("otlp")},
+			},
+			component.NewID("traces"): {
+				Receivers: []component.ID{component.NewID("otlp")},
+				Exporters: []component.ID{component.NewID("otlp")},
+			},
+			component.NewIDWithName("metrics", "out"): {
+				Receivers: []component.ID{component.NewID("exampleconnector")},
+				Exporters: []component.ID{component.NewIDWithName("otlp", "2")},
+			},
+		},
+	})
+	require.NoError(t, err)
+	return g
+}
+
+func TestRenderDOT(t *testing.T) {
+	out, err := newRenderTestGraph(t).Render(FormatDOT)
+	require.NoError(t, err)
+	assert.Equal(t, `digraph pipelines {
+  rankdir=LR;
+  connector__exampleconnector__traces_to_metrics [label="connector\nexampleconnector\ntraces to metrics", shape=diamond];
+  exporter__otlp__traces [label="exporter\notlp\ntraces", shape=box];
+  exporter__otlp_2__metrics [label="exporter\notlp/2\nmetrics", shape=box];
+  processor__batch__traces_in [label="processor\nbatch\ntraces/in", shape=ellipse];
+  receiver__otlp__traces [label="receiver\notlp\ntraces", shape=box];
+  connector__exampleconnector__traces_to_metrics -> exporter__otlp_2__metrics;
+  processor__batch__traces_in -> connector__exampleconnector__traces_to_metrics;
+  processor__batch__traces_in -> exporter__otlp__traces;
+  receiver__otlp__traces -> exporter__otlp__traces;
+  receiver__otlp__traces -> processor__batch__traces_in;
+}
+`, out)
+}
+
+func TestRenderMermaid(t *testing.T) {
+	out, err := newRenderTestGraph(t).Render(FormatMermaid)
+	require.NoError(t, err)
+	assert.Equal(t, `flowchart LR
+  connector__exampleconnector__traces_to_metrics["connector<br>exampleconnector<br>traces to metrics"]
+  exporter__otlp__traces["exporter<br>otlp<br>traces"]
+  exporter__otlp_2__metrics["exporter<br>otlp/2<br>metrics"]
+  processor__batch__traces_in["processor<br>batch<br>traces/in"]
+  receiver__otlp__traces["receiver<br>otlp<br>traces"]
+  connector__exampleconnector__traces_to_metrics --> exporter__otlp_2__metrics
+  processor__batch__traces_in --> connector__exampleconnector__traces_to_metrics
+  processor__batch__traces_in --> exporter__otlp__traces
+  receiver__otlp__traces --> exporter__otlp__traces
+  receiver__otlp__traces --> processor__batch__traces_in
+`, out)
+}
+
+func TestRenderUnsupportedFormat(t *testing.T) {
+	_, err := newRenderTestGraph(t).Render("svg")
+	assert.EqualError(t, err, `unsupported graph format "svg", must be either "dot" or "mermaid"`)
+}
+
+func TestRenderUniqueNodeNames(t *testing.T) {
+	g, err := New(Settings{
+		ConnectorBuilder: connector.NewBuilder(nil, nil),
+		PipelineConfigs: pipelines.Config{
+			component.NewID("traces"): {
+				Receivers: []component.ID{component.NewIDWithName("otlp", "a-b"), component.NewIDWithName("otlp", "a_b")},
+				Exporters: []component.ID{component.NewID("otlp")},
+			},
+		},
+	})
+	require.NoError(t, err)
+	out, err := g.Render(FormatMermaid)
+	require.NoError(t, err)
+	assert.Contains(t, out, `receiver__otlp_a_b__traces["receiver<br>otlp/a-b<br>traces"]`)
+	assert.Contains(t, out, `receiver__otlp_a_b__traces__2["receiver<br>otlp/a_b<br>traces"]`)
+}
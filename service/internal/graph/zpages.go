@@ -16,6 +16,7 @@ const (
 	zPipelineName  = "pipelinenamez"
 	zComponentName = "componentnamez"
 	zComponentKind = "componentkindz"
+	zGraphFormat   = "graphformatz"
 )
 
 func (g *Graph) HandleZPages(w http.ResponseWriter, r *http.Request) {
@@ -24,6 +25,11 @@ func (g *Graph) HandleZPages(w http.ResponseWriter, r *http.Request) {
 	componentName := qValues.Get(zComponentName)
 	componentKind := qValues.Get(zComponentKind)
 
+	if format := qValues.Get(zGraphFormat); format != "" {
+		g.handleGraphZPages(w, Format(format))
+		return
+	}
+
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
 	zpages.WriteHTMLPageHeader(w, zpages.HeaderData{Title: "builtPipelines"})
 
@@ -66,6 +72,13 @@ func (g *Graph) HandleZPages(w http.ResponseWriter, r *http.Request) {
 		return sumData.Rows[i].FullName < sumData.Rows[j].FullName
 	})
 	zpages.WriteHTMLPipelinesSummaryTable(w, sumData)
+	for _, format := range []Format{FormatDOT, FormatMermaid} {
+		zpages.WriteHTMLComponentHeader(w, zpages.ComponentHeaderData{
+			Name:              "Graph (" + string(format) + ")",
+			ComponentEndpoint: "?" + zGraphFormat + "=" + string(format),
+			Link:              true,
+		})
+	}
 
 	if pipelineName != "" && componentName != "" && componentKind != "" {
 		fullName := componentName
@@ -79,3 +92,14 @@ func (g *Graph) HandleZPages(w http.ResponseWriter, r *http.Request) {
 	}
 	zpages.WriteHTMLPageFooter(w)
 }
+
+// handleGraphZPages writes the graph of the pipelines as text in the given format.
+func (g *Graph) handleGraphZPages(w http.ResponseWriter, format Format) {
+	out, err := g.Render(format)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = w.Write([]byte(out))
+}
@@ -14,6 +14,8 @@ var (
 	errMissingServicePipelines         = errors.New("service must have at least one pipeline")
 	errMissingServicePipelineReceivers = errors.New("must have at least one receiver")
 	errMissingServicePipelineExporters = errors.New("must have at least one exporter")
+	errInvalidFanoutQueueSize          = errors.New("queue_size must not be negative")
+	errFanoutPropagateNotIsolated      = errors.New("propagate_errors requires isolated to be enabled")
 )
 
 // Config defines the configurable settings for service telemetry.
@@ -46,6 +48,9 @@ type PipelineConfig struct {
 	Receivers  []component.ID `mapstructure:"receivers"`
 	Processors []component.ID `mapstructure:"processors"`
 	Exporters  []component.ID `mapstructure:"exporters"`
+
+	// Fanout configures how data is sent to the exporters of the pipeline.
+	Fanout FanoutConfig `mapstructure:"fanout"`
 }
 
 func (cfg *PipelineConfig) Validate() error {
@@ -69,5 +74,58 @@ func (cfg *PipelineConfig) Validate() error {
 		procSet[ref] = struct{}{}
 	}
 
+	if err := cfg.Fanout.Validate(); err != nil {
+		return fmt.Errorf("fanout: %w", err)
+	}
+	for _, ref := range cfg.Fanout.PropagateErrors {
+		if !containsID(cfg.Exporters, ref) {
+			return fmt.Errorf("fanout: propagate_errors references exporter %q which is not in the pipeline", ref)
+		}
+	}
+
 	return nil
 }
+
+// FanoutConfig defines how a pipeline sends data to its exporters.
+type FanoutConfig struct {
+	// Isolated sends data to each exporter through a dedicated bounded queue,
+	// so that a slow or blocked exporter does not delay the other exporters.
+	// By default, data is sent to the exporters one after the other, and the
+	// error of any exporter is returned to the receivers of the pipeline.
+	Isolated bool `mapstructure:"isolated"`
+
+	// QueueSize is the number of requests buffered for each exporter when
+	// Isolated is enabled. When the queue of an exporter is full, data is dropped
+	// for that exporter only. If zero, DefaultFanoutQueueSize is used.
+	QueueSize int `mapstructure:"queue_size"`
+
+	// PropagateErrors lists the exporters of the pipeline whose errors are
+	// returned to the receivers of the pipeline. These exporters are not queued:
+	// the receivers wait for them to export the data. When one of them fails, the
+	// data is queued for none of the other exporters, so that the receivers can
+	// retry it without duplicating it. Only used when Isolated is enabled.
+	PropagateErrors []component.ID `mapstructure:"propagate_errors"`
+}
+
+// DefaultFanoutQueueSize is the size of the per exporter queue used when
+// FanoutConfig.QueueSize is not set.
+const DefaultFanoutQueueSize = 100
+
+func (cfg *FanoutConfig) Validate() error {
+	if cfg.QueueSize < 0 {
+		return errInvalidFanoutQueueSize
+	}
+	if !cfg.Isolated && len(cfg.PropagateErrors) > 0 {
+		return errFanoutPropagateNotIsolated
+	}
+	return nil
+}
+
+func containsID(ids []component.ID, id component.ID) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
@@ -52,6 +52,33 @@ func TestConfigValidate(t *testing.T) {
 			},
 			expected: fmt.Errorf(`pipeline "traces": %w`, errMissingServicePipelineExporters),
 		},
+		{
+			name: "invalid-fanout-queue-size",
+			cfgFn: func() Config {
+				cfg := generateConfig()
+				cfg[component.NewID("traces")].Fanout = FanoutConfig{Isolated: true, QueueSize: -1}
+				return cfg
+			},
+			expected: fmt.Errorf(`pipeline "traces": %w`, fmt.Errorf("fanout: %w", errInvalidFanoutQueueSize)),
+		},
+		{
+			name: "fanout-propagate-not-isolated",
+			cfgFn: func() Config {
+				cfg := generateConfig()
+				cfg[component.NewID("traces")].Fanout = FanoutConfig{PropagateErrors: []component.ID{component.NewID("nop")}}
+				return cfg
+			},
+			expected: fmt.Errorf(`pipeline "traces": %w`, fmt.Errorf("fanout: %w", errFanoutPropagateNotIsolated)),
+		},
+		{
+			name: "fanout-propagate-unknown-exporter",
+			cfgFn: func() Config {
+				cfg := generateConfig()
+				cfg[component.NewID("traces")].Fanout = FanoutConfig{Isolated: true, PropagateErrors: []component.ID{component.NewIDWithName("nop", "2")}}
+				return cfg
+			},
+			expected: fmt.Errorf(`pipeline "traces": %w`, errors.New(`fanout: propagate_errors references exporter "nop/2" which is not in the pipeline`)),
+		},
 		{
 			name: "missing-pipelines",
 			cfgFn: func() Config {
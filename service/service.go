@@ -21,6 +21,7 @@ import (
 	"go.opentelemetry.io/collector/connector"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/extension"
+	"go.opentelemetry.io/collector/featuregate"
 	"go.opentelemetry.io/collector/internal/obsreportconfig"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/processor"
@@ -61,6 +62,9 @@ type Settings struct {
 	// LoggingOptions provides a way to change behavior of zap logging.
 	LoggingOptions []zap.Option
 
+	// ComponentFeatureGates are the feature gates overridden for individual components.
+	ComponentFeatureGates ComponentFeatureGates
+
 	// For testing purpose only.
 	useOtel *bool
 }
@@ -73,10 +77,27 @@ type Service struct {
 	host                 *serviceHost
 	telemetryInitializer *telemetryInitializer
 	collectorConf        *confmap.Conf
+	// previousFeatureGates is the state of the feature gates before they were set from the
+	// configuration, restored on shutdown.
+	previousFeatureGates map[string]bool
 }
 
-func New(ctx context.Context, set Settings, cfg Config) (*Service, error) {
-	useOtel := obsreportconfig.UseOtelForInternalMetricsfeatureGate.IsEnabled()
+func New(ctx context.Context, set Settings, cfg Config) (_ *Service, err error) {
+	// The feature gates must be set before anything reads them.
+	previousGates, gateWarnings, err := applyFeatureGates(featuregate.GlobalRegistry(), cfg.FeatureGates, set.BuildInfo.Version)
+	defer func() {
+		// Do not leave the feature gates of a service which could not be created in the registry.
+		if err != nil {
+			err = multierr.Append(err, restoreFeatureGates(featuregate.GlobalRegistry(), previousGates))
+		}
+	}()
+	if err != nil {
+		return nil, fmt.Errorf("failed to set feature gates: %w", err)
+	}
+
+	// The components enabling the gate for themselves need the OpenTelemetry MeterProvider.
+	useOtel := obsreportconfig.UseOtelForInternalMetricsfeatureGate.IsEnabled() ||
+		set.ComponentFeatureGates.enablesFeatureGate(obsreportconfig.UseOtelForInternalMetricsfeatureGate.ID())
 	if set.useOtel != nil {
 		useOtel = *set.useOtel
 	}
@@ -95,13 +116,16 @@ func New(ctx context.Context, set Settings, cfg Config) (*Service, error) {
 		},
 		telemetryInitializer: newColTelemetry(useOtel, disableHighCard, extendedConfig),
 		collectorConf:        set.CollectorConf,
+		previousFeatureGates: previousGates,
 	}
-	var err error
 	srv.telemetry, err = telemetry.New(ctx, telemetry.Settings{ZapOptions: set.LoggingOptions}, cfg.Telemetry)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get logger: %w", err)
 	}
-	res := buildResource(set.BuildInfo, cfg.Telemetry)
+	for _, warning := range gateWarnings {
+		srv.telemetry.Logger().Warn("Feature gate warning", zap.Error(warning))
+	}
+	res := buildResource(set.BuildInfo, cfg.Telemetry, nonDefaultFeatureGates(featuregate.GlobalRegistry()))
 	pcommonRes := pdataFromSdk(res)
 
 	srv.telemetrySettings = component.TelemetrySettings{
@@ -112,6 +136,9 @@ func New(ctx context.Context, set Settings, cfg Config) (*Service, error) {
 
 		// Construct telemetry attributes from build info and config's resource attributes.
 		Resource: pcommonRes,
+
+		// The components which do not override the feature gates resolve the global registry.
+		FeatureGates: featuregate.GlobalRegistry().DefaultView(),
 	}
 
 	if err = srv.telemetryInitializer.init(res, srv.telemetrySettings, cfg.Telemetry, set.AsyncErrorChannel); err != nil {
@@ -190,15 +217,24 @@ func (srv *Service) Shutdown(ctx context.Context) error {
 	if err := srv.telemetryInitializer.shutdown(); err != nil {
 		errs = multierr.Append(errs, fmt.Errorf("failed to shutdown collector telemetry: %w", err))
 	}
+
+	if err := restoreFeatureGates(featuregate.GlobalRegistry(), srv.previousFeatureGates); err != nil {
+		errs = multierr.Append(errs, fmt.Errorf("failed to restore feature gates: %w", err))
+	}
 	return errs
 }
 
 func (srv *Service) initExtensionsAndPipeline(ctx context.Context, set Settings, cfg Config) error {
-	var err error
+	featureGates, err := newComponentFeatureGateViews(featuregate.GlobalRegistry(), set.ComponentFeatureGates)
+	if err != nil {
+		return fmt.Errorf("failed to set component feature gates: %w", err)
+	}
+
 	extensionsSettings := extensions.Settings{
-		Telemetry:  srv.telemetrySettings,
-		BuildInfo:  srv.buildInfo,
-		Extensions: srv.host.extensions,
+		Telemetry:    srv.telemetrySettings,
+		BuildInfo:    srv.buildInfo,
+		Extensions:   srv.host.extensions,
+		FeatureGates: featureGates.ofKind(component.KindExtension),
 	}
 	if srv.host.serviceExtensions, err = extensions.New(ctx, extensionsSettings, cfg.Extensions); err != nil {
 		return fmt.Errorf("failed to build extensions: %w", err)
@@ -212,12 +248,22 @@ func (srv *Service) initExtensionsAndPipeline(ctx context.Context, set Settings,
 		ExporterBuilder:  set.Exporters,
 		ConnectorBuilder: set.Connectors,
 		PipelineConfigs:  cfg.Pipelines,
+		FeatureGates: map[component.Kind]map[component.ID]component.FeatureGates{
+			component.KindReceiver:  featureGates.ofKind(component.KindReceiver),
+			component.KindProcessor: featureGates.ofKind(component.KindProcessor),
+			component.KindExporter:  featureGates.ofKind(component.KindExporter),
+			component.KindConnector: featureGates.ofKind(component.KindConnector),
+		},
 	}
 
 	if srv.host.pipelines, err = graph.Build(ctx, pSet); err != nil {
 		return fmt.Errorf("failed to build pipelines: %w", err)
 	}
 
+	if err = featureGates.validateResolved(); err != nil {
+		return fmt.Errorf("failed to set component feature gates: %w", err)
+	}
+
 	if cfg.Telemetry.Metrics.Level != configtelemetry.LevelNone && cfg.Telemetry.Metrics.Address != "" {
 		// The process telemetry initialization requires the ballast size, which is available after the extensions are initialized.
 		if err = proctelemetry.RegisterProcessMetrics(srv.telemetryInitializer.ocRegistry, srv.telemetryInitializer.mp, obsreportconfig.UseOtelForInternalMetricsfeatureGate.IsEnabled(), getBallastSize(srv.host)); err != nil {
@@ -243,7 +289,7 @@ func getBallastSize(host component.Host) uint64 {
 	return 0
 }
 
-func buildResource(buildInfo component.BuildInfo, cfg telemetry.Config) *resource.Resource {
+func buildResource(buildInfo component.BuildInfo, cfg telemetry.Config, featureGates string) *resource.Resource {
 	var telAttrs []attribute.KeyValue
 
 	for k, v := range cfg.Resource {
@@ -270,6 +316,10 @@ func buildResource(buildInfo component.BuildInfo, cfg telemetry.Config) *resourc
 		// build version.
 		telAttrs = append(telAttrs, attribute.String(semconv.AttributeServiceVersion, buildInfo.Version))
 	}
+
+	if _, ok := cfg.Resource[featureGatesResourceAttribute]; !ok && featureGates != "" {
+		telAttrs = append(telAttrs, attribute.String(featureGatesResourceAttribute, featureGates))
+	}
 	return resource.NewWithAttributes(semconv.SchemaURL, telAttrs...)
 }
 
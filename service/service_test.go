@@ -24,12 +24,16 @@ import (
 	"go.opentelemetry.io/collector/config/configtelemetry"
 	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/connector/connectortest"
+	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/exporter/exportertest"
 	"go.opentelemetry.io/collector/extension"
 	"go.opentelemetry.io/collector/extension/extensiontest"
 	"go.opentelemetry.io/collector/extension/zpagesextension"
+	"go.opentelemetry.io/collector/featuregate"
+	"go.opentelemetry.io/collector/internal/obsreportconfig"
 	"go.opentelemetry.io/collector/internal/testutil"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/processor/processortest"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.opentelemetry.io/collector/service/extensions"
@@ -403,6 +407,69 @@ func TestNilCollectorEffectiveConfig(t *testing.T) {
 	require.NoError(t, srv.Shutdown(context.Background()))
 }
 
+func TestServiceFeatureGates(t *testing.T) {
+	gate := featuregate.GlobalRegistry().MustRegister("service.test.configFeatureGate", featuregate.StageAlpha)
+	defer func() {
+		require.NoError(t, featuregate.GlobalRegistry().Set(gate.ID(), false))
+	}()
+
+	cfg := newNopConfig()
+	cfg.FeatureGates = map[string]bool{gate.ID(): true}
+	srv, err := New(context.Background(), newNopSettings(), cfg)
+	require.NoError(t, err)
+	assert.True(t, gate.IsEnabled())
+	assert.True(t, srv.telemetrySettings.FeatureGates.IsEnabled(gate.ID()))
+	value, ok := srv.telemetrySettings.Resource.Attributes().Get(featureGatesResourceAttribute)
+	assert.True(t, ok)
+	assert.Contains(t, strings.Split(value.Str(), ","), gate.ID())
+	require.NoError(t, srv.Shutdown(context.Background()))
+	// The feature gates are restored on shutdown.
+	assert.False(t, gate.IsEnabled())
+
+	cfg.FeatureGates = map[string]bool{gate.ID(): true, "service.test.unknownFeatureGate": true}
+	_, err = New(context.Background(), newNopSettings(), cfg)
+	assert.EqualError(t, err, `failed to set feature gates: no such feature gate "service.test.unknownFeatureGate"`)
+	// The feature gates are restored when the service can not be created.
+	assert.False(t, gate.IsEnabled())
+}
+
+func TestServiceComponentFeatureGates(t *testing.T) {
+	gateID := obsreportconfig.UseOtelForInternalMetricsfeatureGate.ID()
+	canaryID := component.NewID("canary")
+	var enabled bool
+	factory := processor.NewFactory("canary", func() component.Config { return &struct{}{} },
+		processor.WithTraces(func(ctx context.Context, set processor.CreateSettings, cfg component.Config, next consumer.Traces) (processor.Traces, error) {
+			enabled = set.TelemetrySettings.FeatureGates.IsEnabled(gateID)
+			return processortest.NewNopFactory().CreateTracesProcessor(ctx, set, cfg, next)
+		}, component.StabilityLevelDevelopment))
+
+	set := newNopSettings()
+	set.Processors = processor.NewBuilder(map[component.ID]component.Config{canaryID: &struct{}{}}, map[component.Type]processor.Factory{"canary": factory})
+	set.ComponentFeatureGates = ComponentFeatureGates{component.KindProcessor: {canaryID: {gateID: true}}}
+	cfg := newNopConfigPipelineConfigs(pipelines.Config{
+		component.NewID("traces"): {
+			Receivers:  []component.ID{component.NewID("nop")},
+			Processors: []component.ID{canaryID},
+			Exporters:  []component.ID{component.NewID("nop")},
+		},
+	})
+	cfg.Telemetry.Metrics.Level = configtelemetry.LevelNone
+
+	// The gate is only enabled for the processor, and the service sets up the OpenTelemetry
+	// MeterProvider it needs.
+	srv, err := New(context.Background(), set, cfg)
+	require.NoError(t, err)
+	assert.True(t, enabled)
+	assert.False(t, obsreportconfig.UseOtelForInternalMetricsfeatureGate.IsEnabled())
+	assert.True(t, srv.telemetryInitializer.useOtel)
+	require.NoError(t, srv.Shutdown(context.Background()))
+
+	// The nop exporter does not use the gate, overriding it would have no effect.
+	set.ComponentFeatureGates[component.KindExporter] = map[component.ID]map[string]bool{component.NewID("nop"): {gateID: true}}
+	_, err = New(context.Background(), set, cfg)
+	assert.EqualError(t, err, `failed to set component feature gates: exporter "nop" does not use the feature gate "telemetry.useOtelForInternalMetrics" overridden in its configuration`)
+}
+
 func assertResourceLabels(t *testing.T, res pcommon.Resource, expectedLabels map[string]labelValue) {
 	for key, labelValue := range expectedLabels {
 		lookupKey, ok := prometheusToOtelConv[key]
@@ -472,6 +539,8 @@ func assertZPages(t *testing.T, zpagesAddr string) {
 		// TODO: enable this when otel-metrics is used and this page is available.
 		// "/debug/rpcz",
 		"/debug/pipelinez",
+		"/debug/pipelinez?graphformatz=dot",
+		"/debug/pipelinez?graphformatz=mermaid",
 		"/debug/servicez",
 		"/debug/extensionz",
 	}
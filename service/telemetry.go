@@ -32,6 +32,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configtelemetry"
 	"go.opentelemetry.io/collector/internal/obsreportconfig"
+	"go.opentelemetry.io/collector/internal/selftelemetry"
 	"go.opentelemetry.io/collector/service/internal/proctelemetry"
 	"go.opentelemetry.io/collector/service/telemetry"
 )
@@ -145,7 +146,17 @@ func (tel *telemetryInitializer) initMetrics(res *resource.Resource, logger *zap
 	}
 
 	metricproducer.GlobalManager().AddProducer(tel.ocRegistry)
-	opts := []sdkmetric.Option{}
+	// When only some components enable the gate for themselves, the others still record their
+	// metrics with OpenCensus, exported through the OpenCensus bridge of the Prometheus readers.
+	if tel.useOtel && !obsreportconfig.UseOtelForInternalMetricsfeatureGate.IsEnabled() {
+		tel.views = obsreportconfig.AllViews(cfg.Metrics.Level)
+		if err := view.Register(tel.views...); err != nil {
+			return err
+		}
+	}
+	// The self reader makes the metrics available to the components reading them in-process.
+	selfReader := selftelemetry.NewReader()
+	opts := []sdkmetric.Option{sdkmetric.WithReader(selfReader)}
 	for _, reader := range cfg.Metrics.Readers {
 		// https://github.com/open-telemetry/opentelemetry-collector/issues/8045
 		r, server, err := proctelemetry.InitMetricReader(context.Background(), reader, asyncErrorChannel)
@@ -168,6 +179,7 @@ func (tel *telemetryInitializer) initMetrics(res *resource.Resource, logger *zap
 		return err
 	}
 	tel.mp = mp
+	selftelemetry.SetReader(selfReader)
 	return nil
 }
 
@@ -208,6 +220,7 @@ func (tel *telemetryInitializer) initOpenCensus(res *resource.Resource, logger *
 }
 
 func (tel *telemetryInitializer) shutdown() error {
+	selftelemetry.SetReader(nil)
 	metricproducer.GlobalManager().DeleteProducer(tel.ocRegistry)
 	view.Unregister(tel.views...)
 
@@ -20,6 +20,7 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configtelemetry"
+	"go.opentelemetry.io/collector/internal/selftelemetry"
 	"go.opentelemetry.io/collector/internal/testutil"
 	semconv "go.opentelemetry.io/collector/semconv/v1.18.0"
 	"go.opentelemetry.io/collector/service/internal/proctelemetry"
@@ -40,7 +41,7 @@ func TestBuildResource(t *testing.T) {
 
 	// Check default config
 	cfg := telemetry.Config{}
-	otelRes := buildResource(buildInfo, cfg)
+	otelRes := buildResource(buildInfo, cfg, "")
 	res := pdataFromSdk(otelRes)
 
 	assert.Equal(t, res.Attributes().Len(), 3)
@@ -54,6 +55,13 @@ func TestBuildResource(t *testing.T) {
 	_, ok = res.Attributes().Get(semconv.AttributeServiceInstanceID)
 	assert.True(t, ok)
 
+	// Check the feature gates
+	otelRes = buildResource(buildInfo, cfg, "alpha,-beta")
+	res = pdataFromSdk(otelRes)
+	value, ok = res.Attributes().Get(featureGatesResourceAttribute)
+	assert.True(t, ok)
+	assert.Equal(t, "alpha,-beta", value.AsString())
+
 	// Check override by nil
 	cfg = telemetry.Config{
 		Resource: map[string]*string{
@@ -62,7 +70,7 @@ func TestBuildResource(t *testing.T) {
 			semconv.AttributeServiceInstanceID: nil,
 		},
 	}
-	otelRes = buildResource(buildInfo, cfg)
+	otelRes = buildResource(buildInfo, cfg, "")
 	res = pdataFromSdk(otelRes)
 
 	// Attributes should not exist since we nil-ified all.
@@ -77,7 +85,7 @@ func TestBuildResource(t *testing.T) {
 			semconv.AttributeServiceInstanceID: strPtr("c"),
 		},
 	}
-	otelRes = buildResource(buildInfo, cfg)
+	otelRes = buildResource(buildInfo, cfg, "")
 	res = pdataFromSdk(otelRes)
 
 	assert.Equal(t, res.Attributes().Len(), 3)
@@ -270,7 +278,7 @@ func TestTelemetryInit(t *testing.T) {
 					},
 				}
 			}
-			otelRes := buildResource(buildInfo, *tc.cfg)
+			otelRes := buildResource(buildInfo, *tc.cfg, "")
 			res := pdataFromSdk(otelRes)
 			settings := component.TelemetrySettings{
 				Logger:   zap.NewNop(),
@@ -303,6 +311,20 @@ func TestTelemetryInit(t *testing.T) {
 				require.Equal(t, metricValue.labels, labels, "labels for metric %q was different than expected", metricName)
 				require.Equal(t, metricValue.value, mf.Metric[0].Counter.GetValue(), "value for metric %q was different than expected", metricName)
 			}
+
+			// The metrics are also available in-process.
+			scopeMetrics, err := selftelemetry.Collect(context.Background())
+			require.NoError(t, err)
+			var names []string
+			for _, sm := range scopeMetrics {
+				for _, m := range sm.Metrics {
+					names = append(names, m.Name)
+				}
+			}
+			assert.Contains(t, names, ocPrefix+counterName)
+			if tc.useOtel || tc.extendedConfig {
+				assert.Contains(t, names, otelPrefix+counterName)
+			}
 		})
 
 	}